@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/identity"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagApplyFile  string
+	flagApplyYes   bool
+	flagApplyPrune bool
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Plan and apply a desired identity state of tenants, groups, roles, memberships and authorizations (camunda 8.8+)",
+	Long: `Plan and apply a desired identity state of tenants, groups, roles, memberships and authorizations.
+
+The file lists the desired tenants, groups and roles with their members, and the authorizations:
+
+  tenants:
+    - id: emea
+      name: EMEA
+      members:
+        groups: [ops]
+  groups:
+    - id: ops
+      name: Operations
+      members:
+        users: [alice, bob]
+        mappingRules: [ops-claim]
+  roles:
+    - id: operator
+      name: Operator
+      members:
+        groups: [ops]
+  authorizations:
+    - ownerType: group
+      ownerId: ops
+      resourceType: process_definition
+      resourceId: "*"
+      permissions: [read_process_instance, update_process_instance]
+
+The plan of changes against the cluster is printed and only applied with --yes.
+Sections and member lists left out are not managed. Without --prune nothing is removed;
+with --prune undeclared tenants, groups and roles of a listed section are deleted, members
+missing from a listed member list are unassigned and undeclared authorizations of the groups,
+roles and owners used in the file are deleted. Built-in roles like admin and the default tenant
+are never deleted and neither are their authorizations. On a protected profile pruning asks to
+type the cluster name, --yes only applies the plan; pass --confirm-cluster in scripts.`,
+	Example: `  kamunder apply -f identity.yaml
+  kamunder apply -f identity.yaml --yes
+  kamunder apply -f identity.yaml --prune --yes
+  kamunder apply -f identity.yaml --prune --yes --confirm-cluster prod`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		desired, err := loadIdentityState(flagApplyFile)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+		plan, err := identity.NewPlan(cmd.Context(), cli, desired, flagApplyPrune)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error planning identity changes: %w", err))
+		}
+		if err = identityPlanView(cmd, plan); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering plan view: %w", err))
+		}
+		if plan.IsEmpty() {
+			return
+		}
+		if !flagApplyYes {
+			log.Info("plan not applied, re-run with --yes to apply it")
+			return
+		}
+		if removals := plan.Removals(); removals > 0 {
+			if err = confirmDestructive(cmd, "delete and unassign identities and authorizations not declared in "+flagApplyFile, removals); err != nil {
+				ferrors.HandleAndExit(log, err)
+			}
+		}
+		n, err := plan.Apply(cmd.Context(), cli)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error applying plan after %d of %d change(s): %w", n, len(plan.Changes), err))
+		}
+		log.Info(fmt.Sprintf("plan applied: %d change(s)", n))
+	},
+}
+
+func loadIdentityState(path string) (identity.State, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return identity.State{}, err
+		}
+		defer func() { _ = f.Close() }()
+		r = f
+	}
+	return identity.LoadState(r)
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+
+	fs := applyCmd.Flags()
+	fs.StringVarP(&flagApplyFile, "file", "f", "", "path to the desired state YAML file or '-' for stdin")
+	_ = applyCmd.MarkFlagRequired("file")
+	fs.BoolVarP(&flagApplyYes, "yes", "y", false, "apply the plan instead of only printing it")
+	fs.BoolVar(&flagApplyPrune, "prune", false, "delete and unassign what is not declared in the file")
+	addConfirmClusterFlag(applyCmd)
+}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Log in with your own account, show the login state and manage cached tokens",
+	Long: `Log in with your own account, show the login state and manage cached tokens.
+With auth.mode oidc-user, "auth login" logs you in with your SSO account in the browser or,
+on headless machines, with a code entered on another device. The session is kept encrypted
+in the user cache dir ($XDG_CACHE_HOME/kamunder), as are the access tokens of the oauth2
+mode with auth.oauth2.token_cache enabled.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"login", "oauth", "token"},
+}
+
+func init() {
+	rootCmd.AddCommand(authCmd)
+}
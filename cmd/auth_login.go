@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/internal/services/auth/authenticator"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/toolx/logging"
+	"github.com/spf13/cobra"
+)
+
+var flagAuthLoginDevice bool
+
+var authLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Log in with your own account (auth.mode oidc-user)",
+	Long: `Log in with your own account (auth.mode oidc-user).
+By default the login page opens in the browser and the identity provider redirects back to
+kamunder on the loopback interface (authorization code flow with PKCE). With --device, or
+auth.oidc_user.flow device, you open a URL on any other device and enter the printed code instead.`,
+	Example: `  kamunder auth login
+  kamunder auth login --device`,
+	Run: func(cmd *cobra.Command, args []string) {
+		log := logging.FromContext(cmd.Context())
+		ator, err := authenticator.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		ul, ok := ator.(authenticator.UserLogin)
+		if !ok {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: auth mode %s needs no login, set auth.mode to %s to log in with your own account", ferrors.ErrBadRequest, ator.Name(), config.ModeOIDCUser))
+		}
+		var flow config.OIDCFlow
+		if flagAuthLoginDevice {
+			flow = config.FlowDevice
+		}
+		if err = ul.Login(cmd.Context(), flow, cmd.ErrOrStderr()); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error logging in: %w", err))
+		}
+		sess, err := ul.Session(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error reading session: %w", err))
+		}
+		log.Info(fmt.Sprintf("logged in%s", subjectSuffix(sess)))
+	},
+}
+
+func subjectSuffix(s authenticator.Session) string {
+	if s.Subject == "" {
+		return ""
+	}
+	return " as " + s.Subject
+}
+
+func init() {
+	authCmd.AddCommand(authLoginCmd)
+
+	authLoginCmd.Flags().BoolVar(&flagAuthLoginDevice, "device", false, "log in on another device with a code instead of the browser")
+}
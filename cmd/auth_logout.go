@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/internal/services/auth/tokencache"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/toolx/logging"
+	"github.com/spf13/cobra"
+)
+
+var authLogoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Remove all cached access tokens from disk",
+	Run: func(cmd *cobra.Command, args []string) {
+		log := logging.FromContext(cmd.Context())
+		dir, err := tokencache.DefaultDir()
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error locating token cache: %w", err))
+		}
+		n, err := tokencache.New(dir, "").Clear()
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error clearing token cache: %w", err))
+		}
+		log.Info(fmt.Sprintf("removed %d cached token(s) from %s", n, dir))
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authLogoutCmd)
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/internal/services/auth/authenticator"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/toolx/logging"
+	"github.com/spf13/cobra"
+)
+
+var authStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the authentication mode and who is logged in",
+	Run: func(cmd *cobra.Command, args []string) {
+		log := logging.FromContext(cmd.Context())
+		ator, err := authenticator.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		var sess *authenticator.Session
+		if ul, ok := ator.(authenticator.UserLogin); ok {
+			s, err := ul.Session(cmd.Context())
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error reading session: %w", err))
+			}
+			sess = &s
+		}
+		if err = authStatusView(cmd, ator.Name(), sess); err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authStatusCmd)
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/internal/services/auth/authenticator"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/toolx/logging"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagAuthTokenTarget string
+	flagAuthTokenClear  bool
+)
+
+// tokenCacheClearer is implemented by authenticators keeping tokens on disk.
+type tokenCacheClearer interface {
+	ClearTokenCache() (int, error)
+}
+
+var authTokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Print an access token for an API, or clear the cached tokens of the configured client",
+	Long: `Print an access token for an API, e.g. to call the API with curl, or clear the cached tokens
+of the configured client with --clear.`,
+	Example: `  kamunder auth token --target camunda_api
+  kamunder auth token --clear`,
+	Run: func(cmd *cobra.Command, args []string) {
+		log := logging.FromContext(cmd.Context())
+		ator, err := authenticator.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if flagAuthTokenClear {
+			ator.ClearCache()
+			c, ok := ator.(tokenCacheClearer)
+			if !ok {
+				ferrors.HandleAndExitOK(log, fmt.Sprintf("%s authentication keeps no tokens on disk", ator.Name()))
+			}
+			n, err := c.ClearTokenCache()
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error clearing token cache: %w", err))
+			}
+			log.Info(fmt.Sprintf("removed %d cached token(s)", n))
+			return
+		}
+		bp, ok := ator.(authenticator.BearerProvider)
+		if !ok {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %s authentication does not use bearer tokens", ferrors.ErrBadRequest, ator.Name()))
+		}
+		tok, err := bp.Token(cmd.Context(), flagAuthTokenTarget)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error retrieving token: %w", err))
+		}
+		cmd.Println(tok)
+	},
+}
+
+func init() {
+	authCmd.AddCommand(authTokenCmd)
+
+	fs := authTokenCmd.Flags()
+	fs.StringVar(&flagAuthTokenTarget, "target", "camunda_api", "API the token is for, selects its scope (camunda_api, operate_api, tasklist_api, administration_api)")
+	fs.BoolVar(&flagAuthTokenClear, "clear", false, "clear the cached tokens of the configured client instead of printing a token")
+}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var broadcastCmd = &cobra.Command{
+	Use:     "broadcast",
+	Short:   "Broadcast resources like signals",
+	Aliases: []string{"bc"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"broadcst", "brodcast"},
+}
+
+func init() {
+	rootCmd.AddCommand(broadcastCmd)
+
+	addBackoffFlagsAndBindings(broadcastCmd, viper.GetViper())
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/signal"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagBroadcastSignalName string
+	flagBroadcastVars       []string
+	flagBroadcastVerify     bool
+)
+
+var broadcastSignalCmd = &cobra.Command{
+	Use:   "signal",
+	Short: "Broadcast a signal to all subscriptions of the tenant (set with --tenant)",
+	Long: `Broadcast a signal to all subscriptions of the tenant (set with --tenant).
+
+With --verify the intermediate signal catch events waiting for the signal are captured before
+the broadcast, and the command waits (see --backoff-timeout) until all of them progressed.
+Signal boundary events and event sub-processes are not tracked by --verify.`,
+	Aliases: []string{"signals", "sig", "s"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		cfg, err := config.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		vars, err := parseVars(flagBroadcastVars)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+
+		sig := signal.SignalBroadcast{
+			Name:      flagBroadcastSignalName,
+			TenantId:  cfg.App.Tenant,
+			Variables: vars,
+		}
+		log.Debug(fmt.Sprintf("broadcasting signal %q to tenant %q", sig.Name, sig.TenantId))
+		res, err := cli.BroadcastSignal(cmd.Context(), sig, collectOptions()...)
+		if res.SignalKey != "" {
+			if verr := signalBroadcastView(cmd, res); verr != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering broadcast view: %w", verr))
+			}
+		}
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error broadcasting signal %q: %w", sig.Name, err))
+		}
+		if flagBroadcastVerify {
+			log.Info(fmt.Sprintf("signal %q broadcast, %d waiting element instance(s) progressed", sig.Name, len(res.CatchingInstances)))
+		}
+	},
+}
+
+func init() {
+	broadcastCmd.AddCommand(broadcastSignalCmd)
+
+	fs := broadcastSignalCmd.Flags()
+	fs.StringVarP(&flagBroadcastSignalName, "name", "n", "", "signal name as defined in the BPMN model")
+	fs.StringArrayVar(&flagBroadcastVars, "var", nil, "variable as key=value, value is parsed as JSON when possible (repeatable)")
+	fs.BoolVar(&flagBroadcastVerify, "verify", false, "verify that the element instances waiting for the signal progressed after the broadcast")
+
+	_ = broadcastSignalCmd.MarkFlagRequired("name")
+}
@@ -23,6 +23,7 @@ func init() {
 	rootCmd.AddCommand(cancelCmd)
 
 	cancelCmd.PersistentFlags().BoolVarP(&flagCancelWait, "wait", "w", false, "wait for the cancellation to be completed")
+	addConfirmFlag(cancelCmd)
 
 	addBackoffFlagsAndBindings(cancelCmd, viper.GetViper())
 }
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var flagCancelBOKey string
+
+var cancelBatchOperationCmd = &cobra.Command{
+	Use:     "batch-operation",
+	Short:   "Cancel a batch operation by its key (camunda 8.8+)",
+	Aliases: []string{"bo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = confirmDestructive(cmd, "cancel batch operation "+flagCancelBOKey, 1); err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = cli.CancelBatchOperation(cmd.Context(), flagCancelBOKey); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error cancelling batch operation %s: %w", flagCancelBOKey, err))
+		}
+		log.Info(fmt.Sprintf("batch operation %s canceled", flagCancelBOKey))
+	},
+}
+
+func init() {
+	cancelCmd.AddCommand(cancelBatchOperationCmd)
+
+	cancelBatchOperationCmd.Flags().StringVarP(&flagCancelBOKey, "key", "k", "", "batch operation key to cancel")
+	_ = cancelBatchOperationCmd.MarkFlagRequired("key")
+}
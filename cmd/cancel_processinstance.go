@@ -2,26 +2,63 @@ package cmd
 
 import (
 	"fmt"
+	"log/slog"
 
+	"github.com/grafvonb/kamunder/kamunder"
+	"github.com/grafvonb/kamunder/kamunder/batch"
 	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+	"github.com/grafvonb/kamunder/kamunder/process"
 	"github.com/spf13/cobra"
 )
 
 var (
-	flagCancelPIKey        string
-	flagCancelNoStateCheck bool
+	flagCancelPIKey               string
+	flagCancelNoStateCheck        bool
+	flagCancelPIBpmnProcessID     string
+	flagCancelPIProcessVersion    int32
+	flagCancelPIProcessVersionTag string
+	flagCancelPIParentKey         string
+	flagCancelServerSide          bool
 )
 
 var cancelProcessInstanceCmd = &cobra.Command{
-	Use:     "process-instance",
-	Short:   "Cancel a process instance by its key",
+	Use:   "process-instance",
+	Short: "Cancel a process instance by its key or, server-side, all active instances matching a filter",
+	Long: `Cancel a process instance by its key or, server-side, all active instances matching a filter.
+
+With --server-side (camunda 8.8+) a batch operation cancelling the active instances matching the
+filter is submitted and followed until all items have finished, failed items are printed at the end.
+Of the bulk operations camunda offers as batch, cancel is the one with --server-side; resolve incidents
+always runs as batch operation on 8.8 and kamunder has no migrate or modify commands yet.`,
+	Example: `  kamunder cancel process-instance --key <process-instance-key>
+  kamunder cancel process-instance --bpmn-process-id order-process --process-version 3 --server-side`,
 	Aliases: []string{"pi"},
 	Run: func(cmd *cobra.Command, args []string) {
 		cli, log, err := NewCli(cmd)
 		if err != nil {
 			ferrors.HandleAndExit(log, err)
 		}
-
+		if err = requireAnyFlag(cmd, "key", "bpmn-process-id", "process-version", "process-version-tag", "parent-key"); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: select process instances by key or filter: %w", ferrors.ErrBadRequest, err))
+		}
+		if flagCancelServerSide {
+			cancelProcessInstancesServerSide(cmd, cli, log, process.ProcessInstanceSearchFilterOpts{
+				Key:               flagCancelPIKey,
+				BpmnProcessId:     flagCancelPIBpmnProcessID,
+				ProcessVersion:    flagCancelPIProcessVersion,
+				ProcessVersionTag: flagCancelPIProcessVersionTag,
+				ParentKey:         flagCancelPIParentKey,
+				State:             process.StateActive,
+			})
+			return
+		}
+		if flagCancelPIKey == "" {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: cancelling by filter requires --server-side, or select a single process instance with --key", ferrors.ErrBadRequest))
+		}
+		if err = confirmDestructive(cmd, "cancel process instance "+flagCancelPIKey, 1); err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
 		_, err = cli.CancelProcessInstance(cmd.Context(), flagCancelPIKey, collectOptions()...)
 		if err != nil {
 			ferrors.HandleAndExit(log, fmt.Errorf("cancelling process instance: %w", err))
@@ -29,10 +66,53 @@ var cancelProcessInstanceCmd = &cobra.Command{
 	},
 }
 
+// cancelProcessInstancesServerSide submits a batch cancellation, follows it until it finished and prints failed items.
+func cancelProcessInstancesServerSide(cmd *cobra.Command, cli kamunder.API, log *slog.Logger, filter process.ProcessInstanceSearchFilterOpts) {
+	n := -1
+	if total, err := cli.CountProcessInstances(cmd.Context(), filter); err != nil {
+		log.Warn(fmt.Sprintf("counting process instances to cancel: %v", err))
+	} else {
+		n = int(total)
+	}
+	if err := confirmDestructive(cmd, "submit a batch cancellation of active process instances", n); err != nil {
+		ferrors.HandleAndExit(log, err)
+	}
+	bo, err := cli.CancelProcessInstancesBatch(cmd.Context(), filter, options.WithWait())
+	if err != nil {
+		if bo.Key != "" {
+			log.Info(fmt.Sprintf("batch operation %s can be inspected with: kamunder get batch-operation --key %s", bo.Key, bo.Key))
+		}
+		ferrors.HandleAndExit(log, fmt.Errorf("batch cancellation of process instances: %w", err))
+	}
+	if err = batchOperationView(cmd, bo); err != nil {
+		ferrors.HandleAndExit(log, fmt.Errorf("error rendering batch operation view: %w", err))
+	}
+	if bo.OperationsFailedCount == 0 && bo.State == "COMPLETED" {
+		log.Info(fmt.Sprintf("batch operation %s completed, %d process instance(s) cancelled", bo.Key, bo.OperationsCompletedCount))
+		return
+	}
+	items, err := cli.SearchBatchOperationItems(cmd.Context(), batch.BatchOperationItemSearchFilterOpts{
+		BatchOperationKey: bo.Key,
+		State:             "FAILED",
+	}, maxBatchSearchSize)
+	if err != nil {
+		ferrors.HandleAndExit(log, fmt.Errorf("error fetching failed items of batch operation %s: %w", bo.Key, err))
+	}
+	if err = listBatchOperationItemsView(cmd, items); err != nil {
+		ferrors.HandleAndExit(log, fmt.Errorf("error rendering items view: %w", err))
+	}
+	ferrors.HandleAndExit(log, fmt.Errorf("batch operation %s finished in state %s with %d failed item(s)", bo.Key, bo.State, bo.OperationsFailedCount))
+}
+
 func init() {
 	cancelCmd.AddCommand(cancelProcessInstanceCmd)
 
-	cancelProcessInstanceCmd.Flags().StringVarP(&flagCancelPIKey, "key", "k", "", "process instance key to cancel")
-	_ = cancelProcessInstanceCmd.MarkFlagRequired("key")
-	cancelProcessInstanceCmd.Flags().BoolVar(&flagCancelNoStateCheck, "no-state-check", false, "skip checking the current state of the process instance before cancelling it")
+	fs := cancelProcessInstanceCmd.Flags()
+	fs.StringVarP(&flagCancelPIKey, "key", "k", "", "process instance key to cancel")
+	fs.BoolVar(&flagCancelNoStateCheck, "no-state-check", false, "skip checking the current state of the process instance before cancelling it")
+	fs.StringVarP(&flagCancelPIBpmnProcessID, "bpmn-process-id", "b", "", "BPMN process ID to select active process instances (with --server-side)")
+	fs.Int32VarP(&flagCancelPIProcessVersion, "process-version", "v", 0, "process definition version to select active process instances (with --server-side)")
+	fs.StringVar(&flagCancelPIProcessVersionTag, "process-version-tag", "", "process definition version tag to select active process instances (with --server-side)")
+	fs.StringVar(&flagCancelPIParentKey, "parent-key", "", "parent process instance key to select active process instances (with --server-side)")
+	fs.BoolVar(&flagCancelServerSide, "server-side", false, "submit a server-side batch operation and follow it until finished (camunda 8.8+)")
 }
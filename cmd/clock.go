@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var clockCmd = &cobra.Command{
+	Use:   "clock",
+	Short: "Pin, reset and show the engine clock for time-travel testing",
+	Long: `Pin, reset and show the engine clock for time-travel testing, e.g. to let timer events fire
+on a local Camunda 8 Run. The cluster must run with a controllable clock
+(zeebe.clock.controlled=true, the default of Camunda 8 Run).`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"clok", "time"},
+}
+
+func init() {
+	rootCmd.AddCommand(clockCmd)
+}
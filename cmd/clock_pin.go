@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var flagClockPinTime string
+
+var clockPinCmd = &cobra.Command{
+	Use:   "pin",
+	Short: "Pin the engine clock to a point in time",
+	Long: `Pin the engine clock to a point in time, given as RFC3339 timestamp or as duration relative
+to the local time, e.g. +72h. The clock stays pinned until "clock reset".`,
+	Example: `  kamunder clock pin --time 2025-12-24T00:00:00Z
+  kamunder clock pin --time +72h`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		t, err := parseClockTime(flagClockPinTime, time.Now())
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+		if err = cli.PinClock(cmd.Context(), t); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error pinning clock to %s: %w", t.Format(time.RFC3339), err))
+		}
+		log.Info(fmt.Sprintf("clock pinned to %s", t.Format(time.RFC3339)))
+	},
+}
+
+// parseClockTime accepts an RFC3339 timestamp or a signed duration relative to now, e.g. +72h or -30m.
+func parseClockTime(v string, now time.Time) (time.Time, error) {
+	if v != "" && (v[0] == '+' || v[0] == '-') {
+		if d, err := time.ParseDuration(v); err == nil {
+			return now.Add(d), nil
+		}
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --time %q, expected an RFC3339 timestamp or a duration like +72h", v)
+	}
+	return t, nil
+}
+
+func init() {
+	clockCmd.AddCommand(clockPinCmd)
+
+	clockPinCmd.Flags().StringVarP(&flagClockPinTime, "time", "t", "", "time to pin the clock to: RFC3339 timestamp or duration relative to now like +72h")
+	_ = clockPinCmd.MarkFlagRequired("time")
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var clockResetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Reset the engine clock to the system time",
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = cli.ResetClock(cmd.Context()); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error resetting clock: %w", err))
+		}
+		log.Info("clock reset to system time")
+	},
+}
+
+func init() {
+	clockCmd.AddCommand(clockResetCmd)
+}
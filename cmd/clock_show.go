@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var clockShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the gateway time and its skew to the local clock",
+	Long: `Show the wall clock of the gateway and its skew to the local clock.
+
+The API offers no way to read back a pinned engine clock, so the gateway time shown is its
+system time (from the HTTP Date header, second precision), not the pinned time.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		gw, err := cli.GetGatewayTime(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error fetching gateway time: %w", err))
+		}
+		local := time.Now()
+		if pickMode() == ModeJSON {
+			cmd.Println(ToJSONString(map[string]string{
+				"gatewayTime": gw.Format(time.RFC3339),
+				"localTime":   local.Format(time.RFC3339),
+				"skew":        gw.Sub(local).Round(time.Second).String(),
+			}))
+			return
+		}
+		cmd.Println("gateway time:", gw.Format(time.RFC3339))
+		cmd.Println("local time:  ", local.Format(time.RFC3339))
+		cmd.Println("skew:        ", gw.Sub(local).Round(time.Second))
+	},
+}
+
+func init() {
+	clockCmd.AddCommand(clockShowCmd)
+}
@@ -1,20 +1,80 @@
 package cmd
 
-import "github.com/spf13/cobra"
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
 
 var utilityCommands = map[string]struct{}{
 	"help":       {},
 	"version":    {},
 	"completion": {},
 	"config":     {},
+	"logout":     {},
+	"history":    {},
 }
 
+// isUtilityCommand reports whether cmd or one of its parents, e.g. "config" of "config secret set", needs no connection.
 func isUtilityCommand(cmd *cobra.Command) bool {
-	if cmd == nil {
+	for c := cmd; c != nil && c.HasParent(); c = c.Parent() {
+		if _, ok := utilityCommands[c.Name()]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// mutatingCommands change the state of the cluster and are rejected with read_only, keyed by the
+// command path below the root, e.g. "clock pin". A parent like "delete" covers all of its subcommands.
+var mutatingCommands = map[string]struct{}{
+	"apply":             {},
+	"broadcast":         {},
+	"cancel":            {},
+	"clock pin":         {},
+	"clock reset":       {},
+	"complete":          {},
+	"correlate":         {},
+	"create":            {},
+	"delete":            {},
+	"deploy":            {},
+	"document delete":   {},
+	"document link":     {},
+	"document upload":   {},
+	"evaluate":          {},
+	"fail":              {},
+	"identity assign":   {},
+	"identity create":   {},
+	"identity delete":   {},
+	"identity unassign": {},
+	"identity update":   {},
+	"publish":           {},
+	"resolve":           {},
+	"resume":            {},
+	"suspend":           {},
+	"throw":             {},
+	"update":            {},
+	"worker":            {},
+}
+
+// planOnlyCommands only print what they would change unless --yes is given, so read_only allows them without it.
+var planOnlyCommands = map[string]struct{}{
+	"apply": {},
+}
+
+// isMutatingCommand reports whether cmd or one of its parents is listed in mutatingCommands.
+func isMutatingCommand(cmd *cobra.Command) bool {
+	path := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+	if _, ok := planOnlyCommands[path]; ok && !cmd.Flags().Changed("yes") {
 		return false
 	}
-	_, ok := utilityCommands[cmd.Name()]
-	return ok
+	for c := cmd; c != nil && c.HasParent(); c = c.Parent() {
+		path := strings.TrimPrefix(c.CommandPath(), c.Root().Name()+" ")
+		if _, ok := mutatingCommands[path]; ok {
+			return true
+		}
+	}
+	return false
 }
 
 func hasHelpFlag(cmd *cobra.Command) bool {
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagConfirmYes     bool
+	flagConfirmCluster string
+)
+
+// addConfirmFlag adds --yes and --confirm-cluster, skipping the confirmation of destructive commands on protected profiles.
+func addConfirmFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().BoolVarP(&flagConfirmYes, "yes", "y", false, "do not ask for confirmation on a protected profile")
+	addConfirmClusterFlag(cmd)
+}
+
+// addConfirmClusterFlag adds --confirm-cluster only, for commands like apply whose --yes means something else.
+func addConfirmClusterFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&flagConfirmCluster, "confirm-cluster", "", "cluster name confirming a destructive command on a protected profile instead of typing it")
+}
+
+// confirmDestructive enforces max_items for an operation on n items and, on a protected profile,
+// prints what is about to happen and asks to type the cluster name unless --yes or --confirm-cluster was given.
+// A negative n means the number of items is unknown, which is refused with protected or max_items.
+func confirmDestructive(cmd *cobra.Command, action string, n int) error {
+	cfg, err := config.FromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	if n < 0 && (cfg.Protected || cfg.MaxItems > 0) {
+		return fmt.Errorf("%w: cannot %s, the number of affected items is unknown and the profile is protected or sets max_items", ferrors.ErrBadRequest, action)
+	}
+	if err = cfg.CheckItems(n); err != nil {
+		return fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err)
+	}
+	if !cfg.Protected {
+		return nil
+	}
+	name := cfg.ConfirmationName()
+	out := cmd.ErrOrStderr()
+	_, _ = fmt.Fprintf(out, "protected cluster %s: about to %s, %d item(s) affected\n", name, action, n)
+	switch {
+	case flagConfirmYes || flagConfirmCluster == name:
+		return nil
+	case flagConfirmCluster != "":
+		return fmt.Errorf("%w: --confirm-cluster %s does not match %s, nothing done", ferrors.ErrBadRequest, flagConfirmCluster, name)
+	}
+	_, _ = fmt.Fprintf(out, "type %q to confirm: ", name)
+	line, _ := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if strings.TrimSpace(line) != name {
+		return fmt.Errorf("%w: confirmation for %s did not match, nothing done (use --confirm-cluster in scripts)", ferrors.ErrBadRequest, name)
+	}
+	return nil
+}
+
+// confirmationNeeded reports whether the profile is protected or limits the items, so that commands
+// can skip counting the affected items for confirmDestructive otherwise.
+func confirmationNeeded(cmd *cobra.Command) bool {
+	cfg, err := config.FromContext(cmd.Context())
+	return err != nil || cfg.Protected || cfg.MaxItems > 0
+}
@@ -38,7 +38,6 @@ func addBackoffFlagsAndBindings(cmd *cobra.Command, v *viper.Viper) {
 	v.SetDefault("app.backoff.multiplier", defaultBackoffMultiplier)
 }
 
-//nolint:unused
 func requireAnyFlag(cmd *cobra.Command, flags ...string) error {
 	for _, f := range flags {
 		if cmd.Flags().Changed(f) {
@@ -13,5 +13,8 @@ func collectOptions() []options.FacadeOption {
 	if flagDeleteWithCancel {
 		opts = append(opts, options.WithCancel())
 	}
+	if flagBroadcastVerify {
+		opts = append(opts, options.WithVerify())
+	}
 	return opts
 }
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// parseVars turns repeated key=value flags into a variables map.
+// Values that are valid JSON (numbers, booleans, objects, arrays, quoted strings) are decoded, anything else is kept as plain string.
+func parseVars(pairs []string) (map[string]any, error) {
+	vars := make(map[string]any, len(pairs))
+	for _, p := range pairs {
+		k, v, ok := strings.Cut(p, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid variable %q, expected key=value", p)
+		}
+		var decoded any
+		if err := json.Unmarshal([]byte(v), &decoded); err == nil {
+			vars[k] = decoded
+		} else {
+			vars[k] = v
+		}
+	}
+	return vars, nil
+}
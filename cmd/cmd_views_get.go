@@ -1,10 +1,27 @@
 package cmd
 
 import (
+	"encoding/csv"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/grafvonb/kamunder/internal/services/auth/authenticator"
+	"github.com/grafvonb/kamunder/kamunder/audit"
+	"github.com/grafvonb/kamunder/kamunder/batch"
+	"github.com/grafvonb/kamunder/kamunder/cluster"
+	"github.com/grafvonb/kamunder/kamunder/decision"
+	"github.com/grafvonb/kamunder/kamunder/document"
+	"github.com/grafvonb/kamunder/kamunder/identity"
+	"github.com/grafvonb/kamunder/kamunder/incident"
+	"github.com/grafvonb/kamunder/kamunder/job"
+	"github.com/grafvonb/kamunder/kamunder/message"
 	"github.com/grafvonb/kamunder/kamunder/process"
+	"github.com/grafvonb/kamunder/kamunder/resource"
+	"github.com/grafvonb/kamunder/kamunder/signal"
+	"github.com/grafvonb/kamunder/kamunder/usage"
 	"github.com/spf13/cobra"
 )
 
@@ -128,3 +145,457 @@ func pickMode() RenderMode {
 		return ModeOneLine
 	}
 }
+
+func resourceView(cmd *cobra.Command, item resource.Resource) error {
+	return itemView(cmd, item, pickMode(), oneLineResource, func(it resource.Resource) string { return it.Key })
+}
+
+func oneLineResource(it resource.Resource) string {
+	vTag := ""
+	if it.VersionTag != "" {
+		vTag = "/" + it.VersionTag
+	}
+	return fmt.Sprintf("%-16s %s %s v%d%s %s",
+		it.Key, it.TenantId, it.Id, it.Version, vTag, it.Name,
+	)
+}
+
+func decisionDefinitionView(cmd *cobra.Command, item decision.DecisionDefinition) error {
+	return itemView(cmd, item, pickMode(), oneLineDD, func(it decision.DecisionDefinition) string { return it.Key })
+}
+
+func listDecisionDefinitionsView(cmd *cobra.Command, resp decision.DecisionDefinitions) error {
+	return listOrJSON(cmd, resp, resp.Items, pickMode(), oneLineDD, func(it decision.DecisionDefinition) string { return it.Key })
+}
+
+func oneLineDD(it decision.DecisionDefinition) string {
+	return fmt.Sprintf("%-16s %s %s v%d %s drd:%s",
+		it.Key, it.TenantId, it.DecisionId, it.Version, it.Name, it.DecisionRequirementsId,
+	)
+}
+
+func decisionInstanceView(cmd *cobra.Command, item decision.DecisionInstance) error {
+	return itemView(cmd, item, pickMode(), oneLineDIWithIO, func(it decision.DecisionInstance) string { return it.Id })
+}
+
+func listDecisionInstancesView(cmd *cobra.Command, resp decision.DecisionInstances) error {
+	return listOrJSON(cmd, resp, resp.Items, pickMode(), oneLineDI, func(it decision.DecisionInstance) string { return it.Id })
+}
+
+func oneLineDI(it decision.DecisionInstance) string {
+	piTag := ""
+	if it.ProcessInstanceKey != "" {
+		piTag = " pi:" + it.ProcessInstanceKey
+	}
+	return fmt.Sprintf("%-20s %s %s v%d %s e:%s%s r:%s",
+		it.Id, it.TenantId, it.DecisionId, it.DecisionVersion, it.State, it.EvaluationDate, piTag, it.Result,
+	)
+}
+
+func oneLineDIWithIO(it decision.DecisionInstance) string {
+	var b strings.Builder
+	b.WriteString(oneLineDI(it))
+	if it.EvaluationFailure != "" {
+		b.WriteString("\n  failure: " + it.EvaluationFailure)
+	}
+	writeDecisionIO(&b, it.EvaluatedInputs, it.EvaluatedOutputs)
+	return b.String()
+}
+
+func decisionEvaluationView(cmd *cobra.Command, item decision.DecisionEvaluationResult) error {
+	return itemView(cmd, item, pickMode(), oneLineDecisionEvaluation, func(it decision.DecisionEvaluationResult) string { return it.DecisionInstanceKey })
+}
+
+func oneLineDecisionEvaluation(it decision.DecisionEvaluationResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-16s %s %s v%d output:%s", it.DecisionInstanceKey, it.TenantId, it.DecisionId, it.DecisionVersion, it.Output)
+	if it.FailureMessage != "" {
+		fmt.Fprintf(&b, "\n  failed: %s: %s", it.FailedDecisionId, it.FailureMessage)
+	}
+	for _, ed := range it.EvaluatedDecisions {
+		fmt.Fprintf(&b, "\n  decision %s v%d (%s) output:%s", ed.DecisionId, ed.DecisionVersion, ed.DecisionType, ed.Output)
+		writeDecisionIO(&b, ed.EvaluatedInputs, ed.EvaluatedOutputs)
+	}
+	return b.String()
+}
+
+func writeDecisionIO(b *strings.Builder, ins []decision.DecisionInput, outs []decision.DecisionOutput) {
+	for _, in := range ins {
+		fmt.Fprintf(b, "\n  in  %s (%s) = %s", in.Name, in.Id, in.Value)
+	}
+	for _, out := range outs {
+		fmt.Fprintf(b, "\n  out %s (%s) = %s [rule %d %s]", out.Name, out.Id, out.Value, out.RuleIndex, out.RuleId)
+	}
+}
+
+func messagePublicationView(cmd *cobra.Command, item message.MessagePublicationResult) error {
+	return itemView(cmd, item, pickMode(), func(it message.MessagePublicationResult) string {
+		return fmt.Sprintf("%-16s %s published", it.MessageKey, it.TenantId)
+	}, func(it message.MessagePublicationResult) string { return it.MessageKey })
+}
+
+func messageCorrelationView(cmd *cobra.Command, item message.MessageCorrelationResult) error {
+	return itemView(cmd, item, pickMode(), func(it message.MessageCorrelationResult) string {
+		return fmt.Sprintf("%-16s %s correlated pi:%s", it.MessageKey, it.TenantId, it.ProcessInstanceKey)
+	}, func(it message.MessageCorrelationResult) string { return it.MessageKey })
+}
+
+func listMessageSubscriptionsView(cmd *cobra.Command, resp message.MessageSubscriptions) error {
+	return listOrJSON(cmd, resp, resp.Items, pickMode(), oneLineMS, func(it message.MessageSubscription) string { return it.Key })
+}
+
+func oneLineMS(it message.MessageSubscription) string {
+	return fmt.Sprintf("%-16s %s %s ck:%s %s pi:%s %s/%s u:%s",
+		it.Key, it.TenantId, it.MessageName, it.CorrelationKey, it.State,
+		it.ProcessInstanceKey, it.ProcessDefinitionId, it.ElementId, it.LastUpdatedDate,
+	)
+}
+
+func listCorrelatedMessageSubscriptionsView(cmd *cobra.Command, resp message.CorrelatedMessageSubscriptions) error {
+	return listOrJSON(cmd, resp, resp.Items, pickMode(), oneLineCMS, func(it message.CorrelatedMessageSubscription) string { return it.MessageKey })
+}
+
+func oneLineCMS(it message.CorrelatedMessageSubscription) string {
+	return fmt.Sprintf("%-16s %s %s ck:%s pi:%s %s/%s c:%s",
+		it.MessageKey, it.TenantId, it.MessageName, it.CorrelationKey,
+		it.ProcessInstanceKey, it.ProcessDefinitionId, it.ElementId, it.CorrelationTime,
+	)
+}
+
+func signalBroadcastView(cmd *cobra.Command, item signal.SignalBroadcastResult) error {
+	return itemView(cmd, item, pickMode(), oneLineSignalBroadcast, func(it signal.SignalBroadcastResult) string { return it.SignalKey })
+}
+
+func oneLineSignalBroadcast(it signal.SignalBroadcastResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-16s %s broadcast", it.SignalKey, it.TenantId)
+	if len(it.CatchingInstances) > 0 || it.Verified {
+		fmt.Fprintf(&b, " verified:%t", it.Verified)
+	}
+	for _, ci := range it.CatchingInstances {
+		fmt.Fprintf(&b, "\n  %-16s pi:%s %s %s -> %s", ci.Key, ci.ProcessInstanceKey, ci.ElementId, ci.StateBefore, ci.StateAfter)
+	}
+	return b.String()
+}
+
+func listJobsView(cmd *cobra.Command, resp job.Jobs) error {
+	return listOrJSON(cmd, resp, resp.Items, pickMode(), oneLineJob, func(it job.Job) string { return it.Key })
+}
+
+func oneLineJob(it job.Job) string {
+	eTag := ""
+	if it.ErrorMessage != "" {
+		eTag = " err:" + it.ErrorMessage
+	}
+	return fmt.Sprintf("%-16s %s %s %s r:%d pi:%s %s/%s w:%s%s",
+		it.Key, it.TenantId, it.Type, it.State, it.Retries,
+		it.ProcessInstanceKey, it.ProcessDefinitionId, it.ElementId, it.Worker, eTag,
+	)
+}
+
+func listJobActionResultsView(cmd *cobra.Command, items []job.JobActionResult) error {
+	return listOrJSON(cmd, items, items, pickMode(), func(it job.JobActionResult) string {
+		if it.Ok {
+			return fmt.Sprintf("%-16s ok", it.Key)
+		}
+		return fmt.Sprintf("%-16s failed: %s", it.Key, it.Error)
+	}, func(it job.JobActionResult) string { return it.Key })
+}
+
+func oneLineJobWorkerResult(it job.JobWorkerResult) string {
+	status := "completed"
+	if !it.Completed {
+		status = "failed: " + it.Error
+	}
+	return fmt.Sprintf("%-16s %s pi:%s %s", it.Key, it.Type, it.ProcessInstanceKey, status)
+}
+
+func batchOperationView(cmd *cobra.Command, item batch.BatchOperation) error {
+	return itemView(cmd, item, pickMode(), oneLineBO, func(it batch.BatchOperation) string { return it.Key })
+}
+
+func listBatchOperationsView(cmd *cobra.Command, resp batch.BatchOperations) error {
+	return listOrJSON(cmd, resp, resp.Items, pickMode(), oneLineBO, func(it batch.BatchOperation) string { return it.Key })
+}
+
+func oneLineBO(it batch.BatchOperation) string {
+	eTag := ""
+	if len(it.Errors) > 0 {
+		eTag = " err:" + strings.Join(it.Errors, "; ")
+	}
+	return fmt.Sprintf("%-16s %s %s total:%d completed:%d failed:%d s:%s e:%s%s",
+		it.Key, it.Type, it.State, it.OperationsTotalCount, it.OperationsCompletedCount, it.OperationsFailedCount,
+		it.StartDate, it.EndDate, eTag,
+	)
+}
+
+func listBatchOperationItemsView(cmd *cobra.Command, resp batch.BatchOperationItems) error {
+	return listOrJSON(cmd, resp, resp.Items, pickMode(), oneLineBOItem, func(it batch.BatchOperationItem) string { return it.ItemKey })
+}
+
+func oneLineBOItem(it batch.BatchOperationItem) string {
+	eTag := ""
+	if it.ErrorMessage != "" {
+		eTag = " err:" + it.ErrorMessage
+	}
+	return fmt.Sprintf("%-16s %s %s pi:%s p:%s%s",
+		it.ItemKey, it.OperationType, it.State, it.ProcessInstanceKey, it.ProcessedDate, eTag,
+	)
+}
+
+func incidentResolutionView(cmd *cobra.Command, r incident.IncidentResolution) error {
+	if pickMode() == ModeJSON {
+		cmd.Println(ToJSONString(r))
+		return nil
+	}
+	bTag := ""
+	if r.BatchOperationKey != "" {
+		bTag = " batch:" + r.BatchOperationKey
+	}
+	cmd.Printf("incidents:%d process-instances:%d failed:%d pending:%d%s\n", r.Incidents, len(r.ProcessInstanceKeys), len(r.Failures), len(r.Pending), bTag)
+	for _, f := range r.Failures {
+		cmd.Printf("failed  %-16s pi:%s %s\n", f.IncidentKey, f.ProcessInstanceKey, f.Error)
+	}
+	for _, k := range r.Pending {
+		cmd.Printf("pending pi:%s\n", k)
+	}
+	return nil
+}
+
+func identityView(cmd *cobra.Command, item identity.Identity) error {
+	return itemView(cmd, item, pickMode(), oneLineIdentity, func(it identity.Identity) string { return it.Id })
+}
+
+func listIdentitiesView(cmd *cobra.Command, resp identity.Identities) error {
+	return listOrJSON(cmd, resp, resp.Items, pickMode(), oneLineIdentity, func(it identity.Identity) string { return it.Id })
+}
+
+func oneLineIdentity(it identity.Identity) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-24s %s", it.Id, it.Kind)
+	if it.Name != "" {
+		fmt.Fprintf(&b, " n:%q", it.Name)
+	}
+	if it.Email != "" {
+		b.WriteString(" e:" + it.Email)
+	}
+	if it.Description != "" {
+		fmt.Fprintf(&b, " d:%q", it.Description)
+	}
+	if it.ClaimName != "" {
+		fmt.Fprintf(&b, " claim:%s=%s", it.ClaimName, it.ClaimValue)
+	}
+	return b.String()
+}
+
+func authorizationView(cmd *cobra.Command, item identity.Authorization) error {
+	return itemView(cmd, item, pickMode(), oneLineAuthorization, func(it identity.Authorization) string { return it.Key })
+}
+
+func listAuthorizationsView(cmd *cobra.Command, resp identity.Authorizations) error {
+	return listOrJSON(cmd, resp, resp.Items, pickMode(), oneLineAuthorization, func(it identity.Authorization) string { return it.Key })
+}
+
+func oneLineAuthorization(it identity.Authorization) string {
+	return fmt.Sprintf("%-16s %s:%s %s:%s p:%s",
+		it.Key, it.OwnerType, it.OwnerId, it.ResourceType, it.ResourceId, strings.Join(it.Permissions, ","),
+	)
+}
+
+func identityPlanView(cmd *cobra.Command, p identity.Plan) error {
+	if pickMode() == ModeJSON {
+		cmd.Println(ToJSONString(p))
+		return nil
+	}
+	if p.IsEmpty() {
+		cmd.Println("no changes, the cluster matches the desired state")
+		return nil
+	}
+	cmd.Println("planned changes:", len(p.Changes))
+	for _, c := range p.Changes {
+		cmd.Println(planSymbol(c.Action), c.String())
+	}
+	return nil
+}
+
+func planSymbol(a identity.Action) string {
+	switch a {
+	case identity.ActionCreate, identity.ActionAssign:
+		return "+"
+	case identity.ActionDelete, identity.ActionUnassign:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+func healthReportView(cmd *cobra.Command, r cluster.HealthReport) error {
+	if pickMode() == ModeJSON {
+		cmd.Println(ToJSONString(r))
+		return nil
+	}
+	cmd.Println("cluster health:", r.Status)
+	for _, c := range r.Checks {
+		cmd.Println(fmt.Sprintf("  %-5s %-24s %s", c.Status, c.Name, c.Detail))
+	}
+	return nil
+}
+
+func licenseView(cmd *cobra.Command, l cluster.License) error {
+	if pickMode() == ModeJSON {
+		cmd.Println(ToJSONString(l))
+		return nil
+	}
+	validity := "invalid"
+	if l.ValidLicense {
+		validity = "valid"
+	}
+	kind := "non-commercial"
+	if l.IsCommercial {
+		kind = "commercial"
+	}
+	expiry := "no expiry"
+	if l.ExpiresAt != "" {
+		expiry = "expires " + l.ExpiresAt
+	}
+	cmd.Println(fmt.Sprintf("license: %s (%s), %s, %s", l.LicenseType, kind, validity, expiry))
+	return nil
+}
+
+func usageReportView(cmd *cobra.Command, r usage.UsageReport) error {
+	if pickMode() == ModeJSON {
+		cmd.Println(ToJSONString(r))
+		return nil
+	}
+	cmd.Println(fmt.Sprintf("usage metrics (%s) from %s to %s", r.Source, r.From.Format(time.RFC3339), r.To.Format(time.RFC3339)))
+	current := ""
+	for _, m := range r.Rows {
+		if name := usageClusterLabel(m); name != current {
+			current = name
+			if current != "" {
+				cmd.Println("cluster:", current)
+			}
+		}
+		cmd.Println(oneLineUsageMetrics(m))
+	}
+	return nil
+}
+
+func oneLineUsageMetrics(m usage.UsageMetrics) string {
+	return fmt.Sprintf("  %-7s process instances: %-10d decision instances: %-10d assignees: %d",
+		m.Period, m.ProcessInstances, m.DecisionInstances, m.Assignees)
+}
+
+func usageClusterLabel(m usage.UsageMetrics) string {
+	switch {
+	case m.ClusterName != "" && m.ClusterId != "":
+		return fmt.Sprintf("%s (%s)", m.ClusterName, m.ClusterId)
+	case m.ClusterName != "":
+		return m.ClusterName
+	default:
+		return m.ClusterId
+	}
+}
+
+// usageReportCSV writes one record per report row with a header line, ready for spreadsheets.
+func usageReportCSV(w io.Writer, r usage.UsageReport) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"cluster_id", "cluster_name", "period", "from", "to", "process_instances", "decision_instances", "assignees"})
+	for _, m := range r.Rows {
+		_ = cw.Write([]string{
+			m.ClusterId,
+			m.ClusterName,
+			m.Period,
+			m.From.Format(time.RFC3339),
+			m.To.Format(time.RFC3339),
+			strconv.FormatInt(m.ProcessInstances, 10),
+			strconv.FormatInt(m.DecisionInstances, 10),
+			strconv.FormatInt(m.Assignees, 10),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func documentReferenceView(cmd *cobra.Command, ref document.DocumentReference) error {
+	return itemView(cmd, ref, pickMode(), oneLineDocumentReference, func(r document.DocumentReference) string { return r.DocumentId })
+}
+
+func documentBatchView(cmd *cobra.Command, b document.DocumentBatch) error {
+	if err := listOrJSON(cmd, b, b.Created, pickMode(), oneLineDocumentReference, func(r document.DocumentReference) string { return r.DocumentId }); err != nil {
+		return err
+	}
+	if pickMode() == ModeJSON {
+		return nil
+	}
+	for _, f := range b.Failed {
+		cmd.Println(fmt.Sprintf("failed: %s: %s", f.FileName, f.Detail))
+	}
+	return nil
+}
+
+func oneLineDocumentReference(r document.DocumentReference) string {
+	return fmt.Sprintf("%-36s %-24s %-24s %8d bytes store:%s hash:%s",
+		r.DocumentId, r.Metadata.FileName, r.Metadata.ContentType, r.Metadata.Size, r.StoreId, r.ContentHash)
+}
+
+func documentLinkView(cmd *cobra.Command, l document.DocumentLink) error {
+	if pickMode() == ModeJSON {
+		cmd.Println(ToJSONString(l))
+		return nil
+	}
+	cmd.Println(l.Url)
+	if l.ExpiresAt != "" {
+		cmd.Println("expires:", l.ExpiresAt)
+	}
+	return nil
+}
+
+func authStatusView(cmd *cobra.Command, mode string, s *authenticator.Session) error {
+	if pickMode() == ModeJSON {
+		cmd.Println(ToJSONString(struct {
+			Mode    string                 `json:"mode"`
+			Session *authenticator.Session `json:"session,omitempty"`
+		}{mode, s}))
+		return nil
+	}
+	if s == nil {
+		cmd.Println(fmt.Sprintf("auth mode: %s (no user login)", mode))
+		return nil
+	}
+	if !s.LoggedIn {
+		cmd.Println(fmt.Sprintf("auth mode: %s, not logged in", mode))
+		return nil
+	}
+	line := fmt.Sprintf("auth mode: %s, logged in%s", mode, subjectSuffix(*s))
+	if !s.ExpiresAt.IsZero() {
+		line += ", access token expires " + s.ExpiresAt.Local().Format(time.RFC3339)
+	}
+	if s.Refreshable {
+		line += ", refreshable"
+	} else {
+		line += ", not refreshable"
+	}
+	cmd.Println(line)
+	return nil
+}
+
+func listAuditEntriesView(cmd *cobra.Command, entries []audit.Entry) error {
+	return listOrJSON(cmd, entries, entries, pickMode(), oneLineAuditEntry, func(it audit.Entry) string { return strings.Join(it.Keys, ",") })
+}
+
+func oneLineAuditEntry(it audit.Entry) string {
+	profile := ""
+	if it.Profile != "" {
+		profile = " profile:" + it.Profile
+	}
+	keys := ""
+	if len(it.Keys) > 0 {
+		keys = " " + strings.Join(it.Keys, ",")
+	}
+	errMsg := ""
+	if it.Error != "" {
+		errMsg = ": " + strings.Join(strings.Fields(it.Error), " ")
+	}
+	return fmt.Sprintf("%s %s%s %s%s %s%s", it.Time.Local().Format(time.RFC3339), it.User, profile, it.Operation, keys, it.Outcome, errMsg)
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var completeCmd = &cobra.Command{
+	Use:     "complete",
+	Short:   "Complete resources like jobs",
+	Aliases: []string{"comp"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"compelte", "complet"},
+}
+
+func init() {
+	rootCmd.AddCommand(completeCmd)
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagCompleteJobKey  string
+	flagCompleteJobVars []string
+)
+
+var completeJobCmd = &cobra.Command{
+	Use:     "job",
+	Short:   "Complete a job manually, optionally with variables",
+	Aliases: []string{"j"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		vars, err := parseVars(flagCompleteJobVars)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+		if err = cli.CompleteJob(cmd.Context(), flagCompleteJobKey, vars); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error completing job %s: %w", flagCompleteJobKey, err))
+		}
+		log.Info(fmt.Sprintf("job %s completed successfully", flagCompleteJobKey))
+	},
+}
+
+func init() {
+	completeCmd.AddCommand(completeJobCmd)
+
+	fs := completeJobCmd.Flags()
+	fs.StringVarP(&flagCompleteJobKey, "key", "k", "", "job key to complete")
+	fs.StringArrayVar(&flagCompleteJobVars, "var", nil, "variable as key=value, value is parsed as JSON when possible (repeatable)")
+
+	_ = completeJobCmd.MarkFlagRequired("key")
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/grafvonb/kamunder/config"
 	"github.com/grafvonb/kamunder/kamunder/ferrors"
@@ -26,6 +27,7 @@ var configCmd = &cobra.Command{
 		}
 
 		if flagConfigValidate {
+			cfg.ResolveSecrets()
 			err = cfg.Validate()
 			if err != nil {
 				ferrors.HandleAndExit(log, fmt.Errorf("configuration is invalid:\n%w", err))
@@ -52,8 +54,119 @@ var configCmd = &cobra.Command{
 	},
 }
 
+var configListProfilesCmd = &cobra.Command{
+	Use:     "list-profiles",
+	Short:   "List the profiles of the config file, the active one marked with *",
+	Aliases: []string{"profiles", "get-profiles"},
+	Run: func(cmd *cobra.Command, args []string) {
+		log := logging.FromContext(cmd.Context())
+		cfg, err := config.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("config from context: %w", err))
+		}
+		if pickMode() == ModeJSON {
+			cmd.Println(ToJSONString(map[string]any{
+				"profiles":        cfg.ProfileNames(),
+				"current_profile": cfg.CurrentProfile,
+				"active_profile":  cfg.ActiveProfile,
+			}))
+			return
+		}
+		for _, n := range cfg.ProfileNames() {
+			mark := " "
+			if n == cfg.ActiveProfile {
+				mark = "*"
+			}
+			line := mark + " " + n
+			if strings.EqualFold(n, cfg.CurrentProfile) {
+				line += " (current)"
+			}
+			cmd.Println(line)
+		}
+	},
+}
+
+var configUseProfileCmd = &cobra.Command{
+	Use:     "use-profile <name>",
+	Short:   "Make a profile the current one (current_profile in the config file)",
+	Aliases: []string{"use"},
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		log := logging.FromContext(cmd.Context())
+		cfg, err := config.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("config from context: %w", err))
+		}
+		name := strings.ToLower(args[0])
+		if _, ok := cfg.Profiles[name]; !ok {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: profile %q not found (available: %s)", ferrors.ErrBadRequest, name, strings.Join(cfg.ProfileNames(), ", ")))
+		}
+		path, err := configFilePath(cfg)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = config.SetFileValue(path, "current_profile", name); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error writing config file: %w", err))
+		}
+		log.Info(fmt.Sprintf("switched to profile %s in %s", name, path))
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key>=<value>...",
+	Short: "Set values in the config file, in a profile with --profile",
+	Long: `Set values in the config file, in a profile with --profile (created if missing).
+Keys are dot separated like in the config file, e.g. apis.camunda_api.base_url.`,
+	Example: `  kamunder config set apis.camunda_api.base_url=https://prod.example.com/v2 --profile prod
+  kamunder config set auth.mode=oidc-user auth.oidc_user.client_id=kamunder-cli --profile prod`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		log := logging.FromContext(cmd.Context())
+		cfg, err := config.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("config from context: %w", err))
+		}
+		prefix := ""
+		if f := cmd.Flags().Lookup("profile"); f != nil && f.Changed {
+			prefix = "profiles." + strings.ToLower(f.Value.String()) + "."
+		}
+		type kv struct{ key, value string }
+		var sets []kv
+		for _, a := range args {
+			k, v, ok := strings.Cut(a, "=")
+			k = strings.TrimSpace(k)
+			if !ok || k == "" {
+				ferrors.HandleAndExit(log, fmt.Errorf("%w: %q is not key=value", ferrors.ErrBadRequest, a))
+			}
+			if !config.IsKnownKey(k) || strings.HasPrefix(k, "profiles.") || k == "active_profile" {
+				ferrors.HandleAndExit(log, fmt.Errorf("%w: unknown config key %q", ferrors.ErrBadRequest, k))
+			}
+			sets = append(sets, kv{prefix + k, v})
+		}
+		path, err := configFilePath(cfg)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		for _, s := range sets {
+			if err = config.SetFileValue(path, s.key, s.value); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error writing config file: %w", err))
+			}
+			log.Info(fmt.Sprintf("set %s in %s", s.key, path))
+		}
+	},
+}
+
+// configFilePath is the config file in use, or the default location if there is none yet.
+func configFilePath(cfg *config.Config) (string, error) {
+	if cfg.Config != "" {
+		return cfg.Config, nil
+	}
+	return config.DefaultFile()
+}
+
 func init() {
 	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configListProfilesCmd, configUseProfileCmd, configSetCmd)
 
 	configCmd.Flags().BoolVar(&flagConfigShow, "show", false, "Show effective configuration with sensitive values sanitized")
 	configCmd.Flags().BoolVar(&flagConfigValidate, "validate", false, "Validate the effective configuration and exit with an error code if invalid")
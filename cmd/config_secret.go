@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/toolx/logging"
+	"github.com/spf13/cobra"
+)
+
+var configSecretCmd = &cobra.Command{
+	Use:   "secret",
+	Short: "Store secrets in the local encrypted keyring, referenced as keyring:<name> in the config",
+	Long: `Store secrets in the local encrypted keyring, referenced as keyring:<name> in the config,
+e.g. client_secret: "keyring:camunda-prod". The keyring lives in the kamunder dir of the user config dir
+($XDG_CONFIG_HOME/kamunder). It is encrypted with ` + config.KeyringPassphraseEnv + ` if set when the keyring
+is created, otherwise with a random key file next to it.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	Aliases: []string{"secrets", "keyring"},
+}
+
+var configSecretSetCmd = &cobra.Command{
+	Use:     "set <name>",
+	Short:   "Store a secret read from stdin",
+	Example: `  pass show camunda/prod | kamunder config secret set camunda-prod`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		log := logging.FromContext(cmd.Context())
+		kr, err := config.DefaultKeyring()
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		cmd.PrintErrf("secret for %s: ", args[0])
+		line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			ferrors.HandleAndExit(log, fmt.Errorf("error reading secret: %w", err))
+		}
+		cmd.PrintErrln()
+		value := strings.TrimRight(line, "\r\n")
+		if value == "" {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: empty secret", ferrors.ErrBadRequest))
+		}
+		if err = kr.Set(args[0], value); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error storing secret: %w", err))
+		}
+		log.Info(fmt.Sprintf("secret %s stored in %s, reference it as keyring:%s", args[0], kr.Path(), args[0]))
+	},
+}
+
+var configSecretListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List the names of the stored secrets",
+	Aliases: []string{"ls"},
+	Run: func(cmd *cobra.Command, args []string) {
+		log := logging.FromContext(cmd.Context())
+		kr, err := config.DefaultKeyring()
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		names, err := kr.List()
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error reading keyring: %w", err))
+		}
+		for _, n := range names {
+			cmd.Println(n)
+		}
+	},
+}
+
+var configSecretDeleteCmd = &cobra.Command{
+	Use:     "delete <name>",
+	Short:   "Remove a stored secret",
+	Aliases: []string{"rm"},
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		log := logging.FromContext(cmd.Context())
+		kr, err := config.DefaultKeyring()
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = kr.Delete(args[0]); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error deleting secret: %w", err))
+		}
+		log.Info(fmt.Sprintf("secret %s deleted", args[0]))
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configSecretCmd)
+	configSecretCmd.AddCommand(configSecretSetCmd, configSecretListCmd, configSecretDeleteCmd)
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var correlateCmd = &cobra.Command{
+	Use:     "correlate",
+	Short:   "Correlate resources like messages",
+	Aliases: []string{"corr"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"corelate", "correllate"},
+}
+
+func init() {
+	rootCmd.AddCommand(correlateCmd)
+}
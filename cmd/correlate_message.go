@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/message"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagCorrMsgName           string
+	flagCorrMsgCorrelationKey string
+	flagCorrMsgVars           []string
+)
+
+var correlateMessageCmd = &cobra.Command{
+	Use:     "message",
+	Short:   "Correlate a message synchronously and report the process instance it was correlated with",
+	Aliases: []string{"messages", "msg", "m"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		cfg, err := config.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		vars, err := parseVars(flagCorrMsgVars)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+
+		m := message.MessageCorrelation{
+			Name:           flagCorrMsgName,
+			CorrelationKey: flagCorrMsgCorrelationKey,
+			TenantId:       cfg.App.Tenant,
+			Variables:      vars,
+		}
+		log.Debug(fmt.Sprintf("correlating message %q with correlation key %q", m.Name, m.CorrelationKey))
+		res, err := cli.CorrelateMessage(cmd.Context(), m)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error correlating message %q: %w", m.Name, err))
+		}
+		err = messageCorrelationView(cmd, res)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering correlation view: %w", err))
+		}
+	},
+}
+
+func init() {
+	correlateCmd.AddCommand(correlateMessageCmd)
+
+	fs := correlateMessageCmd.Flags()
+	fs.StringVarP(&flagCorrMsgName, "name", "n", "", "message name as defined in the BPMN model")
+	fs.StringVarP(&flagCorrMsgCorrelationKey, "correlation-key", "c", "", "correlation key of the message")
+	fs.StringArrayVar(&flagCorrMsgVars, "var", nil, "variable as key=value, value is parsed as JSON when possible (repeatable)")
+
+	_ = correlateMessageCmd.MarkFlagRequired("name")
+}
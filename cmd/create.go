@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var createCmd = &cobra.Command{
+	Use:     "create",
+	Short:   "Create resources like process instances",
+	Aliases: []string{"new", "start", "run"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"creat", "craete"},
+}
+
+func init() {
+	rootCmd.AddCommand(createCmd)
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/process"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagCreatePIBpmnProcessId string
+	flagCreatePIKey           string
+	flagCreatePIVersion       int32
+	flagCreatePIVars          []string
+	flagCreatePIVarDocs       []string
+)
+
+var createProcessInstanceCmd = &cobra.Command{
+	Use:   "process-instance",
+	Short: "Create (start) a process instance, optionally with uploaded documents as variables",
+	Example: `  kamunder create process-instance -b invoice-process --var amount=42
+  kamunder create process-instance -b invoice-process --var-doc invoice=./invoice.pdf`,
+	Aliases: []string{"process-instances", "pi"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		cfg, err := config.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		vars, err := parseVars(flagCreatePIVars)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+		for _, p := range flagCreatePIVarDocs {
+			name, path, ok := strings.Cut(p, "=")
+			name = strings.TrimSpace(name)
+			if !ok || name == "" || path == "" {
+				ferrors.HandleAndExit(log, fmt.Errorf("%w: invalid --var-doc %q, expected name=path", ferrors.ErrBadRequest, p))
+			}
+			ref, err := uploadDocumentFile(cmd.Context(), cli, path)
+			if err != nil {
+				ferrors.HandleAndExit(log, err)
+			}
+			log.Debug(fmt.Sprintf("uploaded %s as document %s for variable %s", path, ref.DocumentId, name))
+			vars[name] = ref
+		}
+
+		data := process.ProcessInstanceData{
+			BpmnProcessId:        flagCreatePIBpmnProcessId,
+			ProcessDefinitionKey: flagCreatePIKey,
+			ProcessVersion:       flagCreatePIVersion,
+			TenantId:             cfg.App.Tenant,
+			Variables:            vars,
+		}
+		pi, err := cli.CreateProcessInstance(cmd.Context(), data)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error creating process instance: %w", err))
+		}
+		if err = processInstanceView(cmd, pi); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering process instance view: %w", err))
+		}
+	},
+}
+
+func init() {
+	createCmd.AddCommand(createProcessInstanceCmd)
+
+	fs := createProcessInstanceCmd.Flags()
+	fs.StringVarP(&flagCreatePIBpmnProcessId, "bpmn-process-id", "b", "", "BPMN process ID of the process definition to start")
+	fs.StringVarP(&flagCreatePIKey, "key", "k", "", "key of the process definition to start")
+	fs.Int32Var(&flagCreatePIVersion, "process-version", 0, "version of the process definition (default: latest)")
+	fs.StringArrayVar(&flagCreatePIVars, "var", nil, "variable as key=value, value is parsed as JSON when possible (repeatable)")
+	fs.StringArrayVar(&flagCreatePIVarDocs, "var-doc", nil, "upload a file and pass its document reference as variable, name=path (repeatable)")
+
+	createProcessInstanceCmd.MarkFlagsOneRequired("bpmn-process-id", "key")
+	createProcessInstanceCmd.MarkFlagsMutuallyExclusive("bpmn-process-id", "key")
+	createProcessInstanceCmd.MarkFlagsMutuallyExclusive("key", "process-version")
+}
@@ -18,5 +18,6 @@ var deleteCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(deleteCmd)
 
+	addConfirmFlag(deleteCmd)
 	addBackoffFlagsAndBindings(deleteCmd, viper.GetViper())
 }
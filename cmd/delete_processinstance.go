@@ -21,6 +21,9 @@ var deleteProcessInstanceCmd = &cobra.Command{
 		if err != nil {
 			ferrors.HandleAndExit(log, err)
 		}
+		if err = confirmDestructive(cmd, "delete process instance "+flagDeletePIKey, 1); err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
 
 		_, err = cli.DeleteProcessInstance(cmd.Context(), flagDeletePIKey, collectOptions()...)
 		if err != nil {
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/grafvonb/kamunder/kamunder"
+	"github.com/grafvonb/kamunder/kamunder/document"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagDocStoreId     string
+	flagDocContentHash string
+)
+
+var documentCmd = &cobra.Command{
+	Use:   "document",
+	Short: "Upload, download, link and delete documents of the cluster's document store",
+	Long: `Upload, download, link and delete documents of the cluster's document store (Camunda 8.8+).
+
+An uploaded document is identified by a document reference. Pass the reference as process variable,
+e.g. with 'kamunder create process-instance --var-doc invoice=./invoice.pdf', to test
+document handling processes end to end.`,
+	Aliases: []string{"documents", "doc", "docs"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"documnet", "dokument"},
+}
+
+func init() {
+	rootCmd.AddCommand(documentCmd)
+}
+
+// addDocumentStoreFlag registers --store-id, the cluster default store is used if empty.
+func addDocumentStoreFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&flagDocStoreId, "store-id", "", "id of the document store (default: the cluster's default store)")
+}
+
+// documentUploadFromFile reads path into an upload, the content type is derived from the file extension or sniffed.
+func documentUploadFromFile(path string) (document.DocumentUpload, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return document.DocumentUpload{}, fmt.Errorf("reading document %s: %w", path, err)
+	}
+	ct := mime.TypeByExtension(filepath.Ext(path))
+	if ct == "" {
+		ct = http.DetectContentType(data)
+	}
+	return document.DocumentUpload{
+		StoreId:  flagDocStoreId,
+		Data:     data,
+		Metadata: document.DocumentMetadata{FileName: filepath.Base(path), ContentType: ct},
+	}, nil
+}
+
+// uploadDocumentFile stores the file at path and returns its reference, ready to be used as variable value.
+func uploadDocumentFile(ctx context.Context, cli kamunder.API, path string) (document.DocumentReference, error) {
+	u, err := documentUploadFromFile(path)
+	if err != nil {
+		return document.DocumentReference{}, err
+	}
+	ref, err := cli.CreateDocument(ctx, u)
+	if err != nil {
+		return document.DocumentReference{}, fmt.Errorf("error uploading document %s: %w", path, err)
+	}
+	return ref, nil
+}
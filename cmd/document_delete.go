@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var documentDeleteCmd = &cobra.Command{
+	Use:     "delete <document-id>",
+	Short:   "Delete a document from the document store",
+	Aliases: []string{"del", "rm"},
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = confirmDestructive(cmd, "delete document "+args[0], 1); err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		if err = cli.DeleteDocument(cmd.Context(), args[0], flagDocStoreId); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error deleting document %s: %w", args[0], err))
+		}
+		log.Info(fmt.Sprintf("document %s deleted", args[0]))
+	},
+}
+
+func init() {
+	documentCmd.AddCommand(documentDeleteCmd)
+
+	addDocumentStoreFlag(documentDeleteCmd)
+	addConfirmFlag(documentDeleteCmd)
+}
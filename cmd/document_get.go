@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var flagDocGetOutput string
+
+var documentGetCmd = &cobra.Command{
+	Use:   "get <document-id>",
+	Short: "Download the content of a document",
+	Example: `  kamunder document get 4f7a... --content-hash c0ffee -o invoice.pdf
+  kamunder document get 4f7a... --content-hash c0ffee > invoice.pdf`,
+	Aliases: []string{"download", "dl"},
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		log.Debug(fmt.Sprintf("downloading document %s", args[0]))
+		content, err := cli.GetDocument(cmd.Context(), args[0], flagDocStoreId, flagDocContentHash)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error downloading document %s: %w", args[0], err))
+		}
+		if flagDocGetOutput == "" {
+			_, _ = cmd.OutOrStdout().Write(content)
+			return
+		}
+		if err = os.WriteFile(flagDocGetOutput, content, 0o644); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error writing document to %s: %w", flagDocGetOutput, err))
+		}
+		log.Info(fmt.Sprintf("document %s written to %s (%d bytes)", args[0], flagDocGetOutput, len(content)))
+	},
+}
+
+func init() {
+	documentCmd.AddCommand(documentGetCmd)
+
+	fs := documentGetCmd.Flags()
+	addDocumentStoreFlag(documentGetCmd)
+	fs.StringVar(&flagDocContentHash, "content-hash", "", "content hash from the document reference")
+	fs.StringVarP(&flagDocGetOutput, "output", "o", "", "file to write the content to (default: stdout)")
+}
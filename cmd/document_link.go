@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/document"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var flagDocLinkTTL time.Duration
+
+var documentLinkCmd = &cobra.Command{
+	Use:     "link <document-id>",
+	Short:   "Create a time limited download link for a document",
+	Example: `  kamunder document link 4f7a... --content-hash c0ffee --ttl 15m`,
+	Aliases: []string{"url", "share"},
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		o := document.DocumentLinkOpts{StoreId: flagDocStoreId, ContentHash: flagDocContentHash, TimeToLive: flagDocLinkTTL}
+		link, err := cli.CreateDocumentLink(cmd.Context(), args[0], o)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error creating link for document %s: %w", args[0], err))
+		}
+		if err = documentLinkView(cmd, link); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering link view: %w", err))
+		}
+	},
+}
+
+func init() {
+	documentCmd.AddCommand(documentLinkCmd)
+
+	fs := documentLinkCmd.Flags()
+	addDocumentStoreFlag(documentLinkCmd)
+	fs.StringVar(&flagDocContentHash, "content-hash", "", "content hash from the document reference")
+	fs.DurationVar(&flagDocLinkTTL, "ttl", 0, "validity of the link, e.g. 15m or 24h (default: the store's default)")
+}
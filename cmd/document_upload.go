@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/document"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagDocUploadId                  string
+	flagDocUploadContentType         string
+	flagDocUploadProcessDefinitionId string
+	flagDocUploadProcessInstanceKey  string
+	flagDocUploadProperties          []string
+	flagDocUploadAsVar               string
+)
+
+var documentUploadCmd = &cobra.Command{
+	Use:   "upload <file>...",
+	Short: "Upload one or more files to the document store and print their document references",
+	Example: `  kamunder document upload ./invoice.pdf
+  kamunder document upload ./invoice.pdf --as-var invoice   # prints invoice=<reference>, usable as --var
+  kamunder document upload ./a.pdf ./b.pdf --property department=sales`,
+	Aliases: []string{"up", "create"},
+	Args:    cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if len(args) > 1 && (flagDocUploadId != "" || flagDocUploadAsVar != "") {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: --document-id and --as-var require a single file", ferrors.ErrBadRequest))
+		}
+		props, err := parseVars(flagDocUploadProperties)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+
+		uploads := make([]document.DocumentUpload, 0, len(args))
+		for _, path := range args {
+			u, err := documentUploadFromFile(path)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+			}
+			u.DocumentId = flagDocUploadId
+			if flagDocUploadContentType != "" {
+				u.Metadata.ContentType = flagDocUploadContentType
+			}
+			u.Metadata.ProcessDefinitionId = flagDocUploadProcessDefinitionId
+			u.Metadata.ProcessInstanceKey = flagDocUploadProcessInstanceKey
+			if len(props) > 0 {
+				u.Metadata.CustomProperties = props
+			}
+			uploads = append(uploads, u)
+		}
+
+		if len(uploads) == 1 {
+			log.Debug(fmt.Sprintf("uploading document %s", args[0]))
+			ref, err := cli.CreateDocument(cmd.Context(), uploads[0])
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error uploading document %s: %w", args[0], err))
+			}
+			if flagDocUploadAsVar != "" {
+				b, err := json.Marshal(ref)
+				if err != nil {
+					ferrors.HandleAndExit(log, fmt.Errorf("error encoding document reference: %w", err))
+				}
+				cmd.Println(flagDocUploadAsVar + "=" + string(b))
+				return
+			}
+			if err = documentReferenceView(cmd, ref); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering document view: %w", err))
+			}
+			return
+		}
+
+		log.Debug(fmt.Sprintf("uploading %d documents", len(uploads)))
+		batch, err := cli.CreateDocuments(cmd.Context(), flagDocStoreId, uploads)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error uploading documents: %w", err))
+		}
+		if err = documentBatchView(cmd, batch); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering document view: %w", err))
+		}
+		if len(batch.Failed) > 0 {
+			ferrors.HandleAndExit(log, fmt.Errorf("%d of %d documents were not uploaded", len(batch.Failed), len(uploads)))
+		}
+	},
+}
+
+func init() {
+	documentCmd.AddCommand(documentUploadCmd)
+
+	fs := documentUploadCmd.Flags()
+	addDocumentStoreFlag(documentUploadCmd)
+	fs.StringVar(&flagDocUploadId, "document-id", "", "id to store the document under (default: generated by the store)")
+	fs.StringVar(&flagDocUploadContentType, "content-type", "", "content type of the file (default: derived from the file)")
+	fs.StringVar(&flagDocUploadProcessDefinitionId, "process-definition-id", "", "BPMN process id the document belongs to")
+	fs.StringVar(&flagDocUploadProcessInstanceKey, "process-instance-key", "", "key of the process instance the document belongs to")
+	fs.StringArrayVar(&flagDocUploadProperties, "property", nil, "custom metadata property as key=value, value is parsed as JSON when possible (repeatable)")
+	fs.StringVar(&flagDocUploadAsVar, "as-var", "", "print the reference as name=<json>, ready to be passed with --var")
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var evaluateCmd = &cobra.Command{
+	Use:     "evaluate",
+	Short:   "Evaluate resources like decisions",
+	Aliases: []string{"eval", "ev"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"evaulate", "evalute"},
+}
+
+func init() {
+	rootCmd.AddCommand(evaluateCmd)
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/kamunder/decision"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagEvalDecisionID  string
+	flagEvalDecisionKey string
+	flagEvalVars        []string
+)
+
+var evaluateDecisionCmd = &cobra.Command{
+	Use:     "decision",
+	Short:   "Evaluate a deployed decision (DMN) with the given variables",
+	Aliases: []string{"decisions", "dd"},
+	Example: `  kamunder evaluate decision --id invoiceClassification --var amount=1200 --var invoiceCategory='"Travel Expenses"'`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		cfg, err := config.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = requireAnyFlag(cmd, "id", "key"); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+		if flagEvalDecisionID != "" && flagEvalDecisionKey != "" {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: --id and --key are mutually exclusive", ferrors.ErrBadRequest))
+		}
+		vars, err := parseVars(flagEvalVars)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+
+		ev := decision.DecisionEvaluation{
+			DecisionId:  flagEvalDecisionID,
+			DecisionKey: flagEvalDecisionKey,
+			TenantId:    cfg.App.Tenant,
+			Variables:   vars,
+		}
+		log.Debug(fmt.Sprintf("evaluating decision %s%s with %d variable(s)", flagEvalDecisionID, flagEvalDecisionKey, len(vars)))
+		res, err := cli.EvaluateDecision(cmd.Context(), ev)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error evaluating decision: %w", err))
+		}
+		err = decisionEvaluationView(cmd, res)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering evaluation view: %w", err))
+		}
+		if res.FailureMessage != "" {
+			ferrors.HandleAndExit(log, fmt.Errorf("decision %s failed: %s", res.FailedDecisionId, res.FailureMessage))
+		}
+	},
+}
+
+func init() {
+	evaluateCmd.AddCommand(evaluateDecisionCmd)
+
+	fs := evaluateDecisionCmd.Flags()
+	fs.StringVarP(&flagEvalDecisionID, "id", "i", "", "decision ID to evaluate (latest version is used)")
+	fs.StringVarP(&flagEvalDecisionKey, "key", "k", "", "decision definition key to evaluate")
+	fs.StringArrayVar(&flagEvalVars, "var", nil, "variable as key=value, value is parsed as JSON when possible (repeatable)")
+}
@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:     "export",
+	Short:   "Export resources to local files",
+	Aliases: []string{"ex", "dump"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"exprot", "exort"},
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/process"
+	"github.com/spf13/cobra"
+)
+
+const defaultExportExt = ".bpmn"
+
+var (
+	flagExportBpmnProcessID string
+	flagExportAllVersions   bool
+	flagExportDir           string
+)
+
+var exportResourceCmd = &cobra.Command{
+	Use:     "resource",
+	Short:   "Export deployed resources of a process definition, one file per version",
+	Aliases: []string{"resources", "res", "r"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		filter := process.ProcessDefinitionSearchFilterOpts{BpmnProcessId: flagExportBpmnProcessID}
+		log.Debug(fmt.Sprintf("searching process definitions by filter: %v", filter))
+		pds, err := cli.SearchProcessDefinitions(cmd.Context(), filter, maxPDSearchSize)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error fetching process definitions: %w", err))
+		}
+		items := selectExportVersions(pds.Items, flagExportAllVersions)
+		if len(items) == 0 {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: no process definitions found for bpmn process id %s", ferrors.ErrNotFound, flagExportBpmnProcessID))
+		}
+		if err = os.MkdirAll(flagExportDir, 0o755); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error creating export directory %s: %w", flagExportDir, err))
+		}
+
+		for _, pd := range items {
+			r, err := cli.GetResource(cmd.Context(), pd.Key)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching resource with key %s: %w", pd.Key, err))
+			}
+			content, err := cli.GetResourceContent(cmd.Context(), pd.Key)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching content of resource with key %s: %w", pd.Key, err))
+			}
+			path := filepath.Join(flagExportDir, exportFileName(pd, r.Name))
+			if err = os.WriteFile(path, content, 0o644); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error writing resource with key %s to %s: %w", pd.Key, path, err))
+			}
+			log.Info(fmt.Sprintf("exported %s v%d (key %s) to %s", pd.BpmnProcessId, pd.Version, pd.Key, path))
+		}
+		log.Info(fmt.Sprintf("exported %d resource(s) to %s", len(items), flagExportDir))
+	},
+}
+
+func init() {
+	exportCmd.AddCommand(exportResourceCmd)
+
+	fs := exportResourceCmd.Flags()
+	fs.StringVarP(&flagExportBpmnProcessID, "bpmn-process-id", "b", "", "BPMN process ID of the process definition to export")
+	_ = exportResourceCmd.MarkFlagRequired("bpmn-process-id")
+	fs.BoolVar(&flagExportAllVersions, "all-versions", false, "export all deployed versions instead of the latest one only")
+	fs.StringVarP(&flagExportDir, "dir", "d", ".", "directory to write the exported files to")
+}
+
+// selectExportVersions returns the definitions sorted by tenant and version, reduced to the latest version per tenant unless all is set.
+func selectExportVersions(items []process.ProcessDefinition, all bool) []process.ProcessDefinition {
+	out := make([]process.ProcessDefinition, len(items))
+	copy(out, items)
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].TenantId != out[j].TenantId {
+			return out[i].TenantId < out[j].TenantId
+		}
+		return out[i].Version < out[j].Version
+	})
+	if all {
+		return out
+	}
+	latest := make([]process.ProcessDefinition, 0, len(out))
+	for i, pd := range out {
+		if i+1 < len(out) && out[i+1].TenantId == pd.TenantId {
+			continue
+		}
+		latest = append(latest, pd)
+	}
+	return latest
+}
+
+// exportFileName builds a deterministic file name like <tenant>_<bpmnProcessId>_v<version>.<ext>.
+// The tenant prefix is omitted for the default tenant.
+func exportFileName(pd process.ProcessDefinition, resourceName string) string {
+	ext := filepath.Ext(resourceName)
+	if ext == "" {
+		ext = defaultExportExt
+	}
+	name := fmt.Sprintf("%s_v%d%s", pd.BpmnProcessId, pd.Version, ext)
+	if pd.TenantId != "" && pd.TenantId != "<default>" {
+		name = pd.TenantId + "_" + name
+	}
+	return name
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var failCmd = &cobra.Command{
+	Use:   "fail",
+	Short: "Fail resources like jobs",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"fial"},
+}
+
+func init() {
+	rootCmd.AddCommand(failCmd)
+}
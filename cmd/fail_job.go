@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/job"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagFailJobKey          string
+	flagFailJobErrorMessage string
+	flagFailJobRetries      int32
+	flagFailJobRetryBackoff time.Duration
+	flagFailJobVars         []string
+)
+
+var failJobCmd = &cobra.Command{
+	Use:     "job",
+	Short:   "Fail a job; with no retries left an incident is raised",
+	Aliases: []string{"j"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		vars, err := parseVars(flagFailJobVars)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+		f := job.JobFailure{
+			ErrorMessage: flagFailJobErrorMessage,
+			Retries:      flagFailJobRetries,
+			RetryBackOff: flagFailJobRetryBackoff.Milliseconds(),
+			Variables:    vars,
+		}
+		if err = cli.FailJob(cmd.Context(), flagFailJobKey, f); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error failing job %s: %w", flagFailJobKey, err))
+		}
+		log.Info(fmt.Sprintf("job %s failed with %d retries left", flagFailJobKey, flagFailJobRetries))
+	},
+}
+
+func init() {
+	failCmd.AddCommand(failJobCmd)
+
+	fs := failJobCmd.Flags()
+	fs.StringVarP(&flagFailJobKey, "key", "k", "", "job key to fail")
+	fs.StringVarP(&flagFailJobErrorMessage, "error-message", "m", "", "error message describing the failure")
+	fs.Int32Var(&flagFailJobRetries, "retries", 0, "retries left for the job (0 raises an incident)")
+	fs.DurationVar(&flagFailJobRetryBackoff, "retry-backoff", 0, "back-off before the job is retried, e.g. 30s")
+	fs.StringArrayVar(&flagFailJobVars, "var", nil, "variable as key=value, value is parsed as JSON when possible (repeatable)")
+
+	_ = failJobCmd.MarkFlagRequired("key")
+}
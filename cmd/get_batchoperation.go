@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/grafvonb/kamunder/kamunder/batch"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+const maxBatchSearchSize int32 = 1000
+
+var (
+	batchOperationStates     = []string{"created", "active", "suspended", "completed", "partially_completed", "canceled", "failed"}
+	batchOperationItemStates = []string{"active", "completed", "canceled", "failed"}
+)
+
+var (
+	flagBOKey       string
+	flagBOType      string
+	flagBOState     string
+	flagBOItems     bool
+	flagBOItemState string
+)
+
+var getBatchOperationCmd = &cobra.Command{
+	Use:   "batch-operation",
+	Short: "Get batch operations and their items (camunda 8.8+)",
+	Example: `  kamunder get batch-operation --state active
+  kamunder get batch-operation --key <batch-operation-key>
+  kamunder get batch-operation --key <batch-operation-key> --items --item-state failed`,
+	Aliases: []string{"batch-operations", "bo", "bos"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		switch {
+		case flagBOItems:
+			if flagBOKey == "" {
+				ferrors.HandleAndExit(log, fmt.Errorf("%w: --items requires --key", ferrors.ErrBadRequest))
+			}
+			itemState, err := parseUpperChoice("item-state", flagBOItemState, batchOperationItemStates)
+			if err != nil {
+				ferrors.HandleAndExit(log, err)
+			}
+			items, err := cli.SearchBatchOperationItems(cmd.Context(), batch.BatchOperationItemSearchFilterOpts{
+				BatchOperationKey: flagBOKey,
+				State:             itemState,
+			}, maxBatchSearchSize)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching items of batch operation %s: %w", flagBOKey, err))
+			}
+			if err = listBatchOperationItemsView(cmd, items); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering items view: %w", err))
+			}
+		case flagBOKey != "":
+			bo, err := cli.GetBatchOperation(cmd.Context(), flagBOKey)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching batch operation %s: %w", flagBOKey, err))
+			}
+			if err = batchOperationView(cmd, bo); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering batch operation view: %w", err))
+			}
+		default:
+			state, err := parseUpperChoice("state", flagBOState, batchOperationStates)
+			if err != nil {
+				ferrors.HandleAndExit(log, err)
+			}
+			bos, err := cli.SearchBatchOperations(cmd.Context(), batch.BatchOperationSearchFilterOpts{
+				Type:  strings.ToUpper(flagBOType),
+				State: state,
+			}, maxBatchSearchSize)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching batch operations: %w", err))
+			}
+			if err = listBatchOperationsView(cmd, bos); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering items view: %w", err))
+			}
+			log.Debug(fmt.Sprintf("fetched batch operations: %d", bos.Total))
+		}
+	},
+}
+
+// parseUpperChoice validates v against choices ("all" means no filter) and returns it upper-cased for the API.
+func parseUpperChoice(flag, v string, choices []string) (string, error) {
+	s := strings.ToLower(v)
+	switch {
+	case s == "" || s == "all":
+		return "", nil
+	case slices.Contains(choices, s):
+		return strings.ToUpper(s), nil
+	default:
+		return "", fmt.Errorf("%w: invalid --%s %q, expected one of: all, %s", ferrors.ErrBadRequest, flag, v, strings.Join(choices, ", "))
+	}
+}
+
+func init() {
+	getCmd.AddCommand(getBatchOperationCmd)
+
+	fs := getBatchOperationCmd.Flags()
+	fs.StringVarP(&flagBOKey, "key", "k", "", "batch operation key to fetch")
+	fs.StringVar(&flagBOType, "type", "", "operation type to filter batch operations, e.g. cancel_process_instance, resolve_incident")
+	fs.StringVarP(&flagBOState, "state", "s", "all", "state to filter batch operations: all, "+strings.Join(batchOperationStates, ", "))
+	fs.BoolVar(&flagBOItems, "items", false, "list the items of the batch operation given by --key")
+	fs.StringVar(&flagBOItemState, "item-state", "all", "state to filter batch operation items: all, "+strings.Join(batchOperationItemStates, ", "))
+}
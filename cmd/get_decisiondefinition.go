@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/decision"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+const maxDDSearchSize int32 = 1000
+
+var (
+	flagDDKey             string
+	flagDDDecisionID      string
+	flagDDDecisionVersion int32
+	flagDDName            string
+)
+
+var getDecisionDefinitionCmd = &cobra.Command{
+	Use:     "decision-definition",
+	Short:   "Get deployed decision definitions (DMN)",
+	Aliases: []string{"decisiondefinition", "decision-definitions", "dd", "dds"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		log.Debug("fetching decision definitions")
+		searchFilterOpts := populateDDSearchFilterOpts()
+		if searchFilterOpts.Key != "" {
+			log.Debug(fmt.Sprintf("searching by key: %s", searchFilterOpts.Key))
+			dd, err := cli.GetDecisionDefinitionByKey(cmd.Context(), searchFilterOpts.Key)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching decision definition by key %s: %w", searchFilterOpts.Key, err))
+			}
+			err = decisionDefinitionView(cmd, dd)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering key-only view: %w", err))
+			}
+		} else {
+			log.Debug(fmt.Sprintf("searching by filter: %v", searchFilterOpts))
+			dds, err := cli.SearchDecisionDefinitions(cmd.Context(), searchFilterOpts, maxDDSearchSize)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching decision definitions: %w", err))
+			}
+			err = listDecisionDefinitionsView(cmd, dds)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering items view: %w", err))
+			}
+		}
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getDecisionDefinitionCmd)
+
+	fs := getDecisionDefinitionCmd.Flags()
+	fs.StringVarP(&flagDDKey, "key", "k", "", "decision definition key to fetch")
+	fs.StringVarP(&flagDDDecisionID, "decision-id", "d", "", "decision ID to filter decision definitions")
+	fs.Int32VarP(&flagDDDecisionVersion, "decision-version", "v", 0, "decision definition version")
+	fs.StringVar(&flagDDName, "name", "", "decision name to filter decision definitions")
+}
+
+func populateDDSearchFilterOpts() decision.DecisionDefinitionSearchFilterOpts {
+	var filter decision.DecisionDefinitionSearchFilterOpts
+	if flagDDKey != "" {
+		filter.Key = flagDDKey
+	}
+	if flagDDDecisionID != "" {
+		filter.DecisionId = flagDDDecisionID
+	}
+	if flagDDDecisionVersion != 0 {
+		filter.Version = flagDDDecisionVersion
+	}
+	if flagDDName != "" {
+		filter.Name = flagDDName
+	}
+	return filter
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafvonb/kamunder/kamunder/decision"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+const maxDISearchSize int32 = 1000
+
+var (
+	flagDIId                 string
+	flagDIDecisionID         string
+	flagDIDecisionVersion    int32
+	flagDIProcessInstanceKey string
+	flagDIState              string
+)
+
+var getDecisionInstanceCmd = &cobra.Command{
+	Use:     "decision-instance",
+	Short:   "Get decision instances (DMN evaluations) including their evaluated inputs and outputs",
+	Aliases: []string{"decisioninstance", "decision-instances", "di", "dis"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		log.Debug("fetching decision instances")
+		if flagDIId != "" {
+			log.Debug(fmt.Sprintf("searching by id: %s", flagDIId))
+			di, err := cli.GetDecisionInstanceById(cmd.Context(), flagDIId)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching decision instance by id %s: %w", flagDIId, err))
+			}
+			err = decisionInstanceView(cmd, di)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering id-only view: %w", err))
+			}
+			return
+		}
+		searchFilterOpts, err := populateDISearchFilterOpts()
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		log.Debug(fmt.Sprintf("searching by filter: %v", searchFilterOpts))
+		dis, err := cli.SearchDecisionInstances(cmd.Context(), searchFilterOpts, maxDISearchSize)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error fetching decision instances: %w", err))
+		}
+		err = listDecisionInstancesView(cmd, dis)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering items view: %w", err))
+		}
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getDecisionInstanceCmd)
+
+	fs := getDecisionInstanceCmd.Flags()
+	fs.StringVarP(&flagDIId, "id", "i", "", "decision instance id to fetch (shows evaluated inputs and outputs)")
+	fs.StringVarP(&flagDIDecisionID, "decision-id", "d", "", "decision ID to filter decision instances")
+	fs.Int32VarP(&flagDIDecisionVersion, "decision-version", "v", 0, "decision definition version")
+	fs.StringVar(&flagDIProcessInstanceKey, "pi-key", "", "process instance key that triggered the evaluation")
+	fs.StringVarP(&flagDIState, "state", "s", "all", "state to filter decision instances: all, evaluated, failed")
+}
+
+func populateDISearchFilterOpts() (decision.DecisionInstanceSearchFilterOpts, error) {
+	var filter decision.DecisionInstanceSearchFilterOpts
+	if flagDIDecisionID != "" {
+		filter.DecisionId = flagDIDecisionID
+	}
+	if flagDIDecisionVersion != 0 {
+		filter.DecisionVersion = flagDIDecisionVersion
+	}
+	if flagDIProcessInstanceKey != "" {
+		filter.ProcessInstanceKey = flagDIProcessInstanceKey
+	}
+	switch s := strings.ToLower(flagDIState); s {
+	case "", "all":
+	case "evaluated", "failed":
+		filter.State = strings.ToUpper(s)
+	default:
+		return filter, fmt.Errorf("%w: invalid --state %q, expected one of: all, evaluated, failed", ferrors.ErrBadRequest, flagDIState)
+	}
+	return filter, nil
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/job"
+	"github.com/spf13/cobra"
+)
+
+const maxJobSearchSize int32 = 1000
+
+var jobStates = []string{"created", "completed", "failed", "retries_updated", "timed_out", "canceled", "error_thrown", "migrated"}
+
+var (
+	flagJobKey                string
+	flagJobType               string
+	flagJobState              string
+	flagJobWorker             string
+	flagJobElementID          string
+	flagJobBpmnProcessID      string
+	flagJobProcessInstanceKey string
+)
+
+var getJobCmd = &cobra.Command{
+	Use:     "job",
+	Short:   "Get jobs, e.g. the failed jobs of a process instance",
+	Aliases: []string{"jobs", "j"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		searchFilterOpts, err := populateJobSearchFilterOpts()
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		log.Debug(fmt.Sprintf("searching jobs by filter: %v", searchFilterOpts))
+		jobs, err := cli.SearchJobs(cmd.Context(), searchFilterOpts, maxJobSearchSize)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error fetching jobs: %w", err))
+		}
+		err = listJobsView(cmd, jobs)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering items view: %w", err))
+		}
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getJobCmd)
+
+	addJobFilterFlags(getJobCmd)
+	getJobCmd.Flags().StringVarP(&flagJobKey, "key", "k", "", "job key to fetch")
+}
+
+// addJobFilterFlags registers the job search filter flags shared by the get and update job commands.
+func addJobFilterFlags(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.StringVar(&flagJobType, "type", "", "job type to filter jobs")
+	fs.StringVarP(&flagJobState, "state", "s", "all", "state to filter jobs: all, "+strings.Join(jobStates, ", "))
+	fs.StringVar(&flagJobWorker, "worker", "", "worker name to filter jobs")
+	fs.StringVar(&flagJobElementID, "element-id", "", "BPMN element ID to filter jobs")
+	fs.StringVarP(&flagJobBpmnProcessID, "bpmn-process-id", "b", "", "BPMN process ID to filter jobs")
+	fs.StringVar(&flagJobProcessInstanceKey, "pi-key", "", "process instance key to filter jobs")
+}
+
+func populateJobSearchFilterOpts() (job.JobSearchFilterOpts, error) {
+	var filter job.JobSearchFilterOpts
+	if flagJobKey != "" {
+		filter.Key = flagJobKey
+	}
+	if flagJobType != "" {
+		filter.Type = flagJobType
+	}
+	if flagJobWorker != "" {
+		filter.Worker = flagJobWorker
+	}
+	if flagJobElementID != "" {
+		filter.ElementId = flagJobElementID
+	}
+	if flagJobBpmnProcessID != "" {
+		filter.ProcessDefinitionId = flagJobBpmnProcessID
+	}
+	if flagJobProcessInstanceKey != "" {
+		filter.ProcessInstanceKey = flagJobProcessInstanceKey
+	}
+	s := strings.ToLower(flagJobState)
+	switch {
+	case s == "" || s == "all":
+	case slices.Contains(jobStates, s):
+		filter.State = strings.ToUpper(s)
+	default:
+		return filter, fmt.Errorf("%w: invalid --state %q, expected one of: all, %s", ferrors.ErrBadRequest, flagJobState, strings.Join(jobStates, ", "))
+	}
+	return filter, nil
+}
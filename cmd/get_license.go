@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var getLicenseCmd = &cobra.Command{
+	Use:     "license",
+	Short:   "Get the license status of the connected Camunda 8 cluster",
+	Aliases: []string{"lic"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		log.Debug("fetching license")
+		l, err := cli.GetLicense(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error fetching license: %w", err))
+		}
+		if err = licenseView(cmd, l); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering license view: %w", err))
+		}
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getLicenseCmd)
+}
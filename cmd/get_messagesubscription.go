@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/message"
+	"github.com/spf13/cobra"
+)
+
+const maxMSSearchSize int32 = 1000
+
+var (
+	flagMSMessageName        string
+	flagMSCorrelationKey     string
+	flagMSProcessInstanceKey string
+	flagMSState              string
+	flagMSCorrelated         bool
+)
+
+var getMessageSubscriptionCmd = &cobra.Command{
+	Use:     "message-subscription",
+	Short:   "Get message subscriptions, i.e. which process instances wait for which message and correlation key",
+	Aliases: []string{"message-subscriptions", "ms", "mss"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		searchFilterOpts, err := populateMSSearchFilterOpts()
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if flagMSCorrelated {
+			log.Debug(fmt.Sprintf("searching correlated message subscriptions by filter: %v", searchFilterOpts))
+			cms, err := cli.SearchCorrelatedMessageSubscriptions(cmd.Context(), searchFilterOpts, maxMSSearchSize)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching correlated message subscriptions: %w", err))
+			}
+			err = listCorrelatedMessageSubscriptionsView(cmd, cms)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering items view: %w", err))
+			}
+			return
+		}
+		log.Debug(fmt.Sprintf("searching message subscriptions by filter: %v", searchFilterOpts))
+		ms, err := cli.SearchMessageSubscriptions(cmd.Context(), searchFilterOpts, maxMSSearchSize)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error fetching message subscriptions: %w", err))
+		}
+		err = listMessageSubscriptionsView(cmd, ms)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering items view: %w", err))
+		}
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getMessageSubscriptionCmd)
+
+	fs := getMessageSubscriptionCmd.Flags()
+	fs.StringVarP(&flagMSMessageName, "name", "n", "", "message name to filter subscriptions")
+	fs.StringVarP(&flagMSCorrelationKey, "correlation-key", "c", "", "correlation key to filter subscriptions")
+	fs.StringVar(&flagMSProcessInstanceKey, "pi-key", "", "process instance key to filter subscriptions")
+	fs.StringVarP(&flagMSState, "state", "s", "all", "state to filter subscriptions: all, created, correlated, deleted, migrated")
+	fs.BoolVar(&flagMSCorrelated, "correlated", false, "show messages that were already correlated to subscriptions instead of the subscriptions")
+}
+
+func populateMSSearchFilterOpts() (message.MessageSubscriptionSearchFilterOpts, error) {
+	var filter message.MessageSubscriptionSearchFilterOpts
+	if flagMSMessageName != "" {
+		filter.MessageName = flagMSMessageName
+	}
+	if flagMSCorrelationKey != "" {
+		filter.CorrelationKey = flagMSCorrelationKey
+	}
+	if flagMSProcessInstanceKey != "" {
+		filter.ProcessInstanceKey = flagMSProcessInstanceKey
+	}
+	switch s := strings.ToLower(flagMSState); s {
+	case "", "all":
+	case "created", "correlated", "deleted", "migrated":
+		if flagMSCorrelated {
+			return filter, fmt.Errorf("%w: --state cannot be combined with --correlated", ferrors.ErrBadRequest)
+		}
+		filter.State = strings.ToUpper(s)
+	default:
+		return filter, fmt.Errorf("%w: invalid --state %q, expected one of: all, created, correlated, deleted, migrated", ferrors.ErrBadRequest, flagMSState)
+	}
+	return filter, nil
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagGetResourceKey     string
+	flagGetResourceContent bool
+	flagGetResourceOutput  string
+)
+
+var getResourceCmd = &cobra.Command{
+	Use:     "resource",
+	Short:   "Get a deployed resource (BPMN, DMN, form, ...) by its key",
+	Aliases: []string{"resources", "res", "r"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		if flagGetResourceOutput != "" && !flagGetResourceContent {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: --output can only be used together with --content", ferrors.ErrBadRequest))
+		}
+		if flagGetResourceContent {
+			log.Debug(fmt.Sprintf("fetching content of resource with key %s", flagGetResourceKey))
+			content, err := cli.GetResourceContent(cmd.Context(), flagGetResourceKey)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching content of resource with key %s: %w", flagGetResourceKey, err))
+			}
+			if flagGetResourceOutput == "" {
+				_, _ = cmd.OutOrStdout().Write(content)
+				return
+			}
+			if err = os.WriteFile(flagGetResourceOutput, content, 0o644); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error writing resource content to %s: %w", flagGetResourceOutput, err))
+			}
+			log.Info(fmt.Sprintf("content of resource with key %s written to %s", flagGetResourceKey, flagGetResourceOutput))
+			return
+		}
+
+		log.Debug(fmt.Sprintf("fetching resource with key %s", flagGetResourceKey))
+		r, err := cli.GetResource(cmd.Context(), flagGetResourceKey)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error fetching resource with key %s: %w", flagGetResourceKey, err))
+		}
+		if err = resourceView(cmd, r); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering resource view: %w", err))
+		}
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getResourceCmd)
+
+	fs := getResourceCmd.Flags()
+	fs.StringVarP(&flagGetResourceKey, "key", "k", "", "resource key to fetch")
+	_ = getResourceCmd.MarkFlagRequired("key")
+	fs.BoolVar(&flagGetResourceContent, "content", false, "fetch the raw content of the resource instead of its metadata")
+	fs.StringVarP(&flagGetResourceOutput, "output", "o", "", "file to write the resource content to (default stdout, requires --content)")
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/usage"
+	"github.com/spf13/cobra"
+)
+
+const usageSourceAuto = "auto"
+
+var usageSources = []string{usageSourceAuto, string(usage.SourceOrchestration), string(usage.SourceAdministration)}
+
+var (
+	flagUsageFrom     string
+	flagUsageTo       string
+	flagUsageSource   string
+	flagUsageClusters []string
+	flagUsageCSV      bool
+)
+
+var getUsageMetricsCmd = &cobra.Command{
+	Use:   "usage-metrics",
+	Short: "Get the monthly usage metrics (process instances, decision instances, assignees) for license reporting",
+	Long: `Get the usage metrics relevant for license compliance reporting, summarized per calendar month (UTC)
+and in total for the whole range.
+
+The metrics are read from the orchestration cluster (Camunda 8.8+) or, if apis.administration_api.base_url
+is configured, from the Self-Managed Console administration API for all clusters it manages.
+--from and --to accept YYYY-MM, YYYY-MM-DD or RFC3339; month and day values of --to are inclusive.`,
+	Example: `  kamunder get usage-metrics --from 2025-01 --to 2025-06
+  kamunder get usage-metrics --from 2025-01 --to 2025-12 --source administration --csv > usage-2025.csv`,
+	Aliases: []string{"usage", "um"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		cfg, err := config.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		opts, err := usageReportOptsFromFlags(cfg, time.Now())
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		log.Debug(fmt.Sprintf("fetching usage metrics from %s source between %s and %s", opts.Source,
+			opts.From.Format(time.RFC3339), opts.To.Format(time.RFC3339)))
+		rep, err := cli.GetUsageReport(cmd.Context(), opts)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error fetching usage metrics: %w", err))
+		}
+		if flagUsageCSV {
+			err = usageReportCSV(cmd.OutOrStdout(), rep)
+		} else {
+			err = usageReportView(cmd, rep)
+		}
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering usage report: %w", err))
+		}
+	},
+}
+
+func init() {
+	getCmd.AddCommand(getUsageMetricsCmd)
+
+	fs := getUsageMetricsCmd.Flags()
+	fs.StringVar(&flagUsageFrom, "from", "", "start of the reporting range (default: first day of the month 11 months ago)")
+	fs.StringVar(&flagUsageTo, "to", "", "end of the reporting range (default: now)")
+	fs.StringVar(&flagUsageSource, "source", usageSourceAuto, fmt.Sprintf("metrics source, one of %v", usageSources))
+	fs.StringSliceVar(&flagUsageClusters, "cluster", nil, "restrict the administration source to these cluster ids or names (repeatable)")
+	fs.BoolVar(&flagUsageCSV, "csv", false, "output as CSV")
+}
+
+func usageReportOptsFromFlags(cfg *config.Config, now time.Time) (usage.UsageReportOpts, error) {
+	if !slices.Contains(usageSources, flagUsageSource) {
+		return usage.UsageReportOpts{}, fmt.Errorf("%w: invalid --source %q, expected one of %v", ferrors.ErrBadRequest, flagUsageSource, usageSources)
+	}
+	src := usage.Source(flagUsageSource)
+	if flagUsageSource == usageSourceAuto {
+		src = usage.SourceOrchestration
+		if cfg.APIs.Administration.BaseURL != "" {
+			src = usage.SourceAdministration
+		}
+	}
+	now = now.UTC()
+	from := time.Date(now.Year(), now.Month()-11, 1, 0, 0, 0, 0, time.UTC)
+	to := now
+	var err error
+	if flagUsageFrom != "" {
+		if from, err = parseUsageTime(flagUsageFrom, false); err != nil {
+			return usage.UsageReportOpts{}, err
+		}
+	}
+	if flagUsageTo != "" {
+		if to, err = parseUsageTime(flagUsageTo, true); err != nil {
+			return usage.UsageReportOpts{}, err
+		}
+	}
+	return usage.UsageReportOpts{From: from, To: to, Source: src, ClusterIds: flagUsageClusters}, nil
+}
+
+// parseUsageTime parses YYYY-MM, YYYY-MM-DD or RFC3339. With end set, month and day values
+// resolve to the start of the following month or day, so that they are included in the range.
+func parseUsageTime(v string, end bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, v); err == nil {
+		if end {
+			return t.AddDate(0, 0, 1), nil
+		}
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01", v); err == nil {
+		if end {
+			return t.AddDate(0, 1, 0), nil
+		}
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%w: invalid time %q, expected YYYY-MM, YYYY-MM-DD or an RFC3339 timestamp", ferrors.ErrBadRequest, v)
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/cluster"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagHealthBrokers           int32
+	flagHealthPartitions        int32
+	flagHealthReplicationFactor int32
+	flagHealthRequireLicense    bool
+	flagHealthLicenseExpiry     time.Duration
+	flagHealthSkipEndpoints     bool
+	flagHealthStrict            bool
+)
+
+var healthCmd = &cobra.Command{
+	Use:   "health",
+	Short: "Check the health of the connected Camunda 8 cluster",
+	Long: `Check the health of the connected Camunda 8 cluster.
+
+Combines the gateway status (8.8), the topology, the license and the reachability of the
+Operate and Tasklist base URLs into a report and validates that all brokers are present,
+every partition has a healthy leader and is fully replicated and all brokers run the same version.
+
+Exit codes:
+  0  healthy (warnings only, unless --strict)
+  5  cluster unreachable
+  7  unhealthy, at least one check failed
+  8  degraded, at least one warning with --strict`,
+	Example: `  kamunder health
+  kamunder health --expect-brokers 3 --expect-replication-factor 3 --strict
+  kamunder health --json`,
+	Aliases:    []string{"healthcheck", "check"},
+	SuggestFor: []string{"helth", "status"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		report, err := cli.CheckClusterHealth(cmd.Context(), cluster.HealthExpectations{
+			Brokers:              flagHealthBrokers,
+			Partitions:           flagHealthPartitions,
+			ReplicationFactor:    flagHealthReplicationFactor,
+			RequireLicense:       flagHealthRequireLicense,
+			LicenseExpiryWarning: flagHealthLicenseExpiry,
+			SkipEndpoints:        flagHealthSkipEndpoints,
+		})
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error checking cluster health: %w", err))
+		}
+		if err = healthReportView(cmd, report); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering health report: %w", err))
+		}
+		if err = report.Err(flagHealthStrict); err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(healthCmd)
+
+	fs := healthCmd.Flags()
+	fs.Int32Var(&flagHealthBrokers, "expect-brokers", 0, "number of brokers expected (0 = cluster size from the topology)")
+	fs.Int32Var(&flagHealthPartitions, "expect-partitions", 0, "number of partitions expected (0 = any)")
+	fs.Int32Var(&flagHealthReplicationFactor, "expect-replication-factor", 0, "replication factor expected (0 = any)")
+	fs.BoolVar(&flagHealthRequireLicense, "require-license", false, "fail instead of warn if there is no valid license")
+	fs.DurationVar(&flagHealthLicenseExpiry, "license-expiry-warning", 30*24*time.Hour, "warn if the license expires within this duration")
+	fs.BoolVar(&flagHealthSkipEndpoints, "skip-endpoints", false, "do not probe the Operate and Tasklist base URLs")
+	fs.BoolVar(&flagHealthStrict, "strict", false, "exit with a non-zero code on warnings too")
+}
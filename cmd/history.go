@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/kamunder/audit"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/toolx/logging"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagHistorySince     time.Duration
+	flagHistoryUser      string
+	flagHistoryOperation string
+	flagHistoryKey       string
+	flagHistoryFailed    bool
+	flagHistoryLimit     int
+	flagHistoryAll       bool
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Show the audit log of mutating operations",
+	Long: `Show the audit log of mutating operations, oldest first.
+With a profile active only its entries are shown, unless --all-profiles is given.
+
+Entries are written when audit.enabled is set in the config, to audit.file
+($XDG_CONFIG_HOME/kamunder/audit.jsonl by default) or to syslog with audit.syslog.`,
+	Example: `  kamunder history --since 24h
+  kamunder history --operation cancel --user alice
+  kamunder history --key 2251799813685249 --json`,
+	Aliases: []string{"audit"},
+	Run: func(cmd *cobra.Command, args []string) {
+		log := logging.FromContext(cmd.Context())
+		cfg, err := config.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("config from context: %w", err))
+		}
+		if cfg.Audit.Syslog {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrUnsupported, audit.ErrNoFile))
+		}
+		path, err := cfg.Audit.Path()
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		f := audit.Filter{
+			User:      flagHistoryUser,
+			Profile:   cfg.ActiveProfile,
+			Operation: flagHistoryOperation,
+			Key:       flagHistoryKey,
+			Failed:    flagHistoryFailed,
+			Limit:     flagHistoryLimit,
+		}
+		if flagHistoryAll {
+			f.Profile = ""
+		}
+		if flagHistorySince > 0 {
+			f.Since = time.Now().Add(-flagHistorySince)
+		}
+		entries, err := audit.Read(path, f)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if !cfg.Audit.Enabled {
+			log.Debug("audit log is disabled, enable it with audit.enabled in the config")
+		}
+		if err = listAuditEntriesView(cmd, entries); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering audit log view: %w", err))
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+
+	fs := historyCmd.Flags()
+	fs.DurationVar(&flagHistorySince, "since", 0, "only entries of the last duration, e.g. 24h")
+	fs.StringVar(&flagHistoryUser, "user", "", "only entries of this OS user")
+	fs.StringVar(&flagHistoryOperation, "operation", "", "only operations containing this text, e.g. cancel or DeleteProcessInstance")
+	fs.StringVarP(&flagHistoryKey, "key", "k", "", "only entries affecting this key")
+	fs.BoolVar(&flagHistoryFailed, "failed", false, "only failed operations")
+	fs.IntVarP(&flagHistoryLimit, "limit", "n", 50, "show at most this many of the newest entries, 0 for all")
+	fs.BoolVarP(&flagHistoryAll, "all-profiles", "A", false, "entries of all profiles, not only of the active one")
+}
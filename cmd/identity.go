@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/identity"
+	"github.com/grafvonb/kamunder/toolx"
+	"github.com/spf13/cobra"
+)
+
+const maxIdentitySearchSize int32 = 1000
+
+// kindAuthorization is handled next to the identity kinds by the identity commands.
+const kindAuthorization = string(identity.KindAuthorization)
+
+var identityKinds = []string{"user", "group", "role", "tenant", "mapping-rule", kindAuthorization}
+
+var (
+	flagIdentityId          string
+	flagIdentityName        string
+	flagIdentityEmail       string
+	flagIdentityPassword    string
+	flagIdentityDescription string
+	flagIdentityClaimName   string
+	flagIdentityClaimValue  string
+
+	flagIdentityGroup  string
+	flagIdentityRole   string
+	flagIdentityTenant string
+
+	flagAuthOwnerId      string
+	flagAuthOwnerType    string
+	flagAuthResourceType string
+	flagAuthResourceId   string
+	flagAuthPermissions  []string
+)
+
+var identityCmd = &cobra.Command{
+	Use:   "identity",
+	Short: "Manage users, groups, roles, tenants, mapping rules and authorizations (camunda 8.8+)",
+	Example: `  kamunder identity get users
+  kamunder identity create group --id ops --name "Operations"
+  kamunder identity assign user --id alice --group ops
+  kamunder identity create authorization --owner-type group --owner-id ops --resource-type process_definition --resource-id '*' --permission read_process_instance`,
+	Aliases: []string{"iam"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"identiy", "idenity"},
+}
+
+func init() {
+	rootCmd.AddCommand(identityCmd)
+}
+
+// parseIdentityKind accepts a kind in singular or plural form, e.g. "user" or "users".
+func parseIdentityKind(arg string) (string, error) {
+	k := strings.ToLower(arg)
+	for _, c := range identityKinds {
+		if k == c || k == c+"s" {
+			return c, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unknown identity kind %q, expected one of: %s", ferrors.ErrBadRequest, arg, strings.Join(identityKinds, ", "))
+}
+
+func addIdentityAttrFlags(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.StringVar(&flagIdentityName, "name", "", "display name")
+	fs.StringVar(&flagIdentityEmail, "email", "", "email of a user")
+	fs.StringVar(&flagIdentityPassword, "password", "", "password of a user")
+	fs.StringVar(&flagIdentityDescription, "description", "", "description of a group, role or tenant")
+	fs.StringVar(&flagIdentityClaimName, "claim-name", "", "token claim name of a mapping rule")
+	fs.StringVar(&flagIdentityClaimValue, "claim-value", "", "token claim value of a mapping rule")
+}
+
+func addIdentityOwnerFlags(cmd *cobra.Command, usage string) {
+	fs := cmd.Flags()
+	fs.StringVar(&flagIdentityGroup, "group", "", "group id "+usage)
+	fs.StringVar(&flagIdentityRole, "role", "", "role id "+usage)
+	fs.StringVar(&flagIdentityTenant, "tenant", "", "tenant id "+usage)
+}
+
+func addAuthorizationFlags(cmd *cobra.Command) {
+	fs := cmd.Flags()
+	fs.StringVar(&flagAuthOwnerId, "owner-id", "", "authorization owner id, e.g. a username or group id")
+	fs.StringVar(&flagAuthOwnerType, "owner-type", "", "authorization owner type, e.g. user, group, role, client, mapping_rule")
+	fs.StringVar(&flagAuthResourceType, "resource-type", "", "authorization resource type, e.g. process_definition, decision_definition, resource")
+	fs.StringVar(&flagAuthResourceId, "resource-id", "", "authorization resource id, '*' for all resources of the type")
+	fs.StringSliceVar(&flagAuthPermissions, "permission", nil, "permission to grant, e.g. read, create_process_instance (repeatable)")
+}
+
+// identityFromFlags builds an identity of the given kind from --id and the attribute flags.
+func identityFromFlags(kind string) identity.Identity {
+	return identity.Identity{
+		Kind:        identity.Kind(kind),
+		Id:          flagIdentityId,
+		Name:        flagIdentityName,
+		Email:       flagIdentityEmail,
+		Password:    flagIdentityPassword,
+		Description: flagIdentityDescription,
+		ClaimName:   flagIdentityClaimName,
+		ClaimValue:  flagIdentityClaimValue,
+	}
+}
+
+func authorizationFromFlags() identity.Authorization {
+	return identity.Authorization{
+		Key:          flagIdentityId,
+		OwnerId:      flagAuthOwnerId,
+		OwnerType:    strings.ToUpper(flagAuthOwnerType),
+		ResourceType: strings.ToUpper(flagAuthResourceType),
+		ResourceId:   flagAuthResourceId,
+		Permissions:  toolx.MapSlice(flagAuthPermissions, strings.ToUpper),
+	}
+}
+
+// ownerFromFlags returns the group, role or tenant given by the owner flags; ok is false if none is set.
+func ownerFromFlags() (owner identity.Identity, ok bool, err error) {
+	var owners []identity.Identity
+	if flagIdentityGroup != "" {
+		owners = append(owners, identity.Identity{Kind: identity.KindGroup, Id: flagIdentityGroup})
+	}
+	if flagIdentityRole != "" {
+		owners = append(owners, identity.Identity{Kind: identity.KindRole, Id: flagIdentityRole})
+	}
+	if flagIdentityTenant != "" {
+		owners = append(owners, identity.Identity{Kind: identity.KindTenant, Id: flagIdentityTenant})
+	}
+	switch len(owners) {
+	case 0:
+		return identity.Identity{}, false, nil
+	case 1:
+		return owners[0], true, nil
+	default:
+		return identity.Identity{}, false, fmt.Errorf("%w: only one of --group, --role and --tenant may be given", ferrors.ErrBadRequest)
+	}
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/identity"
+	"github.com/spf13/cobra"
+)
+
+// assignableKinds are the kinds that can be members of a group, role or tenant.
+var assignableKinds = []string{"user", "group", "role", "client", "mapping-rule"}
+
+var identityAssignCmd = &cobra.Command{
+	Use:   "assign <kind>",
+	Short: "Assign a user, group, role, client or mapping rule to a group, role or tenant",
+	Example: `  kamunder identity assign user --id alice --group ops
+  kamunder identity assign group --id ops --role operator
+  kamunder identity assign role --id operator --tenant emea`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: assignableKinds,
+	Run: func(cmd *cobra.Command, args []string) {
+		runIdentityMembership(cmd, args, "assigning", "assigned to", func(cli identity.API, ctx context.Context, owner, member identity.Identity) error {
+			return cli.AssignIdentity(ctx, owner, member)
+		})
+	},
+}
+
+var identityUnassignCmd = &cobra.Command{
+	Use:   "unassign <kind>",
+	Short: "Unassign a user, group, role, client or mapping rule from a group, role or tenant",
+	Example: `  kamunder identity unassign user --id alice --group ops
+  kamunder identity unassign role --id operator --tenant emea`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: assignableKinds,
+	Run: func(cmd *cobra.Command, args []string) {
+		runIdentityMembership(cmd, args, "unassigning", "unassigned from", func(cli identity.API, ctx context.Context, owner, member identity.Identity) error {
+			return cli.UnassignIdentity(ctx, owner, member)
+		})
+	},
+}
+
+func runIdentityMembership(cmd *cobra.Command, args []string, verb, done string, apply func(identity.API, context.Context, identity.Identity, identity.Identity) error) {
+	cli, log, err := NewCli(cmd)
+	if err != nil {
+		ferrors.HandleAndExit(log, err)
+	}
+	kind, err := parseIdentityKind(args[0])
+	if err == nil && kind == kindAuthorization {
+		err = fmt.Errorf("%w: authorizations cannot be assigned, use identity create authorization", ferrors.ErrBadRequest)
+	}
+	if err != nil {
+		ferrors.HandleAndExit(log, err)
+	}
+	owner, ok, err := ownerFromFlags()
+	if err != nil {
+		ferrors.HandleAndExit(log, err)
+	}
+	if !ok {
+		ferrors.HandleAndExit(log, fmt.Errorf("%w: one of --group, --role or --tenant is required", ferrors.ErrBadRequest))
+	}
+	member := identity.Identity{Kind: identity.Kind(kind), Id: flagIdentityId}
+	if err = apply(cli, cmd.Context(), owner, member); err != nil {
+		ferrors.HandleAndExit(log, fmt.Errorf("error %s %s %s: %w", verb, kind, flagIdentityId, err))
+	}
+	log.Info(fmt.Sprintf("%s %s %s %s %s", kind, flagIdentityId, done, owner.Kind, owner.Id))
+}
+
+func init() {
+	identityCmd.AddCommand(identityAssignCmd)
+	identityCmd.AddCommand(identityUnassignCmd)
+
+	for _, c := range []*cobra.Command{identityAssignCmd, identityUnassignCmd} {
+		c.Flags().StringVar(&flagIdentityId, "id", "", "id of the member: username, group, role, client or mapping rule id")
+		_ = c.MarkFlagRequired("id")
+		addIdentityOwnerFlags(c, "of the membership")
+	}
+}
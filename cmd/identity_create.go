@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var identityCreateCmd = &cobra.Command{
+	Use:   "create <kind>",
+	Short: "Create a user, group, role, tenant, mapping rule or authorization",
+	Example: `  kamunder identity create user --id alice --name "Alice" --email alice@example.com --password secret
+  kamunder identity create group --id ops --name "Operations" --description "Operations team"
+  kamunder identity create mapping-rule --id ops-claim --name "Ops claim" --claim-name groups --claim-value ops
+  kamunder identity create authorization --owner-type group --owner-id ops --resource-type process_definition --resource-id '*' --permission read_process_instance`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: identityKinds,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		kind, err := parseIdentityKind(args[0])
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		if kind == kindAuthorization {
+			if flagAuthOwnerId == "" || flagAuthOwnerType == "" || flagAuthResourceType == "" || flagAuthResourceId == "" {
+				ferrors.HandleAndExit(log, fmt.Errorf("%w: --owner-id, --owner-type, --resource-type and --resource-id are required", ferrors.ErrBadRequest))
+			}
+			a, err := cli.CreateAuthorization(cmd.Context(), authorizationFromFlags())
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error creating authorization: %w", err))
+			}
+			if err = authorizationView(cmd, a); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering authorization view: %w", err))
+			}
+			return
+		}
+
+		if flagIdentityId == "" {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: --id is required", ferrors.ErrBadRequest))
+		}
+		if kind == "user" && flagIdentityPassword == "" {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: --password is required to create a user", ferrors.ErrBadRequest))
+		}
+		x, err := cli.CreateIdentity(cmd.Context(), identityFromFlags(kind))
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error creating %s %s: %w", kind, flagIdentityId, err))
+		}
+		if err = identityView(cmd, x); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering %s view: %w", kind, err))
+		}
+	},
+}
+
+func init() {
+	identityCmd.AddCommand(identityCreateCmd)
+
+	identityCreateCmd.Flags().StringVar(&flagIdentityId, "id", "", "id of the entity to create: username or group/role/tenant/mapping rule id")
+	addIdentityAttrFlags(identityCreateCmd)
+	addAuthorizationFlags(identityCreateCmd)
+}
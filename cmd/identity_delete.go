@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/identity"
+	"github.com/spf13/cobra"
+)
+
+var identityDeleteCmd = &cobra.Command{
+	Use:   "delete <kind>",
+	Short: "Delete a user, group, role, tenant, mapping rule or authorization",
+	Example: `  kamunder identity delete user --id alice
+  kamunder identity delete authorization --id <authorization-key>`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: identityKinds,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		kind, err := parseIdentityKind(args[0])
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = confirmDestructive(cmd, fmt.Sprintf("delete %s %s", kind, flagIdentityId), 1); err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		if kind == kindAuthorization {
+			err = cli.DeleteAuthorization(cmd.Context(), flagIdentityId)
+		} else {
+			err = cli.DeleteIdentity(cmd.Context(), identity.Kind(kind), flagIdentityId)
+		}
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error deleting %s %s: %w", kind, flagIdentityId, err))
+		}
+		log.Info(fmt.Sprintf("%s %s deleted", kind, flagIdentityId))
+	},
+}
+
+func init() {
+	identityCmd.AddCommand(identityDeleteCmd)
+
+	identityDeleteCmd.Flags().StringVar(&flagIdentityId, "id", "", "id of the entity to delete: username, group/role/tenant/mapping rule id or authorization key")
+	_ = identityDeleteCmd.MarkFlagRequired("id")
+	addConfirmFlag(identityDeleteCmd)
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/identity"
+	"github.com/spf13/cobra"
+)
+
+var identityGetCmd = &cobra.Command{
+	Use:   "get <kind>",
+	Short: "Get users, groups, roles, tenants, mapping rules or authorizations",
+	Long: `Get users, groups, roles, tenants, mapping rules or authorizations.
+
+Without --id all entities of the kind are listed. With --group, --role or --tenant
+only the entities assigned to it are listed, e.g. the users of a group.`,
+	Example: `  kamunder identity get users
+  kamunder identity get user --id alice
+  kamunder identity get users --group ops
+  kamunder identity get authorizations --owner-type group --owner-id ops`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: identityKinds,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		kind, err := parseIdentityKind(args[0])
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+
+		if kind == kindAuthorization {
+			if flagIdentityId != "" {
+				a, err := cli.GetAuthorization(cmd.Context(), flagIdentityId)
+				if err != nil {
+					ferrors.HandleAndExit(log, fmt.Errorf("error fetching authorization %s: %w", flagIdentityId, err))
+				}
+				if err = authorizationView(cmd, a); err != nil {
+					ferrors.HandleAndExit(log, fmt.Errorf("error rendering authorization view: %w", err))
+				}
+				return
+			}
+			a := authorizationFromFlags()
+			as, err := cli.SearchAuthorizations(cmd.Context(), identity.AuthorizationSearchFilterOpts{
+				OwnerId:      a.OwnerId,
+				OwnerType:    a.OwnerType,
+				ResourceType: a.ResourceType,
+				ResourceId:   a.ResourceId,
+			}, maxIdentitySearchSize)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching authorizations: %w", err))
+			}
+			if err = listAuthorizationsView(cmd, as); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering items view: %w", err))
+			}
+			log.Debug(fmt.Sprintf("fetched authorizations: %d", as.Total))
+			return
+		}
+
+		owner, hasOwner, err := ownerFromFlags()
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		switch {
+		case flagIdentityId != "":
+			x, err := cli.GetIdentity(cmd.Context(), identity.Kind(kind), flagIdentityId)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching %s %s: %w", kind, flagIdentityId, err))
+			}
+			if err = identityView(cmd, x); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering %s view: %w", kind, err))
+			}
+		case hasOwner:
+			xs, err := cli.SearchIdentityMembers(cmd.Context(), owner, identity.Kind(kind), maxIdentitySearchSize)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching %ss of %s %s: %w", kind, owner.Kind, owner.Id, err))
+			}
+			if err = listIdentitiesView(cmd, xs); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering items view: %w", err))
+			}
+			log.Debug(fmt.Sprintf("fetched %ss of %s %s: %d", kind, owner.Kind, owner.Id, xs.Total))
+		default:
+			xs, err := cli.SearchIdentities(cmd.Context(), identity.Kind(kind), maxIdentitySearchSize)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching %ss: %w", kind, err))
+			}
+			if err = listIdentitiesView(cmd, xs); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error rendering items view: %w", err))
+			}
+			log.Debug(fmt.Sprintf("fetched %ss: %d", kind, xs.Total))
+		}
+	},
+}
+
+func init() {
+	identityCmd.AddCommand(identityGetCmd)
+
+	identityGetCmd.Flags().StringVar(&flagIdentityId, "id", "", "id of the entity to fetch: username, group/role/tenant/mapping rule id or authorization key")
+	addIdentityOwnerFlags(identityGetCmd, "to list the members of")
+	addAuthorizationFlags(identityGetCmd)
+}
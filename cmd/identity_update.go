@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var identityUpdateCmd = &cobra.Command{
+	Use:   "update <kind>",
+	Short: "Update a user, group, role, tenant, mapping rule or authorization",
+	Long: `Update a user, group, role, tenant, mapping rule or authorization.
+
+The attributes given replace the stored ones, attributes not given are cleared
+(except the email and password of a user). Authorizations are replaced as a whole.`,
+	Example: `  kamunder identity update group --id ops --name "Operations" --description "Operations and support"
+  kamunder identity update user --id alice --name "Alice Doe" --email alice@example.com
+  kamunder identity update authorization --id <authorization-key> --owner-type group --owner-id ops --resource-type process_definition --resource-id '*' --permission read_process_instance --permission update_process_instance`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: identityKinds,
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		kind, err := parseIdentityKind(args[0])
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if flagIdentityId == "" {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: --id is required", ferrors.ErrBadRequest))
+		}
+
+		if kind == kindAuthorization {
+			if err = cli.UpdateAuthorization(cmd.Context(), authorizationFromFlags()); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error updating authorization %s: %w", flagIdentityId, err))
+			}
+			log.Info(fmt.Sprintf("authorization %s updated", flagIdentityId))
+			return
+		}
+
+		x, err := cli.UpdateIdentity(cmd.Context(), identityFromFlags(kind))
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error updating %s %s: %w", kind, flagIdentityId, err))
+		}
+		if err = identityView(cmd, x); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering %s view: %w", kind, err))
+		}
+	},
+}
+
+func init() {
+	identityCmd.AddCommand(identityUpdateCmd)
+
+	identityUpdateCmd.Flags().StringVar(&flagIdentityId, "id", "", "id of the entity to update: username, group/role/tenant/mapping rule id or authorization key")
+	addIdentityAttrFlags(identityUpdateCmd)
+	addAuthorizationFlags(identityUpdateCmd)
+}
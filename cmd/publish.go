@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var publishCmd = &cobra.Command{
+	Use:     "publish",
+	Short:   "Publish resources like messages",
+	Aliases: []string{"pub"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"pubish", "publsh"},
+}
+
+func init() {
+	rootCmd.AddCommand(publishCmd)
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/message"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagPubMsgName           string
+	flagPubMsgCorrelationKey string
+	flagPubMsgID             string
+	flagPubMsgTTL            time.Duration
+	flagPubMsgVars           []string
+)
+
+var publishMessageCmd = &cobra.Command{
+	Use:     "message",
+	Short:   "Publish a message, buffered for --ttl if no subscription is waiting for it",
+	Aliases: []string{"messages", "msg", "m"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		cfg, err := config.FromContext(cmd.Context())
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		vars, err := parseVars(flagPubMsgVars)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+
+		m := message.MessagePublication{
+			Name:           flagPubMsgName,
+			CorrelationKey: flagPubMsgCorrelationKey,
+			MessageId:      flagPubMsgID,
+			TimeToLive:     flagPubMsgTTL.Milliseconds(),
+			TenantId:       cfg.App.Tenant,
+			Variables:      vars,
+		}
+		log.Debug(fmt.Sprintf("publishing message %q with correlation key %q", m.Name, m.CorrelationKey))
+		res, err := cli.PublishMessage(cmd.Context(), m)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error publishing message %q: %w", m.Name, err))
+		}
+		err = messagePublicationView(cmd, res)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering publication view: %w", err))
+		}
+	},
+}
+
+func init() {
+	publishCmd.AddCommand(publishMessageCmd)
+
+	fs := publishMessageCmd.Flags()
+	fs.StringVarP(&flagPubMsgName, "name", "n", "", "message name as defined in the BPMN model")
+	fs.StringVarP(&flagPubMsgCorrelationKey, "correlation-key", "c", "", "correlation key of the message")
+	fs.StringVar(&flagPubMsgID, "message-id", "", "unique message ID, a message with the same ID is published only once during its ttl")
+	fs.DurationVar(&flagPubMsgTTL, "ttl", 0, "time to buffer the message on the broker, e.g. 30s or 5m (0 = not buffered)")
+	fs.StringArrayVar(&flagPubMsgVars, "var", nil, "variable as key=value, value is parsed as JSON when possible (repeatable)")
+
+	_ = publishMessageCmd.MarkFlagRequired("name")
+}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var resolveCmd = &cobra.Command{
+	Use:     "resolve",
+	Short:   "Resolve resources like incidents",
+	Aliases: []string{"rs", "retry"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"reslove", "resolv"},
+}
+
+func init() {
+	rootCmd.AddCommand(resolveCmd)
+	addConfirmFlag(resolveCmd)
+
+	addBackoffFlagsAndBindings(resolveCmd, viper.GetViper())
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/incident"
+	"github.com/grafvonb/kamunder/kamunder/options"
+	"github.com/spf13/cobra"
+)
+
+const maxIncidentSearchSize int32 = 10000
+
+var (
+	flagResolveIncBpmnProcessID string
+	flagResolveIncErrorType     string
+	flagResolveIncSince         string
+	flagResolveIncPIKey         string
+	flagResolveIncAll           bool
+	flagResolveIncNoWait        bool
+)
+
+var resolveIncidentsCmd = &cobra.Command{
+	Use:   "incidents",
+	Short: "Resolve all active incidents matching a filter",
+	Long: `Resolve all active incidents matching a filter, e.g. to retry everything that failed during a downstream outage.
+
+On camunda 8.8 a single server-side batch operation resolves the incidents of all affected process instances,
+on 8.7 the incidents are resolved one by one from the client. Afterwards the command waits (see backoff flags)
+until the affected process instances no longer report an incident; failed and still pending instances are printed.
+Note that jobs failed with no retries left need their retries raised first, see "update job".`,
+	Example: `  kamunder resolve incidents --bpmn-process-id order-process --error-type job_no_retries --since 2h
+  kamunder resolve incidents --pi-key <process-instance-key>
+  kamunder resolve incidents --since 2025-10-01T08:00:00Z --all`,
+	Aliases: []string{"incident", "inc"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if !flagResolveIncAll {
+			if err = requireAnyFlag(cmd, "bpmn-process-id", "error-type", "pi-key"); err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("%w: %w, or --all to resolve incidents of all processes", ferrors.ErrBadRequest, err))
+			}
+		}
+		since, err := parseSince(flagResolveIncSince, time.Now())
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+		filter := incident.IncidentSearchFilterOpts{
+			ProcessDefinitionId: flagResolveIncBpmnProcessID,
+			ProcessInstanceKey:  flagResolveIncPIKey,
+			ErrorType:           strings.ToUpper(flagResolveIncErrorType),
+			Since:               since,
+		}
+		var opts []options.FacadeOption
+		if !flagResolveIncNoWait {
+			opts = append(opts, options.WithWait())
+		}
+
+		if confirmationNeeded(cmd) {
+			active := filter
+			active.State = "ACTIVE"
+			incidents, err := cli.SearchIncidents(cmd.Context(), active, maxIncidentSearchSize)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error counting incidents to resolve: %w", err))
+			}
+			if err = confirmDestructive(cmd, "resolve active incidents matching the filter", len(incidents.Items)); err != nil {
+				ferrors.HandleAndExit(log, err)
+			}
+		}
+
+		log.Debug(fmt.Sprintf("resolving incidents by filter: %+v", filter))
+		res, err := cli.ResolveIncidents(cmd.Context(), filter, maxIncidentSearchSize, opts...)
+		if verr := incidentResolutionView(cmd, res); verr != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering resolution view: %w", verr))
+		}
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error resolving incidents: %w", err))
+		}
+		if res.Incidents == 0 {
+			log.Info("no active incidents match the filter, nothing to resolve")
+			return
+		}
+		if res.Truncated {
+			log.Warn(fmt.Sprintf("more than %d incidents match the filter, only the first %d were resolved, run the command again for the rest", maxIncidentSearchSize, res.Incidents))
+		}
+		if len(res.Failures) > 0 {
+			ferrors.HandleAndExit(log, fmt.Errorf("resolving %d of %d incident(s) failed", len(res.Failures), res.Incidents))
+		}
+		log.Info(fmt.Sprintf("%d incident(s) of %d process instance(s) resolved", res.Incidents, len(res.ProcessInstanceKeys)))
+	},
+}
+
+// parseSince accepts a duration relative to now (e.g. 2h) or an RFC3339 timestamp, empty means no limit.
+func parseSince(v string, now time.Time) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(v); err == nil {
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --since %q, expected a duration like 2h or an RFC3339 timestamp", v)
+	}
+	return t, nil
+}
+
+func init() {
+	resolveCmd.AddCommand(resolveIncidentsCmd)
+
+	fs := resolveIncidentsCmd.Flags()
+	fs.StringVarP(&flagResolveIncBpmnProcessID, "bpmn-process-id", "b", "", "BPMN process ID to filter incidents")
+	fs.StringVar(&flagResolveIncErrorType, "error-type", "", "incident error type to filter incidents, e.g. job_no_retries, io_mapping_error")
+	fs.StringVar(&flagResolveIncSince, "since", "", "only incidents created since, as duration (e.g. 2h) or RFC3339 timestamp")
+	fs.StringVar(&flagResolveIncPIKey, "pi-key", "", "process instance key to filter incidents")
+	fs.BoolVar(&flagResolveIncAll, "all", false, "allow resolving without process or error type filter")
+	fs.BoolVar(&flagResolveIncNoWait, "no-wait", false, "do not wait until the process instances no longer report an incident")
+}
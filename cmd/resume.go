@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var resumeCmd = &cobra.Command{
+	Use:     "resume",
+	Short:   "Resume suspended resources like batch operations",
+	Aliases: []string{"continue"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"resum", "rsume"},
+}
+
+func init() {
+	rootCmd.AddCommand(resumeCmd)
+	addConfirmFlag(resumeCmd)
+}
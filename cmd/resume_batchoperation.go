@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var flagResumeBOKey string
+
+var resumeBatchOperationCmd = &cobra.Command{
+	Use:     "batch-operation",
+	Short:   "Resume a batch operation by its key (camunda 8.8+)",
+	Aliases: []string{"bo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = confirmDestructive(cmd, "resume batch operation "+flagResumeBOKey, 1); err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = cli.ResumeBatchOperation(cmd.Context(), flagResumeBOKey); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error resuming batch operation %s: %w", flagResumeBOKey, err))
+		}
+		log.Info(fmt.Sprintf("batch operation %s resumed", flagResumeBOKey))
+	},
+}
+
+func init() {
+	resumeCmd.AddCommand(resumeBatchOperationCmd)
+
+	resumeBatchOperationCmd.Flags().StringVarP(&flagResumeBOKey, "key", "k", "", "batch operation key to resume")
+	_ = resumeBatchOperationCmd.MarkFlagRequired("key")
+}
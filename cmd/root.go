@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/grafvonb/kamunder/config"
@@ -60,9 +61,13 @@ var rootCmd = &cobra.Command{
 			return nil
 		}
 
+		cfg.ResolveSecrets()
 		if err = cfg.Validate(); err != nil {
 			return fmt.Errorf("validate config:\n%w", err)
 		}
+		if cfg.ReadOnly && isMutatingCommand(cmd) {
+			return fmt.Errorf("%w: %s", config.ErrReadOnly, cmd.CommandPath())
+		}
 
 		httpSvc, err := httpc.New(cfg, log, httpc.WithCookieJar())
 		if err != nil {
@@ -75,7 +80,7 @@ var rootCmd = &cobra.Command{
 		if err := ator.Init(ctx); err != nil {
 			return fmt.Errorf("auth init: %w", err)
 		}
-		httpSvc.InstallAuthEditor(ator.Editor())
+		httpSvc.InstallAuthenticator(ator)
 		ctx = httpSvc.ToContext(ctx)
 		ctx = authenticator.ToContext(ctx, ator)
 		cmd.SetContext(ctx)
@@ -107,6 +112,7 @@ func init() {
 	pf.BoolVar(&flagViewKeysOnly, "keys-only", false, "output as keys only (where applicable)")
 
 	pf.String("config", "", "path to config file")
+	pf.String("profile", "", "config profile to use (overrides current_profile, env KAMUNDER_PROFILE)")
 
 	pf.String("log-level", "info", "log level (debug, info, warn, error)")
 	pf.String("log-format", "plain", "log format (json, plain, text)")
@@ -114,14 +120,27 @@ func init() {
 
 	pf.String("tenant", "", "default tenant ID")
 
-	pf.String("auth-mode", "oauth2", "authentication mode (oauth2, cookie)")
+	pf.String("auth-mode", "oauth2", "authentication mode (oauth2, cookie, oidc-user, bearer, exec)")
 	pf.String("auth-oauth2-client-id", "", "auth client ID")
 	pf.String("auth-oauth2-client-secret", "", "auth client secret")
 	pf.String("auth-oauth2-token-url", "", "auth token URL")
 	pf.StringToString("auth-oauth2-scopes", nil, "auth scopes as key=value (repeatable or comma-separated)")
+	pf.Bool("auth-oauth2-token-cache", false, "cache access tokens encrypted on disk between invocations")
+	pf.String("auth-oauth2-private-key-file", "", "PEM private key signing a client assertion (private_key_jwt) instead of the client secret")
+	pf.String("auth-oauth2-mtls-cert-file", "", "PEM client certificate for mutual TLS with the token endpoint")
+	pf.String("auth-oauth2-mtls-key-file", "", "PEM private key of the mutual TLS client certificate")
 	pf.String("auth-cookie-base-url", "", "auth cookie base URL")
 	pf.String("auth-cookie-username", "", "auth cookie username")
 	pf.String("auth-cookie-password", "", "auth cookie password")
+	pf.String("auth-oidc-token-url", "", "user login token URL")
+	pf.String("auth-oidc-authorization-url", "", "user login authorization endpoint URL (browser flow)")
+	pf.String("auth-oidc-device-authorization-url", "", "user login device authorization endpoint URL (device flow)")
+	pf.String("auth-oidc-client-id", "", "user login public client ID")
+	pf.String("auth-oidc-scope", "", "user login scope, e.g. \"openid offline_access\"")
+	pf.String("auth-oidc-flow", "", "user login flow (browser, device)")
+	pf.String("auth-bearer-token-env", "", "environment variable holding a static bearer token")
+	pf.String("auth-bearer-token-file", "", "file holding a static bearer token, re-read when it changes")
+	pf.String("auth-exec-command", "", "credential helper printing a token and its expiry as JSON")
 
 	pf.String("http-timeout", "", "HTTP timeout (Go duration, e.g. 30s)")
 
@@ -129,12 +148,14 @@ func init() {
 	pf.String("api-camunda-base-url", "", "Camunda API base URL")
 	pf.String("api-operate-base-url", "", "Operate API base URL")
 	pf.String("api-tasklist-base-url", "", "Tasklist API base URL")
+	pf.String("api-administration-base-url", "", "Self-Managed Console administration API base URL")
 }
 
 func initViper(v *viper.Viper, cmd *cobra.Command) error {
 	fs := cmd.Flags()
 
 	_ = v.BindPFlag("config", fs.Lookup("config"))
+	_ = v.BindPFlag("profile", fs.Lookup("profile"))
 
 	_ = v.BindPFlag("log.level", fs.Lookup("log-level"))
 	_ = v.BindPFlag("log.format", fs.Lookup("log-format"))
@@ -147,9 +168,22 @@ func initViper(v *viper.Viper, cmd *cobra.Command) error {
 	_ = v.BindPFlag("auth.oauth2.client_secret", fs.Lookup("auth-oauth2-client-secret"))
 	_ = v.BindPFlag("auth.oauth2.token_url", fs.Lookup("auth-oauth2-token-url"))
 	_ = v.BindPFlag("auth.oauth2.scopes", fs.Lookup("auth-oauth2-scopes"))
+	_ = v.BindPFlag("auth.oauth2.token_cache", fs.Lookup("auth-oauth2-token-cache"))
+	_ = v.BindPFlag("auth.oauth2.client_assertion.private_key_file", fs.Lookup("auth-oauth2-private-key-file"))
+	_ = v.BindPFlag("auth.oauth2.mtls.cert_file", fs.Lookup("auth-oauth2-mtls-cert-file"))
+	_ = v.BindPFlag("auth.oauth2.mtls.key_file", fs.Lookup("auth-oauth2-mtls-key-file"))
 	_ = v.BindPFlag("auth.cookie.base_url", fs.Lookup("auth-cookie-base-url"))
 	_ = v.BindPFlag("auth.cookie.username", fs.Lookup("auth-cookie-username"))
 	_ = v.BindPFlag("auth.cookie.password", fs.Lookup("auth-cookie-password"))
+	_ = v.BindPFlag("auth.oidc_user.token_url", fs.Lookup("auth-oidc-token-url"))
+	_ = v.BindPFlag("auth.oidc_user.authorization_url", fs.Lookup("auth-oidc-authorization-url"))
+	_ = v.BindPFlag("auth.oidc_user.device_authorization_url", fs.Lookup("auth-oidc-device-authorization-url"))
+	_ = v.BindPFlag("auth.oidc_user.client_id", fs.Lookup("auth-oidc-client-id"))
+	_ = v.BindPFlag("auth.oidc_user.scope", fs.Lookup("auth-oidc-scope"))
+	_ = v.BindPFlag("auth.oidc_user.flow", fs.Lookup("auth-oidc-flow"))
+	_ = v.BindPFlag("auth.bearer.token_env", fs.Lookup("auth-bearer-token-env"))
+	_ = v.BindPFlag("auth.bearer.token_file", fs.Lookup("auth-bearer-token-file"))
+	_ = v.BindPFlag("auth.exec.command", fs.Lookup("auth-exec-command"))
 
 	_ = v.BindPFlag("http.timeout", fs.Lookup("http-timeout"))
 
@@ -157,6 +191,7 @@ func initViper(v *viper.Viper, cmd *cobra.Command) error {
 	_ = v.BindPFlag("apis.camunda_api.base_url", fs.Lookup("api-camunda-base-url"))
 	_ = v.BindPFlag("apis.operate_api.base_url", fs.Lookup("api-operate-base-url"))
 	_ = v.BindPFlag("apis.tasklist_api.base_url", fs.Lookup("api-tasklist-base-url"))
+	_ = v.BindPFlag("apis.administration_api.base_url", fs.Lookup("api-administration-base-url"))
 
 	v.SetDefault("http.timeout", "30s")
 
@@ -182,6 +217,42 @@ func initViper(v *viper.Viper, cmd *cobra.Command) error {
 			return fmt.Errorf("read config file: %w", err)
 		}
 	}
+	return applyProfile(v, !isUtilityCommand(cmd))
+}
+
+// applyProfile merges the selected profile over the values of the config file; env vars and flags still win.
+// A missing profile is only an error if strict, so that e.g. "config set --profile" can create it.
+func applyProfile(v *viper.Viper, strict bool) error {
+	name := v.GetString("profile")
+	if name == "" {
+		name = v.GetString("current_profile")
+	}
+	if name == "" {
+		return nil
+	}
+	// viper lowercases keys, profile names are case-insensitive
+	name = strings.ToLower(name)
+	profiles := v.GetStringMap("profiles")
+	p, ok := profiles[name]
+	if !ok {
+		if !strict {
+			return nil
+		}
+		names := make([]string, 0, len(profiles))
+		for n := range profiles {
+			names = append(names, n)
+		}
+		slices.Sort(names)
+		return fmt.Errorf("config profile %q not found (available: %s)", name, strings.Join(names, ", "))
+	}
+	values, ok := p.(map[string]any)
+	if !ok {
+		return fmt.Errorf("config profile %q is not a mapping", name)
+	}
+	if err := v.MergeConfigMap(values); err != nil {
+		return fmt.Errorf("apply config profile %q: %w", name, err)
+	}
+	v.Set("active_profile", name)
 	return nil
 }
 
@@ -190,6 +261,9 @@ func retrieveAndNormalizeConfig(v *viper.Viper) (*config.Config, error) {
 	if err := v.Unmarshal(&cfg); err != nil {
 		return nil, fmt.Errorf("unmarshal config: %w", err)
 	}
+	if cfg.Config == "" {
+		cfg.Config = v.ConfigFileUsed()
+	}
 	if err := cfg.Normalize(); err != nil {
 		return nil, fmt.Errorf("normalize config: %w", err)
 	}
@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var suspendCmd = &cobra.Command{
+	Use:     "suspend",
+	Short:   "Suspend resources like batch operations",
+	Aliases: []string{"pause"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"suspnd", "supsend"},
+}
+
+func init() {
+	rootCmd.AddCommand(suspendCmd)
+	addConfirmFlag(suspendCmd)
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var flagSuspendBOKey string
+
+var suspendBatchOperationCmd = &cobra.Command{
+	Use:     "batch-operation",
+	Short:   "Suspend a batch operation by its key (camunda 8.8+)",
+	Aliases: []string{"bo"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = confirmDestructive(cmd, "suspend batch operation "+flagSuspendBOKey, 1); err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = cli.SuspendBatchOperation(cmd.Context(), flagSuspendBOKey); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error suspending batch operation %s: %w", flagSuspendBOKey, err))
+		}
+		log.Info(fmt.Sprintf("batch operation %s suspended", flagSuspendBOKey))
+	},
+}
+
+func init() {
+	suspendCmd.AddCommand(suspendBatchOperationCmd)
+
+	suspendBatchOperationCmd.Flags().StringVarP(&flagSuspendBOKey, "key", "k", "", "batch operation key to suspend")
+	_ = suspendBatchOperationCmd.MarkFlagRequired("key")
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var throwCmd = &cobra.Command{
+	Use:   "throw",
+	Short: "Throw errors, e.g. BPMN errors from jobs",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"thorw", "trow"},
+}
+
+func init() {
+	rootCmd.AddCommand(throwCmd)
+}
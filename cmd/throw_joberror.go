@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/job"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagThrowJobKey          string
+	flagThrowJobErrorCode    string
+	flagThrowJobErrorMessage string
+	flagThrowJobVars         []string
+)
+
+var throwJobErrorCmd = &cobra.Command{
+	Use:     "job-error",
+	Short:   "Throw a BPMN error for a job, to be caught by an error event in the model",
+	Aliases: []string{"error", "je"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		vars, err := parseVars(flagThrowJobVars)
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+		e := job.JobError{
+			ErrorCode:    flagThrowJobErrorCode,
+			ErrorMessage: flagThrowJobErrorMessage,
+			Variables:    vars,
+		}
+		if err = cli.ThrowJobError(cmd.Context(), flagThrowJobKey, e); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error throwing error %q for job %s: %w", e.ErrorCode, flagThrowJobKey, err))
+		}
+		log.Info(fmt.Sprintf("error %q thrown for job %s", e.ErrorCode, flagThrowJobKey))
+	},
+}
+
+func init() {
+	throwCmd.AddCommand(throwJobErrorCmd)
+
+	fs := throwJobErrorCmd.Flags()
+	fs.StringVarP(&flagThrowJobKey, "key", "k", "", "job key to throw the error for")
+	fs.StringVarP(&flagThrowJobErrorCode, "error-code", "c", "", "error code matched against the error events of the model")
+	fs.StringVarP(&flagThrowJobErrorMessage, "error-message", "m", "", "error message providing additional context")
+	fs.StringArrayVar(&flagThrowJobVars, "var", nil, "variable as key=value, value is parsed as JSON when possible (repeatable)")
+
+	_ = throwJobErrorCmd.MarkFlagRequired("key")
+	_ = throwJobErrorCmd.MarkFlagRequired("error-code")
+}
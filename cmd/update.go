@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var updateCmd = &cobra.Command{
+	Use:     "update",
+	Short:   "Update resources like jobs",
+	Aliases: []string{"up", "upd"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+	SuggestFor: []string{"updte", "upate"},
+}
+
+func init() {
+	rootCmd.AddCommand(updateCmd)
+	addConfirmFlag(updateCmd)
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/job"
+	"github.com/spf13/cobra"
+)
+
+// maxJobUpdateSize limits the jobs selected by filter, the search reads them page by page.
+const maxJobUpdateSize int32 = 10000
+
+var (
+	flagUpdateJobKeys    []string
+	flagUpdateJobRetries int32
+	flagUpdateJobTimeout time.Duration
+)
+
+var updateJobCmd = &cobra.Command{
+	Use:   "job",
+	Short: "Update retries and/or timeout of one or more jobs",
+	Long: `Update retries and/or timeout of one or more jobs.
+
+Jobs are selected either by --key (repeatable) or by the same filter flags as "get job",
+e.g. to raise the retries of all failed jobs of a type after an outage:
+
+  kamunder update job --type payment-service --state failed --retries 3`,
+	Aliases: []string{"jobs", "j"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if err = requireAnyFlag(cmd, "retries", "timeout"); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+		if err = requireAnyFlag(cmd, "key", "type", "state", "worker", "element-id", "bpmn-process-id", "pi-key"); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: select jobs by key or filter: %w", ferrors.ErrBadRequest, err))
+		}
+
+		keys := flagUpdateJobKeys
+		if len(keys) == 0 {
+			filter, err := populateJobSearchFilterOpts()
+			if err != nil {
+				ferrors.HandleAndExit(log, err)
+			}
+			log.Debug(fmt.Sprintf("searching jobs to update by filter: %v", filter))
+			jobs, err := cli.SearchJobs(cmd.Context(), filter, maxJobUpdateSize+1)
+			if err != nil {
+				ferrors.HandleAndExit(log, fmt.Errorf("error fetching jobs to update: %w", err))
+			}
+			if len(jobs.Items) > int(maxJobUpdateSize) {
+				ferrors.HandleAndExit(log, fmt.Errorf("%w: more than %d jobs match the filter, narrow it down, e.g. with --bpmn-process-id or --element-id, and run again", ferrors.ErrBadRequest, maxJobUpdateSize))
+			}
+			for _, j := range jobs.Items {
+				keys = append(keys, j.Key)
+			}
+			if len(keys) == 0 {
+				log.Info("no jobs match the filter, nothing to update")
+				return
+			}
+		}
+
+		u := job.JobUpdate{
+			Retries: flagUpdateJobRetries,
+			Timeout: flagUpdateJobTimeout.Milliseconds(),
+		}
+		if err = confirmDestructive(cmd, "update jobs", len(keys)); err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		log.Debug(fmt.Sprintf("updating %d job(s) with %+v", len(keys), u))
+		results, err := cli.UpdateJobs(cmd.Context(), keys, u)
+		if verr := listJobActionResultsView(cmd, results); verr != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error rendering update view: %w", verr))
+		}
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error updating jobs: %w", err))
+		}
+		log.Info(fmt.Sprintf("%d job(s) updated successfully", len(results)))
+	},
+}
+
+func init() {
+	updateCmd.AddCommand(updateJobCmd)
+
+	addJobFilterFlags(updateJobCmd)
+	fs := updateJobCmd.Flags()
+	fs.StringSliceVarP(&flagUpdateJobKeys, "key", "k", nil, "job key(s) to update (repeatable or comma separated)")
+	fs.Int32Var(&flagUpdateJobRetries, "retries", 0, "new number of retries, must be positive")
+	fs.DurationVar(&flagUpdateJobTimeout, "timeout", 0, "new job timeout starting from now, e.g. 5m")
+}
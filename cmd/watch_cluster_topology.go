@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/cluster"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagWatchCTInterval time.Duration
+	flagWatchCTNDJSON   bool
+)
+
+var watchClusterTopologyCmd = &cobra.Command{
+	Use:   "cluster-topology",
+	Short: "Poll the cluster topology and print leader changes, health transitions, brokers joining or leaving and version changes",
+	Long: `Poll the cluster topology and print only what changed since the previous poll:
+leader changes, partition health transitions, brokers joining or leaving and broker version changes.
+Periods in which the gateway cannot be reached are reported as unreachable/reachable events.
+
+Runs until interrupted (Ctrl+C).`,
+	Example: `  kamunder watch cluster-topology
+  kamunder watch cluster-topology --interval 2s --ndjson | jq -c 'select(.type == "leader-changed")'`,
+	Aliases: []string{"ct"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		if flagWatchCTInterval <= 0 {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: --interval must be positive", ferrors.ErrBadRequest))
+		}
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		emit := func(e cluster.TopologyEvent) {
+			if flagWatchCTNDJSON {
+				b, _ := json.Marshal(e)
+				cmd.Println(string(b))
+				return
+			}
+			cmd.Println(e.String())
+		}
+
+		var prev *cluster.Topology
+		unreachable := false
+		ticker := time.NewTicker(flagWatchCTInterval)
+		defer ticker.Stop()
+		for {
+			t, err := cli.GetClusterTopology(ctx)
+			now := time.Now()
+			switch {
+			case errors.Is(ctx.Err(), context.Canceled):
+				return
+			case err != nil:
+				if !unreachable {
+					emit(cluster.TopologyEvent{Time: now, Type: cluster.EventUnreachable, NodeId: -1, Detail: err.Error()})
+					unreachable = true
+				}
+				log.Debug(fmt.Sprintf("fetching topology: %v", err))
+			default:
+				if unreachable {
+					emit(cluster.TopologyEvent{Time: now, Type: cluster.EventReachable, NodeId: -1})
+					unreachable = false
+				}
+				if prev == nil {
+					emit(cluster.TopologyEvent{Time: now, Type: cluster.EventSnapshot, NodeId: -1,
+						Detail: fmt.Sprintf("%d brokers, %d partitions, replication factor %d, gateway %s", len(t.Brokers), t.PartitionsCount, t.ReplicationFactor, t.GatewayVersion)})
+				} else {
+					for _, e := range cluster.DiffTopology(*prev, t, now) {
+						emit(e)
+					}
+				}
+				prev = &t
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	},
+}
+
+func init() {
+	watchCmd.AddCommand(watchClusterTopologyCmd)
+
+	fs := watchClusterTopologyCmd.Flags()
+	fs.DurationVar(&flagWatchCTInterval, "interval", 5*time.Second, "poll interval")
+	fs.BoolVar(&flagWatchCTNDJSON, "ndjson", false, "emit events as newline-delimited JSON")
+}
@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/job"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagWorkerTypes        []string
+	flagWorkerName         string
+	flagWorkerTimeout      time.Duration
+	flagWorkerMaxJobs      int32
+	flagWorkerPollInterval time.Duration
+	flagWorkerLimit        int
+	flagWorkerVars         []string
+	flagWorkerVarsFile     string
+	flagWorkerTemplate     string
+	flagWorkerExec         string
+	flagWorkerFail         bool
+	flagWorkerErrorMessage string
+	flagWorkerFailRetries  int32
+	flagWorkerRetryBackoff time.Duration
+)
+
+var workerCmd = &cobra.Command{
+	Use:   "worker",
+	Short: "Run a test job worker that completes or fails jobs of given types",
+	Long: `Run a test job worker that completes or fails jobs of given types.
+
+Meant for smoke tests, e.g. to push test instances past service tasks in staging without deploying real workers.
+Jobs are completed with variables from one of:
+  --var / --vars-file  static variables (file contains a JSON object)
+  --template           a Go template file rendered with the activated job, e.g. {"orderId": {{ json .Variables.orderId }}}
+  --exec               a shell command receiving the job as JSON on stdin, its stdout JSON object becomes the variables
+With --fail every job is failed instead; a failing --exec command fails the job as well.
+The worker runs until interrupted or --limit jobs have been handled.`,
+	Example: `  kamunder worker --type payment-service --var paid=true --limit 1
+  kamunder worker --type shipping --exec './fake-shipping.sh'
+  kamunder worker --type payment-service --fail --error-message "card declined" --fail-retries 0`,
+	Aliases:    []string{"work"},
+	SuggestFor: []string{"wroker", "workr"},
+	Run: func(cmd *cobra.Command, args []string) {
+		cli, log, err := NewCli(cmd)
+		if err != nil {
+			ferrors.HandleAndExit(log, err)
+		}
+		handler, err := workerHandler()
+		if err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("%w: %w", ferrors.ErrBadRequest, err))
+		}
+
+		w := job.NewJobWorker(cli, handler, flagWorkerTypes...)
+		w.Name = flagWorkerName
+		w.Timeout = flagWorkerTimeout
+		w.MaxJobs = flagWorkerMaxJobs
+		w.PollInterval = flagWorkerPollInterval
+		w.Limit = flagWorkerLimit
+		w.FailRetries = flagWorkerFailRetries
+		w.RetryBackOff = flagWorkerRetryBackoff
+		w.Log = log
+		w.OnResult = func(r job.JobWorkerResult) {
+			_ = itemView(cmd, r, pickMode(), oneLineJobWorkerResult, func(it job.JobWorkerResult) string { return it.Key })
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		log.Info(fmt.Sprintf("worker %q waiting for jobs of type(s) %v, press Ctrl+C to stop", w.Name, w.Types))
+		if err = w.Run(ctx); err != nil {
+			ferrors.HandleAndExit(log, fmt.Errorf("error running job worker: %w", err))
+		}
+		log.Info("worker stopped")
+	},
+}
+
+// workerHandler picks the job handler from the mutually exclusive variable sources.
+func workerHandler() (job.JobHandler, error) {
+	switch {
+	case flagWorkerFail:
+		return job.FailWith(flagWorkerErrorMessage), nil
+	case flagWorkerExec != "":
+		return job.CommandVariables(flagWorkerExec), nil
+	case flagWorkerTemplate != "":
+		b, err := os.ReadFile(flagWorkerTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("reading template: %w", err)
+		}
+		return job.TemplateVariables(string(b))
+	}
+	vars := map[string]any{}
+	if flagWorkerVarsFile != "" {
+		fileVars, err := job.ReadVariablesFile(flagWorkerVarsFile)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(vars, fileVars)
+	}
+	inline, err := parseVars(flagWorkerVars)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range inline {
+		vars[k] = v
+	}
+	return job.StaticVariables(vars), nil
+}
+
+func init() {
+	rootCmd.AddCommand(workerCmd)
+
+	fs := workerCmd.Flags()
+	fs.StringSliceVarP(&flagWorkerTypes, "type", "t", nil, "job type(s) to work on (repeatable or comma separated)")
+	fs.StringVar(&flagWorkerName, "name", job.DefaultWorkerName, "worker name reported to the engine")
+	fs.DurationVar(&flagWorkerTimeout, "timeout", job.DefaultWorkerTimeout, "time a job stays locked to this worker")
+	fs.Int32Var(&flagWorkerMaxJobs, "max-jobs", job.DefaultWorkerMaxJobs, "max jobs to activate per poll")
+	fs.DurationVar(&flagWorkerPollInterval, "poll-interval", job.DefaultWorkerPollInterval, "pause between polls")
+	fs.IntVar(&flagWorkerLimit, "limit", 0, "stop after this many handled jobs (0 = run until interrupted)")
+	fs.StringArrayVar(&flagWorkerVars, "var", nil, "variable as key=value, value is parsed as JSON when possible (repeatable)")
+	fs.StringVar(&flagWorkerVarsFile, "vars-file", "", "JSON file with variables to complete jobs with, --var entries take precedence")
+	fs.StringVar(&flagWorkerTemplate, "template", "", "Go template file rendering the variables as JSON object")
+	fs.StringVar(&flagWorkerExec, "exec", "", "shell command printing the variables as JSON object")
+	fs.BoolVar(&flagWorkerFail, "fail", false, "fail jobs instead of completing them")
+	fs.StringVarP(&flagWorkerErrorMessage, "error-message", "m", "failed by kamunder worker", "error message used when failing jobs")
+	fs.Int32Var(&flagWorkerFailRetries, "fail-retries", -1, "retries left when failing a job (-1 = decrement the job's retries)")
+	fs.DurationVar(&flagWorkerRetryBackoff, "retry-backoff", 0, "back-off before a failed job is retried, e.g. 30s")
+
+	_ = workerCmd.MarkFlagRequired("type")
+	workerCmd.MarkFlagsMutuallyExclusive("fail", "exec", "template", "vars-file")
+	workerCmd.MarkFlagsMutuallyExclusive("fail", "exec", "template", "var")
+}
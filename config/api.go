@@ -9,9 +9,10 @@ import (
 )
 
 const (
-	CamundaApiKeyConst  = "camunda_api"
-	OperateApiKeyConst  = "operate_api"
-	TasklistApiKeyConst = "tasklist_api"
+	CamundaApiKeyConst        = "camunda_api"
+	OperateApiKeyConst        = "operate_api"
+	TasklistApiKeyConst       = "tasklist_api"
+	AdministrationApiKeyConst = "administration_api"
 )
 
 type APIs struct {
@@ -19,6 +20,9 @@ type APIs struct {
 	Camunda  API                  `mapstructure:"camunda_api" json:"camunda_api" yaml:"camunda_api"`
 	Operate  API                  `mapstructure:"operate_api" json:"operate_api" yaml:"operate_api"`
 	Tasklist API                  `mapstructure:"tasklist_api" json:"tasklist_api" yaml:"tasklist_api"`
+	// Administration is the Self-Managed Console administration API; it has no default base URL
+	// and is only needed for usage metrics across clusters.
+	Administration API `mapstructure:"administration_api" json:"administration_api" yaml:"administration_api"`
 }
 
 type API struct {
@@ -49,6 +53,9 @@ func (a *APIs) Normalize() error {
 	if a.Tasklist.Key == "" {
 		a.Tasklist.Key = TasklistApiKeyConst
 	}
+	if a.Administration.Key == "" {
+		a.Administration.Key = AdministrationApiKeyConst
+	}
 	if a.Operate.BaseURL == "" {
 		a.Operate.BaseURL = a.Camunda.BaseURL
 	}
@@ -63,7 +70,7 @@ func (a *APIs) Validate(scopes Scopes) error {
 	if a.Camunda.BaseURL == "" {
 		errs = append(errs, fmt.Errorf("apis.camunda_api.base_url: %w", ErrNoBaseURL))
 	}
-	apis := []API{a.Camunda, a.Operate, a.Tasklist}
+	apis := []API{a.Camunda, a.Operate, a.Tasklist, a.Administration}
 	for _, api := range apis {
 		if api.RequireScope && strings.TrimSpace(scopes[api.Key]) == "" {
 			errs = append(errs, fmt.Errorf("api %s requires an auth scope but none was provided as auth.oauth2.scopes.%s", api.Key, api.Key))
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Audit configures the append-only log of mutating operations.
+type Audit struct {
+	Enabled bool `mapstructure:"enabled" json:"enabled" yaml:"enabled"`
+	// File is the JSON lines file, $XDG_CONFIG_HOME/kamunder/audit.jsonl if empty.
+	File string `mapstructure:"file" json:"file" yaml:"file"`
+	// Syslog writes the entries to the local syslog daemon instead of the file.
+	Syslog bool `mapstructure:"syslog" json:"syslog" yaml:"syslog"`
+}
+
+// Path returns the audit log file.
+func (a Audit) Path() (string, error) {
+	if a.File != "" {
+		return a.File, nil
+	}
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("user config dir: %w", err)
+	}
+	return filepath.Join(base, "kamunder", "audit.jsonl"), nil
+}
@@ -4,27 +4,36 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 type AuthMode string
 
-func (m AuthMode) IsValid() bool { return m == ModeOAuth2 || m == ModeCookie }
+func (m AuthMode) IsValid() bool {
+	return m == ModeOAuth2 || m == ModeCookie || m == ModeOIDCUser || m == ModeBearer || m == ModeExec
+}
 
 const (
-	ModeOAuth2 AuthMode = "oauth2"
-	ModeCookie AuthMode = "cookie"
+	ModeOAuth2   AuthMode = "oauth2"
+	ModeCookie   AuthMode = "cookie"
+	ModeOIDCUser AuthMode = "oidc-user"
+	ModeBearer   AuthMode = "bearer"
+	ModeExec     AuthMode = "exec"
 )
 
 type Auth struct {
 	Mode   AuthMode                    `mapstructure:"mode" json:"mode" yaml:"mode"`
 	OAuth2 AuthOAuth2ClientCredentials `mapstructure:"oauth2" json:"oauth2" yaml:"oauth2"`
 	Cookie AuthCookieSession           `mapstructure:"cookie" json:"cookie" yaml:"cookie"`
+	OIDC   AuthOIDCUser                `mapstructure:"oidc_user" json:"oidc_user" yaml:"oidc_user"`
+	Bearer AuthBearer                  `mapstructure:"bearer" json:"bearer" yaml:"bearer"`
+	Exec   AuthExec                    `mapstructure:"exec" json:"exec" yaml:"exec"`
 }
 
 func (c *Auth) Validate() error {
 	var errs []error
 	if !c.Mode.IsValid() {
-		errs = append(errs, fmt.Errorf("mode: invalid value %q (allowed values: %q, %q)", c.Mode, ModeOAuth2, ModeCookie))
+		errs = append(errs, fmt.Errorf("mode: invalid value %q (allowed values: %q, %q, %q, %q, %q)", c.Mode, ModeOAuth2, ModeCookie, ModeOIDCUser, ModeBearer, ModeExec))
 	} else {
 		switch c.Mode {
 		case ModeOAuth2:
@@ -35,6 +44,18 @@ func (c *Auth) Validate() error {
 			if err := c.Cookie.Validate(); err != nil {
 				errs = append(errs, fmt.Errorf("cookie: %w", err))
 			}
+		case ModeOIDCUser:
+			if err := c.OIDC.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("oidc_user: %w", err))
+			}
+		case ModeBearer:
+			if err := c.Bearer.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("bearer: %w", err))
+			}
+		case ModeExec:
+			if err := c.Exec.Validate(); err != nil {
+				errs = append(errs, fmt.Errorf("exec: %w", err))
+			}
 		}
 	}
 	return errors.Join(errs...)
@@ -47,6 +68,11 @@ type AuthOAuth2ClientCredentials struct {
 	ClientID     string `mapstructure:"client_id" json:"client_id" yaml:"client_id"`
 	ClientSecret string `mapstructure:"client_secret" json:"client_secret" yaml:"client_secret"`
 	Scopes       Scopes `mapstructure:"scopes" json:"scopes" yaml:"scopes"`
+	// TokenCache keeps access tokens encrypted in the user cache dir between invocations.
+	TokenCache bool `mapstructure:"token_cache" json:"token_cache" yaml:"token_cache"`
+	// ClientAssertion and MTLS authenticate the client without a client secret.
+	ClientAssertion AuthClientAssertion `mapstructure:"client_assertion" json:"client_assertion" yaml:"client_assertion"`
+	MTLS            AuthMTLS            `mapstructure:"mtls" json:"mtls" yaml:"mtls"`
 }
 
 func (a *AuthOAuth2ClientCredentials) Validate() error {
@@ -58,8 +84,20 @@ func (a *AuthOAuth2ClientCredentials) Validate() error {
 	if strings.TrimSpace(a.ClientID) == "" {
 		errs = append(errs, ErrNoClientID)
 	}
-	if strings.TrimSpace(a.ClientSecret) == "" {
-		errs = append(errs, ErrNoClientSecret)
+	hasSecret := strings.TrimSpace(a.ClientSecret) != ""
+	switch {
+	case hasSecret && a.ClientAssertion.Enabled():
+		errs = append(errs, errors.New("client_secret and client_assertion are mutually exclusive"))
+	case !hasSecret && !a.ClientAssertion.Enabled() && !a.MTLS.Enabled():
+		errs = append(errs, fmt.Errorf("%w (or configure client_assertion or mtls)", ErrNoClientSecret))
+	}
+	if a.ClientAssertion.Enabled() {
+		if err := a.ClientAssertion.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("client_assertion: %w", err))
+		}
+	}
+	if err := a.MTLS.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("mtls: %w", err))
 	}
 	return errors.Join(errs...)
 }
@@ -84,3 +122,94 @@ func (c *AuthCookieSession) Validate() error {
 	}
 	return errors.Join(errs...)
 }
+
+type OIDCFlow string
+
+const (
+	FlowBrowser OIDCFlow = "browser"
+	FlowDevice  OIDCFlow = "device"
+)
+
+func (f OIDCFlow) IsValid() bool { return f == FlowBrowser || f == FlowDevice }
+
+// AuthOIDCUser logs in a person with their own SSO account, either in the browser
+// (authorization code with PKCE) or on another device (device authorization grant).
+// The refresh token is kept encrypted in the user cache dir.
+type AuthOIDCUser struct {
+	TokenURL               string   `mapstructure:"token_url" json:"token_url" yaml:"token_url"`
+	AuthorizationURL       string   `mapstructure:"authorization_url" json:"authorization_url" yaml:"authorization_url"`
+	DeviceAuthorizationURL string   `mapstructure:"device_authorization_url" json:"device_authorization_url" yaml:"device_authorization_url"`
+	ClientID               string   `mapstructure:"client_id" json:"client_id" yaml:"client_id"`
+	Scope                  string   `mapstructure:"scope" json:"scope" yaml:"scope"`
+	Flow                   OIDCFlow `mapstructure:"flow" json:"flow" yaml:"flow"`
+	RedirectPort           int      `mapstructure:"redirect_port" json:"redirect_port" yaml:"redirect_port"`
+}
+
+func (a *AuthOIDCUser) Validate() error {
+	var errs []error
+	if strings.TrimSpace(a.TokenURL) == "" {
+		errs = append(errs, ErrNoTokenURL)
+	}
+	if strings.TrimSpace(a.ClientID) == "" {
+		errs = append(errs, ErrNoClientID)
+	}
+	switch {
+	case a.Flow != "" && !a.Flow.IsValid():
+		errs = append(errs, fmt.Errorf("flow: invalid value %q (allowed values: %q, %q)", a.Flow, FlowBrowser, FlowDevice))
+	case a.Flow == FlowDevice:
+		if strings.TrimSpace(a.DeviceAuthorizationURL) == "" {
+			errs = append(errs, ErrNoDeviceAuthorizationURL)
+		}
+	default:
+		if strings.TrimSpace(a.AuthorizationURL) == "" {
+			errs = append(errs, ErrNoAuthorizationURL)
+		}
+	}
+	if a.RedirectPort < 0 || a.RedirectPort > 65535 {
+		errs = append(errs, fmt.Errorf("redirect_port: invalid value %d", a.RedirectPort))
+	}
+	return errors.Join(errs...)
+}
+
+// AuthBearer sends a static bearer token, taken from exactly one of the sources.
+// A token file is read again when it changes, so an external process can rotate it.
+type AuthBearer struct {
+	Token     string `mapstructure:"token" json:"token" yaml:"token"`
+	TokenEnv  string `mapstructure:"token_env" json:"token_env" yaml:"token_env"`
+	TokenFile string `mapstructure:"token_file" json:"token_file" yaml:"token_file"`
+}
+
+func (b *AuthBearer) Validate() error {
+	n := 0
+	for _, v := range []string{b.Token, b.TokenEnv, b.TokenFile} {
+		if strings.TrimSpace(v) != "" {
+			n++
+		}
+	}
+	if n != 1 {
+		return errors.New("exactly one of token, token_env and token_file must be set")
+	}
+	return nil
+}
+
+// AuthExec runs an external credential helper printing a token and its expiry as JSON,
+// in the style of kubectl credential plugins.
+type AuthExec struct {
+	Command string            `mapstructure:"command" json:"command" yaml:"command"`
+	Args    []string          `mapstructure:"args" json:"args" yaml:"args"`
+	Env     map[string]string `mapstructure:"env" json:"env" yaml:"env"`
+	Timeout string            `mapstructure:"timeout" json:"timeout" yaml:"timeout"` // Go duration, default 1m
+}
+
+func (e *AuthExec) Validate() error {
+	var errs []error
+	if strings.TrimSpace(e.Command) == "" {
+		errs = append(errs, errors.New("command must not be empty"))
+	}
+	if e.Timeout != "" {
+		if _, err := time.ParseDuration(e.Timeout); err != nil {
+			errs = append(errs, fmt.Errorf("timeout: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,125 @@
+package config
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	AlgRS256 = "RS256"
+	AlgES256 = "ES256"
+)
+
+// AuthClientAssertion authenticates the client with a JWT signed by its private key
+// (private_key_jwt, RFC 7523) instead of a client secret.
+type AuthClientAssertion struct {
+	PrivateKeyFile string `mapstructure:"private_key_file" json:"private_key_file" yaml:"private_key_file"`
+	Algorithm      string `mapstructure:"algorithm" json:"algorithm" yaml:"algorithm"` // RS256 or ES256, derived from the key if empty
+	KeyID          string `mapstructure:"key_id" json:"key_id" yaml:"key_id"`          // kid header, if the identity provider needs it
+	Audience       string `mapstructure:"audience" json:"audience" yaml:"audience"`    // defaults to the token endpoint
+}
+
+func (a *AuthClientAssertion) Enabled() bool { return strings.TrimSpace(a.PrivateKeyFile) != "" }
+
+func (a *AuthClientAssertion) Validate() error {
+	_, _, err := a.SigningKey()
+	return err
+}
+
+// SigningKey loads the private key and returns it with the algorithm to sign with.
+func (a *AuthClientAssertion) SigningKey() (crypto.Signer, string, error) {
+	key, err := loadPrivateKey(a.PrivateKeyFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("private_key_file: %w", err)
+	}
+	alg := strings.ToUpper(strings.TrimSpace(a.Algorithm))
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if alg != "" && alg != AlgRS256 {
+			return nil, "", fmt.Errorf("algorithm: %q does not match the RSA private key (use %q)", a.Algorithm, AlgRS256)
+		}
+		return k, AlgRS256, nil
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, "", errors.New("private_key_file: only EC keys on curve P-256 are supported")
+		}
+		if alg != "" && alg != AlgES256 {
+			return nil, "", fmt.Errorf("algorithm: %q does not match the EC private key (use %q)", a.Algorithm, AlgES256)
+		}
+		return k, AlgES256, nil
+	default:
+		return nil, "", fmt.Errorf("private_key_file: unsupported key type %T (RSA or EC P-256 expected)", key)
+	}
+}
+
+func loadPrivateKey(path string) (any, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
+
+// AuthMTLS presents a client certificate to the token endpoint (RFC 8705), with or without
+// a client secret depending on the identity provider.
+type AuthMTLS struct {
+	CertFile string `mapstructure:"cert_file" json:"cert_file" yaml:"cert_file"`
+	KeyFile  string `mapstructure:"key_file" json:"key_file" yaml:"key_file"`
+	CAFile   string `mapstructure:"ca_file" json:"ca_file" yaml:"ca_file"` // trusted CAs of the token endpoint, system pool if empty
+}
+
+func (m *AuthMTLS) Enabled() bool { return strings.TrimSpace(m.CertFile) != "" }
+
+func (m *AuthMTLS) Validate() error {
+	if !m.Enabled() && strings.TrimSpace(m.KeyFile) == "" && strings.TrimSpace(m.CAFile) == "" {
+		return nil
+	}
+	_, err := m.TLSConfig()
+	return err
+}
+
+// TLSConfig loads the client certificate and CAs.
+func (m *AuthMTLS) TLSConfig() (*tls.Config, error) {
+	if strings.TrimSpace(m.CertFile) == "" || strings.TrimSpace(m.KeyFile) == "" {
+		return nil, errors.New("cert_file and key_file must both be set")
+	}
+	cert, err := tls.LoadX509KeyPair(m.CertFile, m.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load client certificate: %w", err)
+	}
+	tc := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	if strings.TrimSpace(m.CAFile) != "" {
+		b, err := os.ReadFile(m.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("ca_file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, errors.New("ca_file: no certificates found")
+		}
+		tc.RootCAs = pool
+	}
+	return tc, nil
+}
@@ -15,6 +15,12 @@ var (
 	ErrNoClientID     = errors.New("no client_id provided in auth configuration")
 	ErrNoClientSecret = errors.New("no client_secret provided in auth configuration")
 
+	ErrNoAuthorizationURL       = errors.New("no authorization_url provided in auth configuration")
+	ErrNoDeviceAuthorizationURL = errors.New("no device_authorization_url provided in auth configuration")
+
+	ErrReadOnly     = errors.New("read-only configuration, command changes the cluster")
+	ErrTooManyItems = errors.New("too many items for a single operation")
+
 	ErrNoConfigInContext       = errors.New("no config in context")
 	ErrInvalidServiceInContext = errors.New("invalid config in context")
 )
@@ -22,14 +28,35 @@ var (
 type Config struct {
 	Config string `mapstructure:"config" json:"config" yaml:"config"`
 
-	App  App  `mapstructure:"app" json:"app" yaml:"app"`
-	Auth Auth `mapstructure:"auth" json:"auth" yaml:"auth"`
-	APIs APIs `mapstructure:"apis" json:"apis" yaml:"apis"`
-	HTTP HTTP `mapstructure:"http" json:"http" yaml:"http"`
+	// CurrentProfile is the profile used unless --profile or KAMUNDER_PROFILE selects another one.
+	CurrentProfile string `mapstructure:"current_profile" json:"current_profile,omitempty" yaml:"current_profile,omitempty"`
+	// ActiveProfile is the profile the values were taken from, set when the config is loaded.
+	ActiveProfile string `mapstructure:"active_profile" json:"active_profile,omitempty" yaml:"active_profile,omitempty"`
+	// Profiles hold values overriding the ones above, per cluster or environment.
+	Profiles map[string]map[string]any `mapstructure:"profiles" json:"profiles,omitempty" yaml:"profiles,omitempty"`
+
+	// Protected asks to type the cluster name or pass --yes before destructive commands such as delete or cancel.
+	Protected bool `mapstructure:"protected" json:"protected,omitempty" yaml:"protected,omitempty"`
+	// ReadOnly rejects all commands changing the state of the cluster.
+	ReadOnly bool `mapstructure:"read_only" json:"read_only,omitempty" yaml:"read_only,omitempty"`
+	// MaxItems limits the number of items a single destructive command may act on, 0 means no limit.
+	MaxItems int `mapstructure:"max_items" json:"max_items,omitempty" yaml:"max_items,omitempty"`
+	// ClusterName is typed to confirm destructive commands, it defaults to the active profile or the Camunda API host.
+	ClusterName string `mapstructure:"cluster_name" json:"cluster_name,omitempty" yaml:"cluster_name,omitempty"`
+
+	App   App   `mapstructure:"app" json:"app" yaml:"app"`
+	Auth  Auth  `mapstructure:"auth" json:"auth" yaml:"auth"`
+	APIs  APIs  `mapstructure:"apis" json:"apis" yaml:"apis"`
+	HTTP  HTTP  `mapstructure:"http" json:"http" yaml:"http"`
+	Audit Audit `mapstructure:"audit" json:"audit" yaml:"audit"`
+
+	secretRefs map[string]string // config key -> secret reference the value was resolved from
+	secretErrs []error
 }
 
 func (c *Config) Normalize() error {
 	var errs []error
+	c.collectSecretRefs()
 	if err := c.APIs.Normalize(); err != nil {
 		errs = append(errs, fmt.Errorf("apis:\n%w", err))
 	}
@@ -38,6 +65,9 @@ func (c *Config) Normalize() error {
 
 func (c *Config) Validate() error {
 	var errs []error
+	if len(c.secretErrs) > 0 {
+		errs = append(errs, fmt.Errorf("secrets:\n%w", errors.Join(c.secretErrs...)))
+	}
 	if err := c.Auth.Validate(); err != nil {
 		errs = append(errs, fmt.Errorf("auth:\n%w", err))
 	}
@@ -85,6 +115,7 @@ func (c *Config) toYaml(opts yamlExportOptions) (string, error) {
 
 	if len(opts.sanitizeKeys) > 0 {
 		sanitize(m, opts.sanitizeKeys)
+		c.withSecretRefs(m)
 	}
 	if opts.template {
 		blankAllLeaves(m)
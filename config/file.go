@@ -0,0 +1,133 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFile is where a config file is created if none was found: $XDG_CONFIG_HOME/kamunder/config.yaml.
+func DefaultFile() (string, error) {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("user config dir: %w", err)
+	}
+	return filepath.Join(base, "kamunder", "config.yaml"), nil
+}
+
+// ProfileNames returns the names of the profiles, sorted.
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for n := range c.Profiles {
+		names = append(names, n)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// IsKnownKey reports whether key, dot separated like "apis.camunda_api.base_url", is a config setting.
+// Below maps such as auth.oauth2.scopes any key is accepted.
+func IsKnownKey(key string) bool {
+	t := reflect.TypeOf(Config{})
+	for _, part := range strings.Split(key, ".") {
+		switch t.Kind() {
+		case reflect.Map:
+			return true
+		case reflect.Struct:
+			f, ok := fieldByTag(t, part)
+			if !ok {
+				return false
+			}
+			t = f.Type
+		default:
+			return false
+		}
+	}
+	return t.Kind() != reflect.Struct
+}
+
+func fieldByTag(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if tag, _, _ := strings.Cut(f.Tag.Get("mapstructure"), ","); f.IsExported() && tag == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// SetFileValue sets the dot separated key in the YAML file at path, keeping comments and the order of keys.
+// The file is created if it does not exist. The value is written as plain YAML scalar, so "true" becomes a bool.
+func SetFileValue(path, key, value string) error {
+	doc := &yaml.Node{Kind: yaml.DocumentNode}
+	mode := fs.FileMode(0o600)
+	b, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("read config file: %w", err)
+	default:
+		if fi, err := os.Stat(path); err == nil {
+			mode = fi.Mode().Perm()
+		}
+		if err := yaml.Unmarshal(b, doc); err != nil {
+			return fmt.Errorf("parse config file %s: %w", path, err)
+		}
+	}
+	if len(doc.Content) == 0 {
+		doc.Kind = yaml.DocumentNode
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode}}
+	}
+	node := doc.Content[0]
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("config file %s: top level is not a mapping", path)
+	}
+	parts := strings.Split(key, ".")
+	for i, part := range parts {
+		last := i == len(parts)-1
+		child := mappingValue(node, part)
+		if child == nil {
+			child = &yaml.Node{Kind: yaml.MappingNode}
+			if last {
+				child = &yaml.Node{Kind: yaml.ScalarNode}
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: part}, child)
+		}
+		if last {
+			child.Kind, child.Tag, child.Style, child.Value, child.Content = yaml.ScalarNode, "", 0, value, nil
+			break
+		}
+		if child.Kind != yaml.MappingNode {
+			return fmt.Errorf("config file %s: %s is not a mapping", path, strings.Join(parts[:i+1], "."))
+		}
+		node = child
+	}
+
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("encode config file: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	return os.WriteFile(path, out.Bytes(), mode)
+}
+
+func mappingValue(m *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetFileValue_KeepsCommentsAndCreatesSections(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(p, []byte("# base\napis:\n  camunda_api:\n    base_url: http://base/v2 # keep me\n"), 0o600))
+
+	require.NoError(t, config.SetFileValue(p, "profiles.prod.apis.camunda_api.base_url", "http://prod/v2"))
+	require.NoError(t, config.SetFileValue(p, "current_profile", "prod"))
+	require.NoError(t, config.SetFileValue(p, "apis.camunda_api.base_url", "http://new/v2"))
+
+	b, err := os.ReadFile(p)
+	require.NoError(t, err)
+	require.Equal(t, `# base
+apis:
+  camunda_api:
+    base_url: http://new/v2 # keep me
+profiles:
+  prod:
+    apis:
+      camunda_api:
+        base_url: http://prod/v2
+current_profile: prod
+`, string(b))
+}
+
+func TestIsKnownKey(t *testing.T) {
+	require.True(t, config.IsKnownKey("apis.camunda_api.base_url"))
+	require.True(t, config.IsKnownKey("auth.oauth2.scopes.camunda_api"), "any key below a map")
+	require.False(t, config.IsKnownKey("apis.camunda_api"), "a section is no value")
+	require.False(t, config.IsKnownKey("apis.nope"))
+}
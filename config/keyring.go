@@ -0,0 +1,236 @@
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+const (
+	// KeyringPassphraseEnv holds the passphrase of the keyring; without it a random key file next to the keyring is used.
+	KeyringPassphraseEnv = "KAMUNDER_KEYRING_PASSPHRASE"
+
+	keyringFile     = "keyring.json"
+	keyringKeyFile  = "keyring.key"
+	keyringKDFIter  = 600_000
+	kdfPassphrase   = "pbkdf2-sha256"
+	kdfLocalKeyFile = "local-key-file"
+)
+
+var ErrNoSecret = errors.New("no such secret in keyring")
+
+// Keyring is a local encrypted file holding named secrets, referenced as keyring:<name>.
+type Keyring struct {
+	path string
+}
+
+type keyringData struct {
+	KDF     string            `json:"kdf"`
+	Salt    string            `json:"salt"`
+	Secrets map[string]string `json:"secrets"`
+}
+
+// DefaultKeyring is keyring.json in the kamunder dir of the user config dir ($XDG_CONFIG_HOME/kamunder).
+func DefaultKeyring() (*Keyring, error) {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("user config dir: %w", err)
+	}
+	return OpenKeyring(filepath.Join(base, "kamunder", keyringFile)), nil
+}
+
+func OpenKeyring(path string) *Keyring { return &Keyring{path: path} }
+
+func (k *Keyring) Path() string { return k.path }
+
+func (k *Keyring) Get(name string) (string, error) {
+	d, err := k.load()
+	if err != nil {
+		return "", err
+	}
+	sealed, ok := d.Secrets[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrNoSecret, name)
+	}
+	gcm, err := k.aead(d)
+	if err != nil {
+		return "", err
+	}
+	raw, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil || len(raw) < gcm.NonceSize() {
+		return "", fmt.Errorf("keyring secret %s is corrupt", name)
+	}
+	plain, err := gcm.Open(nil, raw[:gcm.NonceSize()], raw[gcm.NonceSize():], []byte(name))
+	if err != nil {
+		if d.KDF == kdfPassphrase {
+			return "", fmt.Errorf("decrypt keyring secret %s: wrong %s?", name, KeyringPassphraseEnv)
+		}
+		return "", fmt.Errorf("decrypt keyring secret %s: %w", name, err)
+	}
+	return string(plain), nil
+}
+
+func (k *Keyring) Set(name, value string) error {
+	if name == "" {
+		return errors.New("secret name must not be empty")
+	}
+	d, err := k.load()
+	if err != nil {
+		return err
+	}
+	gcm, err := k.aead(d)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	d.Secrets[name] = base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(value), []byte(name)))
+	return k.save(d)
+}
+
+func (k *Keyring) Delete(name string) error {
+	d, err := k.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := d.Secrets[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrNoSecret, name)
+	}
+	delete(d.Secrets, name)
+	return k.save(d)
+}
+
+// List returns the names of the stored secrets, sorted.
+func (k *Keyring) List() ([]string, error) {
+	d, err := k.load()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(d.Secrets))
+	for n := range d.Secrets {
+		names = append(names, n)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// load reads the keyring, a missing one is empty and protected by a passphrase if one is set.
+func (k *Keyring) load() (*keyringData, error) {
+	b, err := os.ReadFile(k.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		salt := make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		kdf := kdfLocalKeyFile
+		if os.Getenv(KeyringPassphraseEnv) != "" {
+			kdf = kdfPassphrase
+		}
+		return &keyringData{KDF: kdf, Salt: base64.StdEncoding.EncodeToString(salt), Secrets: map[string]string{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read keyring: %w", err)
+	}
+	var d keyringData
+	if err := json.Unmarshal(b, &d); err != nil {
+		return nil, fmt.Errorf("read keyring %s: %w", k.path, err)
+	}
+	if d.Secrets == nil {
+		d.Secrets = map[string]string{}
+	}
+	return &d, nil
+}
+
+func (k *Keyring) save(d *keyringData) error {
+	dir := filepath.Dir(k.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create keyring dir: %w", err)
+	}
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, keyringFile+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("write keyring: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	_, err = tmp.Write(b)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("write keyring: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), k.path); err != nil {
+		return fmt.Errorf("write keyring: %w", err)
+	}
+	return nil
+}
+
+func (k *Keyring) aead(d *keyringData) (cipher.AEAD, error) {
+	salt, err := base64.StdEncoding.DecodeString(d.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("keyring salt: %w", err)
+	}
+	var key []byte
+	switch d.KDF {
+	case kdfPassphrase:
+		pass := os.Getenv(KeyringPassphraseEnv)
+		if pass == "" {
+			return nil, fmt.Errorf("keyring %s is protected by a passphrase, set %s", k.path, KeyringPassphraseEnv)
+		}
+		if key, err = pbkdf2.Key(sha256.New, pass, salt, keyringKDFIter, 32); err != nil {
+			return nil, err
+		}
+	case kdfLocalKeyFile:
+		if key, err = k.localKey(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("keyring %s: unknown kdf %q", k.path, d.KDF)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// localKey returns the random key kept next to the keyring, creating it on first use.
+func (k *Keyring) localKey() ([]byte, error) {
+	p := filepath.Join(filepath.Dir(k.path), keyringKeyFile)
+	b, err := os.ReadFile(p)
+	if err == nil && len(b) == 32 {
+		return b, nil
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("read keyring key: %w", err)
+	}
+	if err == nil {
+		return nil, fmt.Errorf("keyring key %s is corrupt", p)
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return nil, fmt.Errorf("create keyring dir: %w", err)
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(p, key, 0o600); err != nil {
+		return nil, fmt.Errorf("write keyring key: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ConfirmationName returns the name to type for confirming a destructive command on a protected profile.
+func (c *Config) ConfirmationName() string {
+	switch {
+	case c.ClusterName != "":
+		return c.ClusterName
+	case c.ActiveProfile != "":
+		return c.ActiveProfile
+	}
+	if u, err := url.Parse(c.APIs.Camunda.BaseURL); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return "yes"
+}
+
+// CheckItems returns ErrTooManyItems if a single operation on n items exceeds MaxItems.
+func (c *Config) CheckItems(n int) error {
+	if c.MaxItems > 0 && n > c.MaxItems {
+		return fmt.Errorf("%w: %d item(s) affected, max_items is %d", ErrTooManyItems, n, c.MaxItems)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfirmationName(t *testing.T) {
+	c := config.Config{APIs: config.APIs{Camunda: config.API{BaseURL: "https://camunda.prod.example.com:8443/v2"}}}
+	require.Equal(t, "camunda.prod.example.com", c.ConfirmationName())
+
+	c.ActiveProfile = "prod"
+	require.Equal(t, "prod", c.ConfirmationName())
+
+	c.ClusterName = "prod-eu-1"
+	require.Equal(t, "prod-eu-1", c.ConfirmationName())
+}
+
+func TestCheckItems(t *testing.T) {
+	c := config.Config{}
+	require.NoError(t, c.CheckItems(100000))
+
+	c.MaxItems = 50
+	require.NoError(t, c.CheckItems(50))
+	require.ErrorIs(t, c.CheckItems(51), config.ErrTooManyItems)
+}
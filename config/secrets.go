@@ -0,0 +1,166 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Secret references can stand in for client_secret, password and token, so the secret itself
+// does not have to be written into the config file:
+//
+//	file:/run/secrets/camunda   content of the file
+//	env:CAMUNDA_SECRET          value of the environment variable
+//	exec:pass show camunda/prod first line printed by the command (run without a shell)
+//	keyring:camunda-prod        secret stored with "kamunder config secret set"
+//
+// A secret that itself starts with one of these prefixes is written as literal:<secret>.
+const (
+	refFile    = "file:"
+	refEnv     = "env:"
+	refExec    = "exec:"
+	refKeyring = "keyring:"
+	refLiteral = "literal:"
+
+	secretExecTimeout = 30 * time.Second
+)
+
+var ErrSecretRef = errors.New("cannot resolve secret reference")
+
+func isSecretRef(v string) bool {
+	for _, p := range []string{refFile, refEnv, refExec, refKeyring} {
+		if strings.HasPrefix(v, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Config) secretFields() map[string]*string {
+	return map[string]*string{
+		"auth.oauth2.client_secret": &c.Auth.OAuth2.ClientSecret,
+		"auth.cookie.password":      &c.Auth.Cookie.Password,
+		"auth.bearer.token":         &c.Auth.Bearer.Token,
+	}
+}
+
+// collectSecretRefs remembers the secret references for ResolveSecrets and ToSanitizedYAML and
+// unescapes literal secrets. Nothing is resolved yet, so commands like version never run an exec: command.
+func (c *Config) collectSecretRefs() {
+	c.secretRefs, c.secretErrs = nil, nil
+	for path, v := range c.secretFields() {
+		if strings.HasPrefix(*v, refLiteral) {
+			*v = strings.TrimPrefix(*v, refLiteral)
+			continue
+		}
+		ref := strings.TrimSpace(*v)
+		if !isSecretRef(ref) {
+			continue
+		}
+		if c.secretRefs == nil {
+			c.secretRefs = make(map[string]string)
+		}
+		c.secretRefs[path] = ref
+	}
+}
+
+// ResolveSecrets replaces the secret references found by Normalize by their values. It is called only
+// by commands talking to Camunda. Failures are kept for Validate, so that commands not needing the
+// secret, e.g. the one storing a missing keyring secret, still work.
+func (c *Config) ResolveSecrets() {
+	fields := c.secretFields()
+	c.secretErrs = nil
+	for path, ref := range c.secretRefs {
+		v := fields[path]
+		secret, err := ResolveSecret(ref)
+		if err != nil {
+			c.secretErrs = append(c.secretErrs, fmt.Errorf("%s: %w", path, err))
+			*v = ""
+			continue
+		}
+		*v = secret
+	}
+}
+
+// ResolveSecret returns the secret a reference points to.
+func ResolveSecret(ref string) (string, error) {
+	switch {
+	case strings.HasPrefix(ref, refFile):
+		b, err := os.ReadFile(strings.TrimPrefix(ref, refFile))
+		if err != nil {
+			return "", fmt.Errorf("%w %q: %w", ErrSecretRef, ref, err)
+		}
+		return strings.TrimRight(string(b), "\r\n"), nil
+	case strings.HasPrefix(ref, refEnv):
+		name := strings.TrimPrefix(ref, refEnv)
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("%w %q: environment variable %s is not set", ErrSecretRef, ref, name)
+		}
+		return v, nil
+	case strings.HasPrefix(ref, refLiteral):
+		return strings.TrimPrefix(ref, refLiteral), nil
+	case strings.HasPrefix(ref, refExec):
+		return execSecret(ref)
+	case strings.HasPrefix(ref, refKeyring):
+		kr, err := DefaultKeyring()
+		if err != nil {
+			return "", fmt.Errorf("%w %q: %w", ErrSecretRef, ref, err)
+		}
+		v, err := kr.Get(strings.TrimPrefix(ref, refKeyring))
+		if err != nil {
+			return "", fmt.Errorf("%w %q: %w", ErrSecretRef, ref, err)
+		}
+		return v, nil
+	default:
+		return ref, nil
+	}
+}
+
+func execSecret(ref string) (string, error) {
+	args := strings.Fields(strings.TrimPrefix(ref, refExec))
+	if len(args) == 0 {
+		return "", fmt.Errorf("%w %q: no command", ErrSecretRef, ref)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), secretExecTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	// password managers may ask for a passphrase
+	cmd.Stdin, cmd.Stderr = os.Stdin, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%w %q: %w", ErrSecretRef, ref, err)
+	}
+	// like pass, the secret is the first line, further lines are metadata
+	line, _, _ := bufio.NewReader(&out).ReadLine()
+	if len(line) == 0 {
+		return "", fmt.Errorf("%w %q: command printed no secret", ErrSecretRef, ref)
+	}
+	return string(line), nil
+}
+
+// withSecretRefs puts the references back in place of the masked secrets.
+func (c *Config) withSecretRefs(m map[string]any) {
+	for path, ref := range c.secretRefs {
+		keys := strings.Split(path, ".")
+		cur := m
+		for _, k := range keys[:len(keys)-1] {
+			next, ok := cur[k].(map[string]any)
+			if !ok {
+				cur = nil
+				break
+			}
+			cur = next
+		}
+		if cur != nil {
+			cur[keys[len(keys)-1]] = ref
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretRefs_ResolvedAndShownAsReference(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(p, []byte("from-file\n"), 0o600))
+	t.Setenv("KAMUNDER_TEST_PASSWORD", "from-env")
+
+	cfg := config.Config{}
+	cfg.Auth.OAuth2.ClientSecret = "file:" + p
+	cfg.Auth.Cookie.Password = "env:KAMUNDER_TEST_PASSWORD"
+	cfg.Auth.Bearer.Token = "plain"
+	require.NoError(t, cfg.Normalize())
+	cfg.ResolveSecrets()
+	require.Equal(t, "from-file", cfg.Auth.OAuth2.ClientSecret)
+	require.Equal(t, "from-env", cfg.Auth.Cookie.Password)
+
+	y, err := cfg.ToSanitizedYAML()
+	require.NoError(t, err)
+	require.Contains(t, y, "client_secret: file:"+p)
+	require.Contains(t, y, "password: env:KAMUNDER_TEST_PASSWORD")
+	require.Contains(t, y, `token: '*****'`, "inline secrets stay masked")
+	require.NotContains(t, y, "from-file")
+}
+
+func TestSecretRefs_ExecFirstLine(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("needs printf")
+	}
+	v, err := config.ResolveSecret("exec:printf s3cret\\nuser:demo")
+	require.NoError(t, err)
+	require.Equal(t, "s3cret", v)
+}
+
+func TestSecretRefs_FailureReportedByValidate(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Auth.OAuth2.ClientSecret = "env:KAMUNDER_TEST_UNSET_SECRET"
+	require.NoError(t, cfg.Normalize(), "commands not needing the secret must still run")
+	cfg.ResolveSecrets()
+	err := cfg.Validate()
+	require.ErrorIs(t, err, config.ErrSecretRef)
+}
+
+func TestSecretRefs_NotResolvedByNormalize(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Auth.OAuth2.ClientSecret = "exec:kamunder-test-missing-secret-command"
+	require.NoError(t, cfg.Normalize())
+	require.Equal(t, "exec:kamunder-test-missing-secret-command", cfg.Auth.OAuth2.ClientSecret, "utility commands must not run exec: secrets")
+
+	y, err := cfg.ToSanitizedYAML()
+	require.NoError(t, err)
+	require.Contains(t, y, "client_secret: exec:kamunder-test-missing-secret-command")
+}
+
+func TestSecretRefs_LiteralEscape(t *testing.T) {
+	cfg := config.Config{}
+	cfg.Auth.Bearer.Token = "literal:env:not-a-reference"
+	require.NoError(t, cfg.Normalize())
+	cfg.ResolveSecrets()
+	require.Equal(t, "env:not-a-reference", cfg.Auth.Bearer.Token)
+
+	y, err := cfg.ToSanitizedYAML()
+	require.NoError(t, err)
+	require.Contains(t, y, `token: '*****'`, "escaped secrets stay masked")
+}
+
+func TestKeyring_Passphrase(t *testing.T) {
+	t.Setenv(config.KeyringPassphraseEnv, "correct horse")
+	kr := config.OpenKeyring(filepath.Join(t.TempDir(), "keyring.json"))
+	require.NoError(t, kr.Set("prod", "s3cret"))
+
+	b, err := os.ReadFile(kr.Path())
+	require.NoError(t, err)
+	require.False(t, strings.Contains(string(b), "s3cret"))
+
+	v, err := kr.Get("prod")
+	require.NoError(t, err)
+	require.Equal(t, "s3cret", v)
+
+	t.Setenv(config.KeyringPassphraseEnv, "wrong")
+	_, err = kr.Get("prod")
+	require.Error(t, err)
+
+	_, err = kr.Get("missing")
+	require.ErrorIs(t, err, config.ErrNoSecret)
+}
@@ -0,0 +1,44 @@
+package domain
+
+type BatchOperation struct {
+	Key                      string
+	Type                     string
+	State                    string
+	StartDate                string
+	EndDate                  string
+	OperationsTotalCount     int32
+	OperationsCompletedCount int32
+	OperationsFailedCount    int32
+	Errors                   []string
+}
+
+// IsFinished reports whether the batch operation reached a terminal state.
+func (b BatchOperation) IsFinished() bool {
+	switch b.State {
+	case "COMPLETED", "PARTIALLY_COMPLETED", "CANCELED", "FAILED":
+		return true
+	}
+	return false
+}
+
+type BatchOperationSearchFilterOpts struct {
+	Key   string
+	Type  string
+	State string
+}
+
+type BatchOperationItem struct {
+	BatchOperationKey  string
+	ItemKey            string
+	ProcessInstanceKey string
+	OperationType      string
+	State              string
+	ProcessedDate      string
+	ErrorMessage       string
+}
+
+type BatchOperationItemSearchFilterOpts struct {
+	BatchOperationKey  string
+	ProcessInstanceKey string
+	State              string
+}
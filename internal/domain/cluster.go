@@ -25,3 +25,19 @@ type Partition struct {
 
 type PartitionHealth string
 type PartitionRole string
+
+type License struct {
+	ValidLicense bool
+	LicenseType  string
+	IsCommercial bool
+	ExpiresAt    string
+}
+
+// EndpointStatus is the outcome of probing a configured API base URL.
+// StatusCode is 0 if no HTTP response was received, Error tells why.
+type EndpointStatus struct {
+	Name       string
+	URL        string
+	StatusCode int
+	Error      string
+}
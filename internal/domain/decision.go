@@ -0,0 +1,94 @@
+package domain
+
+type DecisionDefinition struct {
+	DecisionId                  string
+	DecisionRequirementsId      string
+	DecisionRequirementsKey     string
+	DecisionRequirementsName    string
+	DecisionRequirementsVersion int32
+	Key                         string
+	Name                        string
+	TenantId                    string
+	Version                     int32
+}
+
+type DecisionDefinitionSearchFilterOpts struct {
+	Key                     string
+	DecisionId              string
+	Name                    string
+	Version                 int32
+	DecisionRequirementsKey string
+}
+
+type DecisionInstance struct {
+	DecisionDefinitionId string
+	DecisionId           string
+	DecisionName         string
+	DecisionType         string
+	DecisionVersion      int32
+	EvaluatedInputs      []DecisionInput
+	EvaluatedOutputs     []DecisionOutput
+	EvaluationDate       string
+	EvaluationFailure    string
+	Id                   string
+	Key                  string
+	ProcessDefinitionKey string
+	ProcessInstanceKey   string
+	Result               string
+	State                string
+	TenantId             string
+}
+
+type DecisionInstanceSearchFilterOpts struct {
+	DecisionId           string
+	DecisionDefinitionId string
+	DecisionVersion      int32
+	ProcessInstanceKey   string
+	State                string
+}
+
+type DecisionInput struct {
+	Id    string
+	Name  string
+	Value string
+}
+
+type DecisionOutput struct {
+	Id        string
+	Name      string
+	RuleId    string
+	RuleIndex int32
+	Value     string
+}
+
+type DecisionEvaluation struct {
+	DecisionId  string
+	DecisionKey string
+	TenantId    string
+	Variables   map[string]any
+}
+
+type DecisionEvaluationResult struct {
+	DecisionId             string
+	DecisionKey            string
+	DecisionName           string
+	DecisionVersion        int32
+	DecisionInstanceKey    string
+	DecisionRequirementsId string
+	EvaluatedDecisions     []EvaluatedDecision
+	FailedDecisionId       string
+	FailureMessage         string
+	Output                 string
+	TenantId               string
+}
+
+type EvaluatedDecision struct {
+	DecisionId       string
+	DecisionKey      string
+	DecisionName     string
+	DecisionType     string
+	DecisionVersion  int32
+	EvaluatedInputs  []DecisionInput
+	EvaluatedOutputs []DecisionOutput
+	Output           string
+}
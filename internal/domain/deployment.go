@@ -23,3 +23,12 @@ type DeploymentUnitData struct {
 	ContentType string // e.g. application/xml
 	Data        []byte
 }
+
+type Resource struct {
+	Id         string `json:"resourceId,omitempty"`
+	Key        string `json:"resourceKey,omitempty"`
+	Name       string `json:"resourceName,omitempty"`
+	TenantId   string `json:"tenantId,omitempty"`
+	Version    int32  `json:"version,omitempty"`
+	VersionTag string `json:"versionTag,omitempty"`
+}
@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// DocumentReference identifies a document in the cluster's document store.
+// Passed as process variable it lets the engine and connectors resolve the document.
+type DocumentReference struct {
+	StoreId     string
+	DocumentId  string
+	ContentHash string
+	Metadata    DocumentMetadata
+}
+
+type DocumentMetadata struct {
+	ContentType         string
+	FileName            string
+	Size                int64
+	ExpiresAt           string
+	ProcessDefinitionId string
+	ProcessInstanceKey  string
+	CustomProperties    map[string]any
+}
+
+// DocumentUpload is a file to store; Metadata.FileName and Metadata.ContentType describe Data.
+type DocumentUpload struct {
+	StoreId    string
+	DocumentId string
+	Data       []byte
+	Metadata   DocumentMetadata
+}
+
+type DocumentCreationFailure struct {
+	FileName string
+	Detail   string
+}
+
+// DocumentBatch is the outcome of a multi document upload, which may succeed partially.
+type DocumentBatch struct {
+	Created []DocumentReference
+	Failed  []DocumentCreationFailure
+}
+
+type DocumentLink struct {
+	Url       string
+	ExpiresAt string
+}
+
+type DocumentLinkRequest struct {
+	StoreId     string
+	ContentHash string
+	TimeToLive  time.Duration
+}
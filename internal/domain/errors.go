@@ -19,4 +19,5 @@ var (
 	ErrUpstream          = errors.New("upstream error")
 	ErrInternal          = errors.New("internal error")
 	ErrMalformedResponse = errors.New("malformed response")
+	ErrNotSupported      = errors.New("not supported by the configured camunda version")
 )
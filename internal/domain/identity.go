@@ -0,0 +1,42 @@
+package domain
+
+// IdentityKind names the type of an identity entity.
+type IdentityKind string
+
+const (
+	IdentityUser        IdentityKind = "user"
+	IdentityGroup       IdentityKind = "group"
+	IdentityRole        IdentityKind = "role"
+	IdentityTenant      IdentityKind = "tenant"
+	IdentityMappingRule IdentityKind = "mapping-rule"
+	IdentityClient      IdentityKind = "client"
+)
+
+// Identity is a user, group, role, tenant, mapping rule or client.
+// Id holds the username, group, role, tenant, mapping rule or client id depending on Kind.
+type Identity struct {
+	Kind        IdentityKind
+	Id          string
+	Name        string
+	Email       string
+	Password    string
+	Description string
+	ClaimName   string
+	ClaimValue  string
+}
+
+type Authorization struct {
+	Key          string
+	OwnerId      string
+	OwnerType    string
+	ResourceType string
+	ResourceId   string
+	Permissions  []string
+}
+
+type AuthorizationSearchFilterOpts struct {
+	OwnerId      string
+	OwnerType    string
+	ResourceType string
+	ResourceId   string
+}
@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+type Incident struct {
+	Key                  string
+	ProcessInstanceKey   string
+	ProcessDefinitionKey string
+	ProcessDefinitionId  string
+	ErrorType            string
+	ErrorMessage         string
+	ElementId            string
+	ElementInstanceKey   string
+	JobKey               string
+	State                string
+	CreationTime         string
+	TenantId             string
+}
+
+type IncidentSearchFilterOpts struct {
+	ProcessDefinitionId string
+	ProcessInstanceKey  string
+	ErrorType           string
+	State               string
+	Since               time.Time // only incidents created at or after
+}
+
+// IncidentResolution summarizes the resolution of all incidents matching a filter.
+type IncidentResolution struct {
+	BatchOperationKey   string // set when resolved by a server-side batch operation
+	Incidents           int
+	Truncated           bool // more incidents matched than the size limit, only the first ones were resolved
+	ProcessInstanceKeys []string
+	Failures            []IncidentResolutionFailure
+	Pending             []string // process instances still reporting an incident after waiting
+}
+
+type IncidentResolutionFailure struct {
+	IncidentKey        string
+	ProcessInstanceKey string
+	Error              string
+}
+
+// ResolvedProcessInstanceKeys returns the affected process instances without a failed resolution.
+func (r IncidentResolution) ResolvedProcessInstanceKeys() []string {
+	failed := make(map[string]bool, len(r.Failures))
+	for _, f := range r.Failures {
+		failed[f.ProcessInstanceKey] = true
+	}
+	var out []string
+	for _, k := range r.ProcessInstanceKeys {
+		if !failed[k] {
+			out = append(out, k)
+		}
+	}
+	return out
+}
@@ -0,0 +1,78 @@
+package domain
+
+type Job struct {
+	Key                      string
+	Type                     string
+	Kind                     string
+	State                    string
+	Worker                   string
+	Retries                  int32
+	HasFailedWithRetriesLeft bool
+	ErrorCode                string
+	ErrorMessage             string
+	Deadline                 string
+	EndTime                  string
+	ElementId                string
+	ElementInstanceKey       string
+	ProcessDefinitionId      string
+	ProcessDefinitionKey     string
+	ProcessInstanceKey       string
+	TenantId                 string
+	CustomHeaders            map[string]string
+}
+
+type JobSearchFilterOpts struct {
+	Key                 string
+	Type                string
+	State               string
+	Worker              string
+	ElementId           string
+	ProcessDefinitionId string
+	ProcessInstanceKey  string
+}
+
+// JobUpdate holds the job attributes to change; zero values are left unchanged.
+type JobUpdate struct {
+	Retries int32
+	Timeout int64 // in milliseconds, starting from now
+}
+
+type JobFailure struct {
+	ErrorMessage string
+	Retries      int32
+	RetryBackOff int64 // in milliseconds
+	Variables    map[string]any
+}
+
+type JobError struct {
+	ErrorCode    string
+	ErrorMessage string
+	Variables    map[string]any
+}
+
+type JobActivation struct {
+	Type              string
+	Worker            string
+	Timeout           int64 // in milliseconds
+	MaxJobsToActivate int32
+	RequestTimeout    int64 // in milliseconds, long polling
+	FetchVariables    []string
+	TenantIds         []string
+}
+
+type ActivatedJob struct {
+	Key                      string
+	Type                     string
+	Worker                   string
+	Retries                  int32
+	Deadline                 int64
+	ElementId                string
+	ElementInstanceKey       string
+	ProcessDefinitionId      string
+	ProcessDefinitionKey     string
+	ProcessDefinitionVersion int32
+	ProcessInstanceKey       string
+	TenantId                 string
+	CustomHeaders            map[string]any
+	Variables                map[string]any
+}
@@ -0,0 +1,63 @@
+package domain
+
+type MessagePublication struct {
+	Name           string
+	CorrelationKey string
+	MessageId      string
+	TimeToLive     int64 // in milliseconds
+	TenantId       string
+	Variables      map[string]any
+}
+
+type MessagePublicationResult struct {
+	MessageKey string
+	TenantId   string
+}
+
+type MessageCorrelation struct {
+	Name           string
+	CorrelationKey string
+	TenantId       string
+	Variables      map[string]any
+}
+
+type MessageCorrelationResult struct {
+	MessageKey         string
+	ProcessInstanceKey string
+	TenantId           string
+}
+
+type MessageSubscription struct {
+	Key                  string
+	MessageName          string
+	CorrelationKey       string
+	ElementId            string
+	ElementInstanceKey   string
+	ProcessDefinitionId  string
+	ProcessDefinitionKey string
+	ProcessInstanceKey   string
+	State                string
+	LastUpdatedDate      string
+	TenantId             string
+}
+
+type CorrelatedMessageSubscription struct {
+	SubscriptionKey      string
+	MessageKey           string
+	MessageName          string
+	CorrelationKey       string
+	CorrelationTime      string
+	ElementId            string
+	ElementInstanceKey   string
+	ProcessDefinitionId  string
+	ProcessDefinitionKey string
+	ProcessInstanceKey   string
+	TenantId             string
+}
+
+type MessageSubscriptionSearchFilterOpts struct {
+	MessageName        string
+	CorrelationKey     string
+	ProcessInstanceKey string
+	State              string
+}
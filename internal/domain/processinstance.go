@@ -19,6 +19,16 @@ type ProcessInstance struct {
 	TenantId                  string
 }
 
+// ProcessInstanceCreation starts an instance of the definition given by ProcessDefinitionKey
+// or by BpmnProcessId and ProcessVersion, the latest version if ProcessVersion is 0.
+type ProcessInstanceCreation struct {
+	BpmnProcessId        string
+	ProcessDefinitionKey string
+	ProcessVersion       int32
+	TenantId             string
+	Variables            map[string]any
+}
+
 type ProcessInstanceSearchFilterOpts struct {
 	Key               string
 	BpmnProcessId     string
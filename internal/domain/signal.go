@@ -0,0 +1,25 @@
+package domain
+
+type SignalBroadcast struct {
+	Name      string
+	TenantId  string
+	Variables map[string]any
+}
+
+type SignalBroadcastResult struct {
+	SignalKey         string
+	TenantId          string
+	Verified          bool
+	CatchingInstances []SignalCatchInstance
+}
+
+// SignalCatchInstance is an element instance waiting for a signal, captured before the broadcast.
+type SignalCatchInstance struct {
+	Key                  string
+	ElementId            string
+	ProcessInstanceKey   string
+	ProcessDefinitionKey string
+	StateBefore          string
+	StateAfter           string
+	TenantId             string
+}
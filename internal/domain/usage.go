@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// UsageMetrics are the license relevant counters for the time range [From, To).
+// ClusterId is only set for metrics reported by the Self-Managed administration API.
+type UsageMetrics struct {
+	ClusterId         string
+	From              time.Time
+	To                time.Time
+	ProcessInstances  int64
+	DecisionInstances int64
+	Assignees         int64
+}
+
+// AdminCluster is a cluster as known to the Self-Managed Console administration API.
+type AdminCluster struct {
+	Uuid       string
+	Name       string
+	Namespace  string
+	Generation string
+	Status     string
+	Type       string
+}
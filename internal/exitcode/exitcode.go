@@ -8,4 +8,6 @@ const (
 	Timeout     = 4
 	Unavailable = 5
 	Conflict    = 6
+	Unhealthy   = 7
+	Degraded    = 8
 )
@@ -3,7 +3,11 @@ package authenticator
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
 )
 
 var (
@@ -20,6 +24,28 @@ type Authenticator interface {
 	Name() string
 }
 
+// Refresher is implemented by authenticators that re-acquire credentials on their own.
+// A request rejected with 401 is retried once after Invalidate dropped the cached credentials.
+type Refresher interface {
+	Invalidate()
+}
+
+// UserLogin is implemented by authenticators acting for a person who logs in interactively.
+// Login prints instructions, e.g. the URL to open, to out; an empty flow uses the configured one.
+type UserLogin interface {
+	Login(ctx context.Context, flow config.OIDCFlow, out io.Writer) error
+	Session(ctx context.Context) (Session, error)
+	Logout() error
+}
+
+// Session is the login state of a UserLogin authenticator.
+type Session struct {
+	LoggedIn    bool      `json:"loggedIn"`
+	Subject     string    `json:"subject,omitempty"`
+	ExpiresAt   time.Time `json:"expiresAt,omitzero"`
+	Refreshable bool      `json:"refreshable"`
+}
+
 type BearerProvider interface {
 	Token(ctx context.Context, target string) (string, error)
 }
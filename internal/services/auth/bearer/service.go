@@ -0,0 +1,122 @@
+package bearer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/internal/services/auth/authenticator"
+	"github.com/grafvonb/kamunder/internal/services/common"
+)
+
+// Service sends a static bearer token from the config, an environment variable or a file.
+type Service struct {
+	cfg *config.Config
+	log *slog.Logger
+
+	mu      sync.Mutex
+	token   string
+	modTime time.Time // of the token file when it was read
+	size    int64
+}
+
+type Option func(*Service)
+
+func New(cfg *config.Config, _ *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	if cfg == nil {
+		return nil, errors.New("cfg must not be nil")
+	}
+	if log == nil {
+		return nil, errors.New("logger must not be nil")
+	}
+	cfg.APIs.Operate.BaseURL = common.DefaultVal(cfg.APIs.Operate.BaseURL, cfg.APIs.Camunda.BaseURL)
+	cfg.APIs.Tasklist.BaseURL = common.DefaultVal(cfg.APIs.Tasklist.BaseURL, cfg.APIs.Camunda.BaseURL)
+
+	s := &Service{cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) Name() string { return "bearer" }
+
+func (s *Service) Init(ctx context.Context) error {
+	_, err := s.Token(ctx, "")
+	return err
+}
+
+func (s *Service) Editor() authenticator.RequestEditor {
+	return func(ctx context.Context, req *http.Request) error {
+		tok, err := s.Token(ctx, "")
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+tok)
+		return nil
+	}
+}
+
+// ClearCache forgets the token, it is read again from its source on the next request.
+func (s *Service) ClearCache() {
+	s.mu.Lock()
+	s.token, s.modTime, s.size = "", time.Time{}, 0
+	s.mu.Unlock()
+}
+
+// Invalidate reads the token again after the server rejected it, it may have been rotated in the meantime.
+func (s *Service) Invalidate() {
+	s.log.Debug("re-reading rejected bearer token")
+	s.ClearCache()
+}
+
+// Token returns the configured token; the target is ignored. A token file is read again when it changed.
+func (s *Service) Token(_ context.Context, _ string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.cfg.Auth.Bearer
+	switch {
+	case b.Token != "":
+		return strings.TrimSpace(b.Token), nil
+	case b.TokenEnv != "":
+		tok := strings.TrimSpace(os.Getenv(b.TokenEnv))
+		if tok == "" {
+			return "", fmt.Errorf("bearer token: environment variable %s is empty or not set", b.TokenEnv)
+		}
+		return tok, nil
+	case b.TokenFile != "":
+		return s.fromFile(b.TokenFile)
+	default:
+		return "", errors.New("bearer token: no token source configured")
+	}
+}
+
+func (s *Service) fromFile(path string) (string, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("bearer token file: %w", err)
+	}
+	if s.token != "" && fi.ModTime().Equal(s.modTime) && fi.Size() == s.size {
+		return s.token, nil
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("bearer token file: %w", err)
+	}
+	tok := strings.TrimSpace(string(raw))
+	if tok == "" {
+		return "", fmt.Errorf("bearer token file %s is empty", path)
+	}
+	if s.token != "" {
+		s.log.Debug(fmt.Sprintf("bearer token file %s changed, re-read it", path))
+	}
+	s.token, s.modTime, s.size = tok, fi.ModTime(), fi.Size()
+	return tok, nil
+}
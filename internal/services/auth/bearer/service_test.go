@@ -0,0 +1,51 @@
+package bearer_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/internal/services/auth/bearer"
+	"github.com/stretchr/testify/require"
+)
+
+func bearerConfig(b config.AuthBearer) *config.Config {
+	return &config.Config{Auth: config.Auth{Mode: config.ModeBearer, Bearer: b}}
+}
+
+func TestBearer_TokenFileReReadOnChange(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(p, []byte("tok-1\n"), 0o600))
+
+	svc, err := bearer.New(bearerConfig(config.AuthBearer{TokenFile: p}), nil, slog.Default())
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, svc.Init(ctx))
+	tok, err := svc.Token(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, "tok-1", tok)
+
+	require.NoError(t, os.WriteFile(p, []byte("tok-22\n"), 0o600))
+	// make the change visible on file systems with coarse mtime
+	later := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(p, later, later))
+	tok, err = svc.Token(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, "tok-22", tok)
+}
+
+func TestBearer_TokenFromEnv(t *testing.T) {
+	t.Setenv("KAMUNDER_TEST_BEARER", "env-tok")
+	svc, err := bearer.New(bearerConfig(config.AuthBearer{TokenEnv: "KAMUNDER_TEST_BEARER"}), nil, slog.Default())
+	require.NoError(t, err)
+	tok, err := svc.Token(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, "env-tok", tok)
+
+	t.Setenv("KAMUNDER_TEST_BEARER", "")
+	require.Error(t, svc.Init(context.Background()), "an empty token must fail early")
+}
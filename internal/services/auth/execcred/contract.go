@@ -0,0 +1,7 @@
+package execcred
+
+import "github.com/grafvonb/kamunder/internal/services/auth/authenticator"
+
+var _ authenticator.Authenticator = (*Service)(nil)
+var _ authenticator.Refresher = (*Service)(nil)
+var _ authenticator.BearerProvider = (*Service)(nil)
@@ -0,0 +1,163 @@
+// Package execcred gets bearer tokens from an external credential helper, e.g. a company token broker.
+package execcred
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/internal/services/auth/authenticator"
+	"github.com/grafvonb/kamunder/internal/services/common"
+)
+
+const (
+	defaultTimeout = time.Minute
+	// defaultExpirySkew is how long before its expiry the helper is asked for a new token.
+	defaultExpirySkew = 30 * time.Second
+)
+
+// credential is what the helper prints to stdout: either {"token": "...", "expires_at": "<RFC3339>"}
+// or a kubectl ExecCredential with the token in status.
+type credential struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Status    *struct {
+		Token               string    `json:"token"`
+		ExpirationTimestamp time.Time `json:"expirationTimestamp"`
+	} `json:"status"`
+}
+
+type Service struct {
+	cfg     *config.Config
+	log     *slog.Logger
+	timeout time.Duration
+	skew    time.Duration
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time // zero if the helper did not tell, the token is then kept until rejected
+}
+
+type Option func(*Service)
+
+func WithExpirySkew(d time.Duration) Option {
+	return func(s *Service) { s.skew = d }
+}
+
+func New(cfg *config.Config, _ *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	if cfg == nil {
+		return nil, errors.New("cfg must not be nil")
+	}
+	if log == nil {
+		return nil, errors.New("logger must not be nil")
+	}
+	cfg.APIs.Operate.BaseURL = common.DefaultVal(cfg.APIs.Operate.BaseURL, cfg.APIs.Camunda.BaseURL)
+	cfg.APIs.Tasklist.BaseURL = common.DefaultVal(cfg.APIs.Tasklist.BaseURL, cfg.APIs.Camunda.BaseURL)
+
+	timeout := defaultTimeout
+	if cfg.Auth.Exec.Timeout != "" {
+		d, err := time.ParseDuration(cfg.Auth.Exec.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("parse exec timeout: %w", err)
+		}
+		timeout = d
+	}
+	s := &Service{cfg: cfg, log: log, timeout: timeout, skew: defaultExpirySkew}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) Name() string { return "exec" }
+
+// Init runs nothing yet, the helper is only asked when a request needs a token.
+func (s *Service) Init(_ context.Context) error { return nil }
+
+func (s *Service) Editor() authenticator.RequestEditor {
+	return func(ctx context.Context, req *http.Request) error {
+		tok, err := s.Token(ctx, "")
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+tok)
+		return nil
+	}
+}
+
+func (s *Service) ClearCache() {
+	s.mu.Lock()
+	s.token, s.expiresAt = "", time.Time{}
+	s.mu.Unlock()
+}
+
+// Invalidate drops the token after the server rejected it, the helper is asked again on the next request.
+func (s *Service) Invalidate() {
+	s.log.Debug("invalidating token of the credential helper")
+	s.ClearCache()
+}
+
+// Token returns the token of the helper, running it again shortly before the token expires; the target is ignored.
+func (s *Service) Token(ctx context.Context, _ string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != "" && (s.expiresAt.IsZero() || time.Now().Add(s.skew).Before(s.expiresAt)) {
+		return s.token, nil
+	}
+	tok, exp, err := s.run(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.token, s.expiresAt = tok, exp
+	return tok, nil
+}
+
+func (s *Service) run(ctx context.Context) (string, time.Time, error) {
+	ec := s.cfg.Auth.Exec
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	s.log.Debug(fmt.Sprintf("running credential helper %s", ec.Command))
+	cmd := exec.CommandContext(ctx, ec.Command, ec.Args...)
+	cmd.Env = os.Environ()
+	for k, v := range ec.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	// the helper may prompt, e.g. for a second factor
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", time.Time{}, fmt.Errorf("credential helper %s: %w", ec.Command, err)
+	}
+
+	var c credential
+	if err := json.Unmarshal(stdout.Bytes(), &c); err != nil {
+		return "", time.Time{}, fmt.Errorf("credential helper %s: invalid output: %w", ec.Command, err)
+	}
+	tok, exp := c.Token, c.ExpiresAt
+	if c.Status != nil {
+		tok = common.DefaultVal(tok, c.Status.Token)
+		if exp.IsZero() {
+			exp = c.Status.ExpirationTimestamp
+		}
+	}
+	tok = strings.TrimSpace(tok)
+	if tok == "" {
+		return "", time.Time{}, fmt.Errorf("credential helper %s: no token in output", ec.Command)
+	}
+	if !exp.IsZero() && !time.Now().Before(exp) {
+		return "", time.Time{}, fmt.Errorf("credential helper %s: token expired at %s", ec.Command, exp.Format(time.RFC3339))
+	}
+	return tok, exp, nil
+}
@@ -0,0 +1,76 @@
+package execcred_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/internal/services/auth/execcred"
+	"github.com/stretchr/testify/require"
+)
+
+// helper returns an exec config running a shell script that counts its runs in a file and prints output.
+func helper(t *testing.T, output string) (*config.Config, func() int) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("credential helper test script needs a POSIX shell")
+	}
+	runs := filepath.Join(t.TempDir(), "runs")
+	script := fmt.Sprintf("echo run >> %q; printf '%%s' '%s'", runs, output)
+	cfg := &config.Config{Auth: config.Auth{Mode: config.ModeExec, Exec: config.AuthExec{Command: "sh", Args: []string{"-c", script}}}}
+	count := func() int {
+		b, _ := os.ReadFile(runs)
+		return strings.Count(string(b), "run")
+	}
+	return cfg, count
+}
+
+func TestExec_TokenCachedUntilExpiry(t *testing.T) {
+	exp := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	cfg, runs := helper(t, fmt.Sprintf(`{"token":"tok-1","expires_at":"%s"}`, exp))
+
+	svc, err := execcred.New(cfg, nil, slog.Default())
+	require.NoError(t, err)
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		tok, err := svc.Token(ctx, "")
+		require.NoError(t, err)
+		require.Equal(t, "tok-1", tok)
+	}
+	require.Equal(t, 1, runs())
+
+	svc.Invalidate()
+	_, err = svc.Token(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, runs(), "a rejected token must be fetched again")
+}
+
+func TestExec_KubectlExecCredential(t *testing.T) {
+	exp := time.Now().Add(10 * time.Second).UTC().Format(time.RFC3339)
+	cfg, runs := helper(t, fmt.Sprintf(`{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential","status":{"token":"kube-tok","expirationTimestamp":"%s"}}`, exp))
+
+	svc, err := execcred.New(cfg, nil, slog.Default(), execcred.WithExpirySkew(30*time.Second))
+	require.NoError(t, err)
+	ctx := context.Background()
+	tok, err := svc.Token(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, "kube-tok", tok)
+	_, err = svc.Token(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, runs(), "a token expiring within the skew must be fetched again")
+}
+
+func TestExec_HelperFailure(t *testing.T) {
+	cfg, _ := helper(t, `not json`)
+	svc, err := execcred.New(cfg, nil, slog.Default())
+	require.NoError(t, err)
+	_, err = svc.Token(context.Background(), "")
+	require.ErrorContains(t, err, "invalid output")
+}
@@ -7,8 +7,11 @@ import (
 
 	"github.com/grafvonb/kamunder/config"
 	"github.com/grafvonb/kamunder/internal/services/auth/authenticator"
+	"github.com/grafvonb/kamunder/internal/services/auth/bearer"
 	"github.com/grafvonb/kamunder/internal/services/auth/cookie"
+	"github.com/grafvonb/kamunder/internal/services/auth/execcred"
 	"github.com/grafvonb/kamunder/internal/services/auth/oauth2"
+	"github.com/grafvonb/kamunder/internal/services/auth/oidcuser"
 )
 
 func BuildAuthenticator(cfg *config.Config, httpClient *http.Client, log *slog.Logger) (authenticator.Authenticator, error) {
@@ -17,6 +20,12 @@ func BuildAuthenticator(cfg *config.Config, httpClient *http.Client, log *slog.L
 		return oauth2.New(cfg, httpClient, log)
 	case config.ModeCookie:
 		return cookie.New(cfg, httpClient, log)
+	case config.ModeOIDCUser:
+		return oidcuser.New(cfg, httpClient, log)
+	case config.ModeBearer:
+		return bearer.New(cfg, httpClient, log)
+	case config.ModeExec:
+		return execcred.New(cfg, httpClient, log)
 	default:
 		return nil, fmt.Errorf("unknown auth mode: %s", cfg.Auth.Mode)
 	}
@@ -0,0 +1,92 @@
+package oauth2
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+)
+
+const (
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	// assertionLifetime is short, an assertion is used for a single token request.
+	assertionLifetime = time.Minute
+)
+
+// assertionSigner creates client assertions for private_key_jwt client authentication (RFC 7523).
+type assertionSigner struct {
+	key      crypto.Signer
+	alg      string
+	kid      string
+	clientID string
+	audience string
+}
+
+func newAssertionSigner(a *config.AuthClientAssertion, clientID, tokenEndpoint string) (*assertionSigner, error) {
+	key, alg, err := a.SigningKey()
+	if err != nil {
+		return nil, err
+	}
+	aud := a.Audience
+	if aud == "" {
+		aud = tokenEndpoint
+	}
+	return &assertionSigner{key: key, alg: alg, kid: a.KeyID, clientID: clientID, audience: aud}, nil
+}
+
+func (s *assertionSigner) sign(now time.Time) (string, error) {
+	header := map[string]string{"alg": s.alg, "typ": "JWT"}
+	if s.kid != "" {
+		header["kid"] = s.kid
+	}
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+	claims := map[string]any{
+		"iss": s.clientID,
+		"sub": s.clientID,
+		"aud": s.audience,
+		"jti": hex.EncodeToString(jti),
+		"iat": now.Unix(),
+		"exp": now.Add(assertionLifetime).Unix(),
+	}
+	h, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	c, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(h) + "." + base64.RawURLEncoding.EncodeToString(c)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var sig []byte
+	switch k := s.key.(type) {
+	case *rsa.PrivateKey:
+		if sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:]); err != nil {
+			return "", err
+		}
+	case *ecdsa.PrivateKey:
+		// JWS wants the raw r||s concatenation, not the ASN.1 encoding (RFC 7518 section 3.4)
+		r, ss, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		if err != nil {
+			return "", err
+		}
+		sig = make([]byte, 64)
+		r.FillBytes(sig[:32])
+		ss.FillBytes(sig[32:])
+	default:
+		return "", fmt.Errorf("unsupported key type %T", s.key)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
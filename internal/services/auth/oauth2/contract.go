@@ -15,3 +15,4 @@ type GenAuthClient interface {
 }
 
 var _ authenticator.Authenticator = (*Service)(nil)
+var _ authenticator.Refresher = (*Service)(nil)
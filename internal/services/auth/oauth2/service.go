@@ -11,16 +11,32 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/grafvonb/kamunder/config"
 	"github.com/grafvonb/kamunder/internal/clients/auth/oauth2"
 	"github.com/grafvonb/kamunder/internal/services/auth/authenticator"
+	"github.com/grafvonb/kamunder/internal/services/auth/tokencache"
 	"github.com/grafvonb/kamunder/internal/services/common"
 	"github.com/grafvonb/kamunder/internal/services/httpc"
 )
 
+// defaultExpirySkew is how long before its expiry a cached token is refreshed,
+// so that it does not expire while a request is in flight.
+const defaultExpirySkew = 30 * time.Second
+
 type TargetResolver func(*http.Request) string
 
+// cachedToken is an access token and when it expires; a zero expiresAt means the server did not tell.
+type cachedToken struct {
+	token     string
+	expiresAt time.Time
+}
+
+func (t cachedToken) validAt(now time.Time, skew time.Duration) bool {
+	return t.token != "" && (t.expiresAt.IsZero() || now.Add(skew).Before(t.expiresAt))
+}
+
 type Service struct {
 	c          GenAuthClient
 	cfg        *config.Config
@@ -29,10 +45,13 @@ type Service struct {
 	headerName string
 	prefix     string
 
-	tokenURL *url.URL
+	tokenURL  *url.URL
+	skew      time.Duration
+	disk      *tokencache.Cache
+	assertion *assertionSigner
 
 	mu    sync.Mutex
-	cache map[string]string
+	cache map[string]cachedToken
 }
 
 type Option func(*Service)
@@ -49,6 +68,16 @@ func WithAuthHeader(name, prefix string) Option {
 	return func(s *Service) { s.headerName, s.prefix = name, prefix }
 }
 
+// WithExpirySkew sets how long before expiry a cached token is refreshed.
+func WithExpirySkew(d time.Duration) Option {
+	return func(s *Service) { s.skew = d }
+}
+
+// WithTokenCache keeps tokens in the given on-disk cache in addition to memory.
+func WithTokenCache(c *tokencache.Cache) Option {
+	return func(s *Service) { s.disk = c }
+}
+
 func New(cfg *config.Config, apiHTTP *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
 	if cfg == nil {
 		return nil, errors.New("cfg must not be nil")
@@ -66,6 +95,21 @@ func New(cfg *config.Config, apiHTTP *http.Client, log *slog.Logger, opts ...Opt
 		return nil, fmt.Errorf("parse token url: %w", err)
 	}
 	tokenHTTP := &http.Client{Timeout: apiHTTP.Timeout, Transport: &httpc.LogTransport{Log: log}}
+	if cfg.Auth.OAuth2.MTLS.Enabled() {
+		tc, err := cfg.Auth.OAuth2.MTLS.TLSConfig()
+		if err != nil {
+			return nil, fmt.Errorf("mtls: %w", err)
+		}
+		base := http.DefaultTransport.(*http.Transport).Clone()
+		base.TLSClientConfig = tc
+		tokenHTTP.Transport = httpc.NewLogTransport(base, log)
+	}
+	var assertion *assertionSigner
+	if cfg.Auth.OAuth2.ClientAssertion.Enabled() {
+		if assertion, err = newAssertionSigner(&cfg.Auth.OAuth2.ClientAssertion, cfg.Auth.OAuth2.ClientID, tokenEndpoint(tu)); err != nil {
+			return nil, fmt.Errorf("client assertion: %w", err)
+		}
+	}
 
 	cfg.APIs.Operate.BaseURL = common.DefaultVal(cfg.APIs.Operate.BaseURL, cfg.APIs.Camunda.BaseURL)
 	cfg.APIs.Tasklist.BaseURL = common.DefaultVal(cfg.APIs.Tasklist.BaseURL, cfg.APIs.Camunda.BaseURL)
@@ -83,7 +127,16 @@ func New(cfg *config.Config, apiHTTP *http.Client, log *slog.Logger, opts ...Opt
 		headerName: "Authorization",
 		prefix:     "Bearer ",
 		tokenURL:   tu,
-		cache:      make(map[string]string),
+		skew:       defaultExpirySkew,
+		assertion:  assertion,
+		cache:      make(map[string]cachedToken),
+	}
+	if cfg.Auth.OAuth2.TokenCache {
+		if disk, err := newDiskCache(cfg.Auth.OAuth2.ClientSecret); err != nil {
+			log.Warn(fmt.Sprintf("token cache disabled: %v", err))
+		} else {
+			s.disk = disk
+		}
 	}
 	for _, opt := range opts {
 		opt(s)
@@ -91,6 +144,22 @@ func New(cfg *config.Config, apiHTTP *http.Client, log *slog.Logger, opts ...Opt
 	return s, nil
 }
 
+// newDiskCache encrypts the cached tokens with the client secret, or with the local secret of the cache
+// dir for client assertions and mTLS, which have no client secret.
+func newDiskCache(clientSecret string) (*tokencache.Cache, error) {
+	dir, err := tokencache.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+	secret := clientSecret
+	if secret == "" {
+		if secret, err = tokencache.LocalSecret(dir); err != nil {
+			return nil, err
+		}
+	}
+	return tokencache.New(dir, secret), nil
+}
+
 func (s *Service) Name() string { return "oauth2" }
 
 func (s *Service) Init(_ context.Context) error { return nil }
@@ -110,6 +179,8 @@ func (s *Service) Editor() authenticator.RequestEditor {
 			target = s.cfg.APIs.Tasklist.Key
 		case s.cfg.APIs.Operate.RequireScope && strings.Contains(u, s.cfg.APIs.Operate.BaseURL):
 			target = s.cfg.APIs.Operate.Key
+		case s.cfg.APIs.Administration.RequireScope && s.cfg.APIs.Administration.BaseURL != "" && strings.Contains(u, s.cfg.APIs.Administration.BaseURL):
+			target = s.cfg.APIs.Administration.Key
 		}
 		tok, err := s.RetrieveTokenForAPI(ctx, target)
 		if err != nil {
@@ -128,10 +199,31 @@ func sameURL(a, b *url.URL) bool {
 
 func (s *Service) ClearCache() {
 	s.mu.Lock()
-	s.cache = make(map[string]string)
+	s.cache = make(map[string]cachedToken)
 	s.mu.Unlock()
 }
 
+// Invalidate drops the cached tokens after the server rejected one, the next request fetches a new token.
+func (s *Service) Invalidate() {
+	s.log.Debug("invalidating cached bearer tokens")
+	s.ClearCache()
+	if _, err := s.ClearTokenCache(); err != nil {
+		s.log.Warn(fmt.Sprintf("clearing token cache: %v", err))
+	}
+}
+
+// ClearTokenCache removes the tokens of the configured client from the on-disk cache, if enabled.
+func (s *Service) ClearTokenCache() (int, error) {
+	if s.disk == nil {
+		return 0, nil
+	}
+	return s.disk.ClearClient(s.diskKey(""))
+}
+
+func (s *Service) diskKey(scope string) tokencache.Key {
+	return tokencache.Key{TokenURL: s.tokenURL.String(), ClientID: s.cfg.Auth.OAuth2.ClientID, Scope: scope}
+}
+
 func (s *Service) Token(ctx context.Context, target string) (string, error) {
 	return s.RetrieveTokenForAPI(ctx, target)
 }
@@ -142,52 +234,117 @@ func (s *Service) RetrieveTokenForAPI(ctx context.Context, target string) (strin
 	}
 	s.log.Debug(fmt.Sprintf("looking up bearer token in cache for target: %s", target))
 	s.mu.Lock()
-	if tok, ok := s.cache[target]; ok && tok != "" {
-		s.mu.Unlock()
-		s.log.Debug(fmt.Sprintf("found bearer token in cache for target: %s", target))
-		return tok, nil
+	if ct, ok := s.cache[target]; ok {
+		if ct.validAt(time.Now(), s.skew) {
+			s.mu.Unlock()
+			s.log.Debug(fmt.Sprintf("found bearer token in cache for target: %s", target))
+			return ct.token, nil
+		}
+		s.log.Debug(fmt.Sprintf("cached bearer token for target %s expires at %s, refreshing", target, ct.expiresAt.Format(time.RFC3339)))
 	}
 	s.mu.Unlock()
 
 	scope := s.cfg.Auth.OAuth2.Scope(target)
-	s.log.Debug(fmt.Sprintf("fetching bearer token for target: %s", target))
-	tok, err := s.requestToken(ctx, s.cfg.Auth.OAuth2.ClientID, s.cfg.Auth.OAuth2.ClientSecret, scope)
+	ct, err := s.fetchToken(ctx, target, scope)
 	if err != nil {
 		return "", fmt.Errorf("retrieve token for %s: %w", target, err)
 	}
 
 	s.log.Debug(fmt.Sprintf("puting bearer token in cache for target: %s", target))
 	s.mu.Lock()
-	s.cache[target] = tok
+	s.cache[target] = ct
 	s.mu.Unlock()
-	return tok, nil
+	return ct.token, nil
+}
+
+// fetchToken requests a token, going through the on-disk cache if enabled. The cache entry is
+// locked while the token is requested, so parallel invocations wait for and reuse the same token.
+func (s *Service) fetchToken(ctx context.Context, target, scope string) (cachedToken, error) {
+	if s.disk == nil {
+		s.log.Debug(fmt.Sprintf("fetching bearer token for target: %s", target))
+		return s.requestToken(ctx, s.cfg.Auth.OAuth2.ClientID, s.cfg.Auth.OAuth2.ClientSecret, scope)
+	}
+	key := s.diskKey(scope)
+	unlock, err := s.disk.Lock(key)
+	if err != nil {
+		s.log.Warn(fmt.Sprintf("token cache not locked, fetching token anyway: %v", err))
+		unlock = func() {}
+	}
+	defer unlock()
+
+	if e, ok, err := s.disk.Load(key); err != nil {
+		s.log.Warn(fmt.Sprintf("reading token cache: %v", err))
+	} else if ct := (cachedToken{token: e.AccessToken, expiresAt: e.ExpiresAt}); ok && ct.validAt(time.Now(), s.skew) {
+		s.log.Debug(fmt.Sprintf("found bearer token in token cache for target: %s", target))
+		return ct, nil
+	}
+
+	s.log.Debug(fmt.Sprintf("fetching bearer token for target: %s", target))
+	ct, err := s.requestToken(ctx, s.cfg.Auth.OAuth2.ClientID, s.cfg.Auth.OAuth2.ClientSecret, scope)
+	if err != nil {
+		return cachedToken{}, err
+	}
+	// a token without known expiry could outlive its validity on disk, keep it in memory only
+	if ct.expiresAt.IsZero() {
+		return ct, nil
+	}
+	if err := s.disk.Store(key, tokencache.Entry{AccessToken: ct.token, ExpiresAt: ct.expiresAt}); err != nil {
+		s.log.Warn(fmt.Sprintf("writing token cache: %v", err))
+	}
+	return ct, nil
 }
 
-func (s *Service) requestToken(ctx context.Context, clientID, clientSecret, scope string) (string, error) {
-	body := formBody(clientID, clientSecret, scope)
+func (s *Service) requestToken(ctx context.Context, clientID, clientSecret, scope string) (cachedToken, error) {
+	body, err := formBody(clientID, clientSecret, s.assertion, scope)
+	if err != nil {
+		return cachedToken{}, err
+	}
+	requestedAt := time.Now()
 	resp, err := s.c.RequestTokenWithBodyWithResponse(ctx, formContentType, body) // uses plain tokenHTTP
 	if err != nil {
-		return "", err
+		return cachedToken{}, err
 	}
 	if resp == nil {
-		return "", errors.New("nil token response")
+		return cachedToken{}, errors.New("nil token response")
 	}
 	if resp.StatusCode() < http.StatusOK || resp.StatusCode() >= http.StatusMultipleChoices {
-		return "", fmt.Errorf("token request failed: status=%d body=%s", resp.StatusCode(), string(resp.Body))
+		return cachedToken{}, fmt.Errorf("token request failed: status=%d body=%s", resp.StatusCode(), string(resp.Body))
 	}
 	if resp.JSON200 == nil || resp.JSON200.AccessToken == "" {
-		return "", fmt.Errorf("missing access token in successful response (status=%d)", resp.StatusCode())
+		return cachedToken{}, fmt.Errorf("missing access token in successful response (status=%d)", resp.StatusCode())
 	}
-	return resp.JSON200.AccessToken, nil
+	ct := cachedToken{token: resp.JSON200.AccessToken}
+	// expires_in counts from when the server issued the token, so measure from before the request
+	if resp.JSON200.ExpiresIn > 0 {
+		ct.expiresAt = requestedAt.Add(time.Duration(resp.JSON200.ExpiresIn) * time.Second)
+	}
+	return ct, nil
 }
 
-func formBody(clientID, clientSecret, scope string) io.Reader {
+// formBody authenticates the client with a signed assertion if configured, with the client secret if set,
+// otherwise only by the client certificate of the TLS connection.
+func formBody(clientID, clientSecret string, assertion *assertionSigner, scope string) (io.Reader, error) {
 	f := url.Values{}
 	f.Set("grant_type", "client_credentials")
 	f.Set("client_id", clientID)
-	f.Set("client_secret", clientSecret)
+	switch {
+	case assertion != nil:
+		jwt, err := assertion.sign(time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("sign client assertion: %w", err)
+		}
+		f.Set("client_assertion_type", clientAssertionType)
+		f.Set("client_assertion", jwt)
+	case clientSecret != "":
+		f.Set("client_secret", clientSecret)
+	}
 	if strings.TrimSpace(scope) != "" {
 		f.Set("scope", scope)
 	}
-	return strings.NewReader(f.Encode())
+	return strings.NewReader(f.Encode()), nil
+}
+
+// tokenEndpoint is the URL the generated client posts to, the default audience of client assertions.
+func tokenEndpoint(base *url.URL) string {
+	return strings.TrimRight(base.String(), "/") + "/token"
 }
@@ -0,0 +1,257 @@
+package oauth2_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/internal/services/auth/oauth2"
+	"github.com/grafvonb/kamunder/internal/services/auth/tokencache"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func oauth2Config(tokenURL string) *config.Config {
+	return &config.Config{
+		Auth: config.Auth{
+			OAuth2: config.AuthOAuth2ClientCredentials{
+				TokenURL:     tokenURL,
+				ClientID:     "test",
+				ClientSecret: "test",
+			},
+		},
+	}
+}
+
+func TestOAuth2_TokenCachedUntilExpiry(t *testing.T) {
+	srv := testx.StartAuthServerOAuth2(t, testx.OAuth2AuthOpts{ExpiresIn: 3600})
+	defer srv.Close()
+
+	svc, err := oauth2.New(oauth2Config(srv.TokenURL), srv.TS.Client(), slog.Default())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	tok1, err := svc.RetrieveTokenForAPI(ctx, "")
+	require.NoError(t, err)
+	tok2, err := svc.RetrieveTokenForAPI(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, tok1, tok2)
+	require.Equal(t, 1, srv.Issued())
+}
+
+func TestOAuth2_TokenRefreshedWithinSkew(t *testing.T) {
+	srv := testx.StartAuthServerOAuth2(t, testx.OAuth2AuthOpts{ExpiresIn: 10})
+	defer srv.Close()
+
+	svc, err := oauth2.New(oauth2Config(srv.TokenURL), srv.TS.Client(), slog.Default(), oauth2.WithExpirySkew(30*time.Second))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	tok1, err := svc.RetrieveTokenForAPI(ctx, "")
+	require.NoError(t, err)
+	tok2, err := svc.RetrieveTokenForAPI(ctx, "")
+	require.NoError(t, err)
+	require.NotEqual(t, tok1, tok2, "token expiring within the skew must be refreshed")
+	require.Equal(t, 2, srv.Issued())
+}
+
+func TestOAuth2_RetryOnceOn401(t *testing.T) {
+	srv := testx.StartAuthServerOAuth2(t, testx.OAuth2AuthOpts{ExpiresIn: 3600})
+	defer srv.Close()
+
+	cfg := oauth2Config(srv.TokenURL)
+	cfg.HTTP.Timeout = "5s"
+	httpSvc, err := httpc.New(cfg, slog.Default())
+	require.NoError(t, err)
+	svc, err := oauth2.New(cfg, httpSvc.Client(), slog.Default())
+	require.NoError(t, err)
+	httpSvc.InstallAuthenticator(svc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	post := func() (int, string) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.APIURL, strings.NewReader(`{"ping":true}`))
+		require.NoError(t, err)
+		resp, err := httpSvc.Client().Do(req)
+		require.NoError(t, err)
+		defer func() { _ = resp.Body.Close() }()
+		b, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(b)
+	}
+
+	status, _ := post()
+	require.Equal(t, http.StatusOK, status)
+
+	srv.Revoke()
+	status, body := post()
+	require.Equal(t, http.StatusOK, status, "rejected token must be replaced and the request retried")
+	require.Equal(t, `{"ping":true}`, body, "request body must be replayed on retry")
+	require.Equal(t, 2, srv.Issued())
+}
+
+func TestOAuth2_TokenCacheWithoutClientSecret(t *testing.T) {
+	srv := testx.StartAuthServerOAuth2(t, testx.OAuth2AuthOpts{ExpiresIn: 3600})
+	defer srv.Close()
+	cacheHome := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", cacheHome)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	retrieve := func() string {
+		cfg := oauth2Config(srv.TokenURL)
+		cfg.Auth.OAuth2.ClientSecret = ""
+		cfg.Auth.OAuth2.TokenCache = true
+		svc, err := oauth2.New(cfg, srv.TS.Client(), slog.Default())
+		require.NoError(t, err)
+		tok, err := svc.RetrieveTokenForAPI(ctx, "")
+		require.NoError(t, err)
+		return tok
+	}
+
+	require.Equal(t, retrieve(), retrieve(), "second invocation must reuse the cached token")
+	require.Equal(t, 1, srv.Issued())
+	require.FileExists(t, filepath.Join(cacheHome, "kamunder", "secret.key"), "tokens must be encrypted with the local secret, not an empty one")
+}
+
+func TestOAuth2_TokenCacheSharedAcrossInstances(t *testing.T) {
+	srv := testx.StartAuthServerOAuth2(t, testx.OAuth2AuthOpts{ExpiresIn: 3600})
+	defer srv.Close()
+
+	dir := t.TempDir()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	retrieve := func() string {
+		cfg := oauth2Config(srv.TokenURL)
+		svc, err := oauth2.New(cfg, srv.TS.Client(), slog.Default(), oauth2.WithTokenCache(tokencache.New(dir, cfg.Auth.OAuth2.ClientSecret)))
+		require.NoError(t, err)
+		tok, err := svc.RetrieveTokenForAPI(ctx, "")
+		require.NoError(t, err)
+		return tok
+	}
+
+	require.Equal(t, retrieve(), retrieve(), "second invocation must reuse the cached token")
+	require.Equal(t, 1, srv.Issued())
+
+	cfg := oauth2Config(srv.TokenURL)
+	svc, err := oauth2.New(cfg, srv.TS.Client(), slog.Default(), oauth2.WithTokenCache(tokencache.New(dir, cfg.Auth.OAuth2.ClientSecret)))
+	require.NoError(t, err)
+	n, err := svc.ClearTokenCache()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	retrieve()
+	require.Equal(t, 2, srv.Issued())
+}
+
+func writePEM(t *testing.T, name, typ string, der []byte) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(p, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600))
+	return p
+}
+
+func TestOAuth2_PrivateKeyJWT(t *testing.T) {
+	srv := testx.StartAuthServerOAuth2(t, testx.OAuth2AuthOpts{ExpiresIn: 3600})
+	defer srv.Close()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	cfg := oauth2Config(srv.TokenURL)
+	cfg.Auth.OAuth2.ClientSecret = ""
+	cfg.Auth.OAuth2.ClientAssertion = config.AuthClientAssertion{PrivateKeyFile: writePEM(t, "key.pem", "PRIVATE KEY", der), KeyID: "k1"}
+	require.NoError(t, cfg.Auth.OAuth2.Validate())
+
+	svc, err := oauth2.New(cfg, srv.TS.Client(), slog.Default())
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = svc.RetrieveTokenForAPI(ctx, "")
+	require.NoError(t, err)
+
+	form := srv.LastTokenRequest()
+	require.Empty(t, form.Get("client_secret"))
+	require.Equal(t, "urn:ietf:params:oauth:client-assertion-type:jwt-bearer", form.Get("client_assertion_type"))
+	parts := strings.Split(form.Get("client_assertion"), ".")
+	require.Len(t, parts, 3)
+
+	var header map[string]string
+	var claims map[string]any
+	decode := func(s string, v any) {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, v))
+	}
+	decode(parts[0], &header)
+	decode(parts[1], &claims)
+	require.Equal(t, "ES256", header["alg"])
+	require.Equal(t, "k1", header["kid"])
+	require.Equal(t, "test", claims["iss"])
+	require.Equal(t, "test", claims["sub"])
+	require.Equal(t, srv.TokenURL+"/token", claims["aud"])
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	require.Len(t, sig, 64)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	require.True(t, ecdsa.Verify(&key.PublicKey, digest[:], r, s), "assertion must be signed with the configured key")
+}
+
+func TestOAuth2_MTLSClientCertificate(t *testing.T) {
+	srv := testx.StartAuthServerOAuth2(t, testx.OAuth2AuthOpts{ExpiresIn: 3600, RequireClientCert: true})
+	defer srv.Close()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "kamunder-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	cfg := oauth2Config(srv.TokenURL)
+	cfg.Auth.OAuth2.ClientSecret = ""
+	cfg.Auth.OAuth2.MTLS = config.AuthMTLS{
+		CertFile: writePEM(t, "client.pem", "CERTIFICATE", certDER),
+		KeyFile:  writePEM(t, "client-key.pem", "EC PRIVATE KEY", keyDER),
+		CAFile:   writePEM(t, "ca.pem", "CERTIFICATE", srv.TS.Certificate().Raw),
+	}
+	require.NoError(t, cfg.Auth.OAuth2.Validate())
+
+	svc, err := oauth2.New(cfg, &http.Client{Timeout: 5 * time.Second}, slog.Default())
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = svc.RetrieveTokenForAPI(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"kamunder-test"}, srv.ClientCertCNs())
+	require.Empty(t, srv.LastTokenRequest().Get("client_secret"))
+}
@@ -0,0 +1,135 @@
+package oidcuser
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"time"
+)
+
+const callbackPath = "/callback"
+
+// loginBrowser runs the authorization code flow with PKCE (RFC 7636). The identity provider redirects
+// the browser back to a listener on the loopback interface (RFC 8252), which receives the code.
+func (s *Service) loginBrowser(ctx context.Context, out io.Writer) (session, error) {
+	ln, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", s.cfg.Auth.OIDC.RedirectPort))
+	if err != nil {
+		return session{}, fmt.Errorf("listen for login redirect: %w", err)
+	}
+	redirectURI := fmt.Sprintf("http://127.0.0.1:%d%s", ln.Addr().(*net.TCPAddr).Port, callbackPath)
+
+	verifier := randomString(32)
+	state := randomString(16)
+	authURL, err := url.Parse(s.cfg.Auth.OIDC.AuthorizationURL)
+	if err != nil {
+		_ = ln.Close()
+		return session{}, fmt.Errorf("parse authorization url: %w", err)
+	}
+	q := authURL.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", s.cfg.Auth.OIDC.ClientID)
+	q.Set("redirect_uri", redirectURI)
+	q.Set("state", state)
+	q.Set("code_challenge", pkceChallenge(verifier))
+	q.Set("code_challenge_method", "S256")
+	if s.cfg.Auth.OIDC.Scope != "" {
+		q.Set("scope", s.cfg.Auth.OIDC.Scope)
+	}
+	authURL.RawQuery = q.Encode()
+
+	type result struct {
+		code string
+		err  error
+	}
+	done := make(chan result, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, func(w http.ResponseWriter, r *http.Request) {
+		rq := r.URL.Query()
+		var res result
+		switch {
+		case rq.Get("state") != state:
+			res.err = errors.New("login redirect with unexpected state")
+		case rq.Get("error") != "":
+			res.err = fmt.Errorf("login failed: %s %s", rq.Get("error"), rq.Get("error_description"))
+		case rq.Get("code") == "":
+			res.err = errors.New("login redirect without code")
+		default:
+			res.code = rq.Get("code")
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if res.err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprintf(w, "<html><body><p>%s</p></body></html>", html.EscapeString(res.err.Error()))
+		} else {
+			_, _ = io.WriteString(w, "<html><body><p>Logged in to kamunder, you can close this window.</p></body></html>")
+		}
+		select {
+		case done <- res:
+		default:
+		}
+	})
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = srv.Serve(ln) }()
+	defer func() { _ = srv.Close() }()
+
+	_, _ = fmt.Fprintf(out, "Opening the browser to log in. If it does not open, visit:\n\n  %s\n\n", authURL.String())
+	if err := s.openBrowser(authURL.String()); err != nil {
+		s.log.Debug(fmt.Sprintf("opening browser: %v", err))
+	}
+
+	var res result
+	select {
+	case <-ctx.Done():
+		return session{}, fmt.Errorf("waiting for login in the browser: %w", ctx.Err())
+	case res = <-done:
+	}
+	if res.err != nil {
+		return session{}, res.err
+	}
+
+	f := url.Values{}
+	f.Set("grant_type", "authorization_code")
+	f.Set("code", res.code)
+	f.Set("redirect_uri", redirectURI)
+	f.Set("client_id", s.cfg.Auth.OIDC.ClientID)
+	f.Set("code_verifier", verifier)
+	sess, err := s.requestToken(ctx, f)
+	if err != nil {
+		return session{}, fmt.Errorf("exchange authorization code: %w", err)
+	}
+	return sess, nil
+}
+
+func pkceChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomString(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func openBrowser(u string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", u)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", u)
+	default:
+		cmd = exec.Command("xdg-open", u)
+	}
+	return cmd.Start()
+}
@@ -0,0 +1,113 @@
+package oidcuser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	deviceCodeGrantType   = "urn:ietf:params:oauth:grant-type:device_code"
+	defaultDevicePollWait = 5 * time.Second
+)
+
+// deviceAuthorization is the response of the device authorization endpoint (RFC 8628 section 3.2).
+type deviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// loginDevice runs the device authorization grant: the person opens a URL on any device with a browser
+// and enters a code, while kamunder polls the token endpoint until they are done.
+func (s *Service) loginDevice(ctx context.Context, out io.Writer) (session, error) {
+	da, err := s.authorizeDevice(ctx)
+	if err != nil {
+		return session{}, err
+	}
+	_, _ = fmt.Fprintf(out, "To log in, open %s on any device and enter the code:\n\n  %s\n\n", da.VerificationURI, da.UserCode)
+	if da.VerificationURIComplete != "" {
+		_, _ = fmt.Fprintf(out, "or open %s\n\n", da.VerificationURIComplete)
+	}
+
+	if da.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(da.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+	wait := defaultDevicePollWait
+	if da.Interval > 0 {
+		wait = time.Duration(da.Interval) * time.Second
+	}
+	f := url.Values{}
+	f.Set("grant_type", deviceCodeGrantType)
+	f.Set("device_code", da.DeviceCode)
+	f.Set("client_id", s.cfg.Auth.OIDC.ClientID)
+	for {
+		select {
+		case <-ctx.Done():
+			return session{}, fmt.Errorf("waiting for login on the other device: %w", ctx.Err())
+		case <-time.After(wait):
+		}
+		sess, err := s.requestToken(ctx, f)
+		var te *tokenError
+		if !errors.As(err, &te) {
+			return sess, err
+		}
+		switch te.Code {
+		case "authorization_pending":
+			s.log.Debug("device login pending")
+		case "slow_down":
+			wait += 5 * time.Second
+		case "access_denied":
+			return session{}, errors.New("login denied on the other device")
+		case "expired_token":
+			return session{}, errors.New("device code expired, run the login again")
+		default:
+			return session{}, err
+		}
+	}
+}
+
+func (s *Service) authorizeDevice(ctx context.Context) (deviceAuthorization, error) {
+	f := url.Values{}
+	f.Set("client_id", s.cfg.Auth.OIDC.ClientID)
+	if s.cfg.Auth.OIDC.Scope != "" {
+		f.Set("scope", s.cfg.Auth.OIDC.Scope)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.Auth.OIDC.DeviceAuthorizationURL, strings.NewReader(f.Encode()))
+	if err != nil {
+		return deviceAuthorization{}, fmt.Errorf("device authorization request: %w", err)
+	}
+	req.Header.Set("Content-Type", formContentType)
+	req.Header.Set("Accept", "application/json")
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return deviceAuthorization{}, fmt.Errorf("device authorization request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return deviceAuthorization{}, fmt.Errorf("device authorization response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return deviceAuthorization{}, fmt.Errorf("device authorization failed: status=%d body=%s", resp.StatusCode, string(body))
+	}
+	var da deviceAuthorization
+	if err := json.Unmarshal(body, &da); err != nil {
+		return deviceAuthorization{}, fmt.Errorf("device authorization response: %w", err)
+	}
+	if da.DeviceCode == "" || da.UserCode == "" || da.VerificationURI == "" {
+		return deviceAuthorization{}, fmt.Errorf("incomplete device authorization response: %s", string(body))
+	}
+	return da, nil
+}
@@ -0,0 +1,389 @@
+package oidcuser
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/internal/clients/auth/oauth2"
+	"github.com/grafvonb/kamunder/internal/services/auth/authenticator"
+	"github.com/grafvonb/kamunder/internal/services/auth/tokencache"
+	"github.com/grafvonb/kamunder/internal/services/common"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+)
+
+const (
+	// defaultExpirySkew is how long before its expiry an access token is refreshed.
+	defaultExpirySkew = 30 * time.Second
+	// loginTimeout bounds how long Login waits for the person to finish in the browser or on the other device.
+	loginTimeout = 5 * time.Minute
+	// cacheScopePrefix keeps the session apart from client credentials tokens of the same client in the token cache.
+	cacheScopePrefix = "oidc-user:"
+)
+
+var ErrNotLoggedIn = errors.New("not logged in, run 'kamunder auth login'")
+
+// session is the token set of the logged-in person; a zero expiresAt means the server did not tell.
+type session struct {
+	access    string
+	expiresAt time.Time
+	refresh   string
+}
+
+func (t session) validAt(now time.Time, skew time.Duration) bool {
+	return t.access != "" && (t.expiresAt.IsZero() || now.Add(skew).Before(t.expiresAt))
+}
+
+type Service struct {
+	c    GenAuthClient
+	http *http.Client
+	cfg  *config.Config
+	log  *slog.Logger
+
+	tokenURL    *url.URL
+	skew        time.Duration
+	disk        *tokencache.Cache
+	openBrowser func(string) error
+
+	mu   sync.Mutex
+	sess session
+}
+
+type Option func(*Service)
+
+func WithClient(c GenAuthClient) Option {
+	return func(s *Service) { s.c = c }
+}
+
+// WithTokenCache keeps the session in the given cache instead of the one in the user cache dir.
+func WithTokenCache(c *tokencache.Cache) Option {
+	return func(s *Service) { s.disk = c }
+}
+
+// WithBrowser replaces the function opening the login page in the browser.
+func WithBrowser(open func(string) error) Option {
+	return func(s *Service) { s.openBrowser = open }
+}
+
+func WithExpirySkew(d time.Duration) Option {
+	return func(s *Service) { s.skew = d }
+}
+
+func New(cfg *config.Config, apiHTTP *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	if cfg == nil {
+		return nil, errors.New("cfg must not be nil")
+	}
+	if log == nil {
+		return nil, errors.New("logger must not be nil")
+	}
+	if apiHTTP == nil {
+		apiHTTP = http.DefaultClient
+	}
+
+	tu, err := url.Parse(cfg.Auth.OIDC.TokenURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse token url: %w", err)
+	}
+	// plain client for the identity provider (no auth transport)
+	idpHTTP := &http.Client{Timeout: apiHTTP.Timeout, Transport: &httpc.LogTransport{Log: log}}
+
+	cfg.APIs.Operate.BaseURL = common.DefaultVal(cfg.APIs.Operate.BaseURL, cfg.APIs.Camunda.BaseURL)
+	cfg.APIs.Tasklist.BaseURL = common.DefaultVal(cfg.APIs.Tasklist.BaseURL, cfg.APIs.Camunda.BaseURL)
+
+	c, err := oauth2.NewClientWithResponses(tu.String(), oauth2.WithHTTPClient(idpHTTP))
+	if err != nil {
+		return nil, fmt.Errorf("init auth client: %w", err)
+	}
+
+	s := &Service{
+		c:           c,
+		http:        idpHTTP,
+		cfg:         cfg,
+		log:         log,
+		tokenURL:    tu,
+		skew:        defaultExpirySkew,
+		openBrowser: openBrowser,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.disk == nil {
+		dir, err := tokencache.DefaultDir()
+		if err != nil {
+			return nil, fmt.Errorf("token cache: %w", err)
+		}
+		secret, err := tokencache.LocalSecret(dir)
+		if err != nil {
+			return nil, fmt.Errorf("token cache: %w", err)
+		}
+		s.disk = tokencache.New(dir, secret)
+	}
+	return s, nil
+}
+
+func (s *Service) Name() string { return "oidc-user" }
+
+// Init loads a stored session. Not being logged in is no error here, so that `auth login` can run;
+// requests fail with ErrNotLoggedIn instead.
+func (s *Service) Init(_ context.Context) error {
+	e, ok, err := s.disk.Load(s.diskKey())
+	if err != nil {
+		s.log.Warn(fmt.Sprintf("reading stored session: %v", err))
+		return nil
+	}
+	if ok {
+		s.mu.Lock()
+		s.sess = session{access: e.AccessToken, expiresAt: e.ExpiresAt, refresh: e.RefreshToken}
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+func (s *Service) Editor() authenticator.RequestEditor {
+	return func(ctx context.Context, req *http.Request) error {
+		// Do NOT add auth to requests to the identity provider.
+		if sameURL(req.URL, s.tokenURL) {
+			return nil
+		}
+		tok, err := s.Token(ctx, "")
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+tok)
+		return nil
+	}
+}
+
+func sameURL(a, b *url.URL) bool {
+	return strings.EqualFold(a.Scheme, b.Scheme) &&
+		strings.EqualFold(a.Host, b.Host) &&
+		path.Clean(a.Path) == path.Clean(b.Path)
+}
+
+// ClearCache drops the session from memory, the stored one is loaded again when needed.
+func (s *Service) ClearCache() {
+	s.mu.Lock()
+	s.sess = session{}
+	s.mu.Unlock()
+}
+
+// Invalidate drops the access token after the server rejected it, the next request refreshes it.
+func (s *Service) Invalidate() {
+	s.log.Debug("invalidating access token of the user session")
+	s.mu.Lock()
+	s.sess.access, s.sess.expiresAt = "", time.Time{}
+	s.mu.Unlock()
+}
+
+// ClearTokenCache removes the stored session.
+func (s *Service) ClearTokenCache() (int, error) {
+	s.ClearCache()
+	return s.disk.ClearClient(s.diskKey())
+}
+
+func (s *Service) Logout() error {
+	_, err := s.ClearTokenCache()
+	return err
+}
+
+// Token returns a valid access token for the logged-in person, refreshing it if needed.
+// The target is ignored, a user session has a single scope.
+func (s *Service) Token(ctx context.Context, _ string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sess.validAt(time.Now(), s.skew) {
+		return s.sess.access, nil
+	}
+
+	// Refresh tokens may be rotated on use, so parallel invocations must not refresh the same one.
+	key := s.diskKey()
+	unlock, err := s.disk.Lock(key)
+	if err != nil {
+		s.log.Warn(fmt.Sprintf("token cache not locked, refreshing anyway: %v", err))
+		unlock = func() {}
+	}
+	defer unlock()
+	if e, ok, err := s.disk.Load(key); err == nil && ok {
+		stored := session{access: e.AccessToken, expiresAt: e.ExpiresAt, refresh: e.RefreshToken}
+		if stored.validAt(time.Now(), s.skew) {
+			s.log.Debug("using access token refreshed by another invocation")
+			s.sess = stored
+			return s.sess.access, nil
+		}
+		if stored.refresh != "" {
+			s.sess.refresh = stored.refresh
+		}
+	}
+	if s.sess.refresh == "" {
+		return "", ErrNotLoggedIn
+	}
+
+	s.log.Debug("refreshing access token of the user session")
+	f := url.Values{}
+	f.Set("grant_type", "refresh_token")
+	f.Set("refresh_token", s.sess.refresh)
+	f.Set("client_id", s.cfg.Auth.OIDC.ClientID)
+	next, err := s.requestToken(ctx, f)
+	var te *tokenError
+	if errors.As(err, &te) && te.Code == "invalid_grant" {
+		s.sess = session{}
+		if _, cerr := s.disk.ClearClient(key); cerr != nil {
+			s.log.Warn(fmt.Sprintf("clearing stored session: %v", cerr))
+		}
+		return "", fmt.Errorf("session expired: %w", ErrNotLoggedIn)
+	}
+	if err != nil {
+		return "", fmt.Errorf("refresh access token: %w", err)
+	}
+	if next.refresh == "" {
+		next.refresh = s.sess.refresh
+	}
+	s.sess = next
+	s.store(key, next)
+	return next.access, nil
+}
+
+// Login runs the interactive login and stores the resulting session.
+func (s *Service) Login(ctx context.Context, flow config.OIDCFlow, out io.Writer) error {
+	if flow == "" {
+		flow = common.DefaultVal(s.cfg.Auth.OIDC.Flow, config.FlowBrowser)
+	}
+	ctx, cancel := context.WithTimeout(ctx, loginTimeout)
+	defer cancel()
+
+	var sess session
+	var err error
+	switch flow {
+	case config.FlowBrowser:
+		sess, err = s.loginBrowser(ctx, out)
+	case config.FlowDevice:
+		sess, err = s.loginDevice(ctx, out)
+	default:
+		return fmt.Errorf("unknown login flow: %s", flow)
+	}
+	if err != nil {
+		return err
+	}
+	if sess.refresh == "" {
+		s.log.Warn("identity provider returned no refresh token (add offline_access to the scope?), the session ends with the access token")
+	}
+	s.mu.Lock()
+	s.sess = sess
+	s.mu.Unlock()
+	s.store(s.diskKey(), sess)
+	return nil
+}
+
+// Session reports the stored login state without refreshing it.
+func (s *Service) Session(_ context.Context) (authenticator.Session, error) {
+	s.mu.Lock()
+	sess := s.sess
+	s.mu.Unlock()
+	if sess.access == "" && sess.refresh == "" {
+		e, ok, err := s.disk.Load(s.diskKey())
+		if err != nil {
+			return authenticator.Session{}, err
+		}
+		if ok {
+			sess = session{access: e.AccessToken, expiresAt: e.ExpiresAt, refresh: e.RefreshToken}
+		}
+	}
+	return authenticator.Session{
+		LoggedIn:    sess.refresh != "" || sess.validAt(time.Now(), 0),
+		Subject:     subjectOf(sess.access),
+		ExpiresAt:   sess.expiresAt,
+		Refreshable: sess.refresh != "",
+	}, nil
+}
+
+func (s *Service) diskKey() tokencache.Key {
+	return tokencache.Key{TokenURL: s.tokenURL.String(), ClientID: s.cfg.Auth.OIDC.ClientID, Scope: cacheScopePrefix + s.cfg.Auth.OIDC.Scope}
+}
+
+func (s *Service) store(key tokencache.Key, sess session) {
+	e := tokencache.Entry{AccessToken: sess.access, ExpiresAt: sess.expiresAt, RefreshToken: sess.refresh}
+	if err := s.disk.Store(key, e); err != nil {
+		s.log.Warn(fmt.Sprintf("storing session: %v", err))
+	}
+}
+
+// tokenError is an OAuth2 error response of the token endpoint (RFC 6749 section 5.2).
+type tokenError struct {
+	Status      int
+	Code        string
+	Description string
+}
+
+func (e *tokenError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("token request failed: status=%d error=%s (%s)", e.Status, e.Code, e.Description)
+	}
+	return fmt.Sprintf("token request failed: status=%d error=%s", e.Status, e.Code)
+}
+
+func (s *Service) requestToken(ctx context.Context, form url.Values) (session, error) {
+	requestedAt := time.Now()
+	resp, err := s.c.RequestTokenWithBodyWithResponse(ctx, formContentType, strings.NewReader(form.Encode()))
+	if err != nil {
+		return session{}, err
+	}
+	if resp == nil {
+		return session{}, errors.New("nil token response")
+	}
+	if resp.StatusCode() < http.StatusOK || resp.StatusCode() >= http.StatusMultipleChoices {
+		for _, oe := range []*oauth2.OAuth2Error{resp.JSON400, resp.JSON401, resp.JSON403} {
+			if oe != nil {
+				te := &tokenError{Status: resp.StatusCode(), Code: string(oe.Error)}
+				if oe.ErrorDescription != nil {
+					te.Description = *oe.ErrorDescription
+				}
+				return session{}, te
+			}
+		}
+		return session{}, fmt.Errorf("token request failed: status=%d body=%s", resp.StatusCode(), string(resp.Body))
+	}
+	if resp.JSON200 == nil || resp.JSON200.AccessToken == "" {
+		return session{}, fmt.Errorf("missing access token in successful response (status=%d)", resp.StatusCode())
+	}
+	sess := session{access: resp.JSON200.AccessToken}
+	if resp.JSON200.RefreshToken != nil {
+		sess.refresh = *resp.JSON200.RefreshToken
+	}
+	if resp.JSON200.ExpiresIn > 0 {
+		sess.expiresAt = requestedAt.Add(time.Duration(resp.JSON200.ExpiresIn) * time.Second)
+	}
+	return sess, nil
+}
+
+// subjectOf returns who a JWT access token was issued to, or "" for opaque tokens.
+// The token is not verified, the result is for display only.
+func subjectOf(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	b, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		PreferredUsername string `json:"preferred_username"`
+		Email             string `json:"email"`
+		Sub               string `json:"sub"`
+	}
+	if json.Unmarshal(b, &claims) != nil {
+		return ""
+	}
+	return common.DefaultVal(claims.PreferredUsername, common.DefaultVal(claims.Email, claims.Sub))
+}
@@ -0,0 +1,111 @@
+package oidcuser_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/internal/services/auth/oidcuser"
+	"github.com/grafvonb/kamunder/internal/services/auth/tokencache"
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func oidcConfig(srv *testx.AuthServerOIDC) *config.Config {
+	return &config.Config{
+		Auth: config.Auth{
+			Mode: config.ModeOIDCUser,
+			OIDC: config.AuthOIDCUser{
+				TokenURL:               srv.TokenURL,
+				AuthorizationURL:       srv.AuthorizationURL,
+				DeviceAuthorizationURL: srv.DeviceAuthorizationURL,
+				ClientID:               "kamunder-cli",
+				Scope:                  "openid offline_access",
+			},
+		},
+	}
+}
+
+// browser follows the login URL like a browser of a person who is already logged in to SSO.
+func browser(u string) error {
+	resp, err := http.Get(u)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.Body.Close()
+}
+
+func newService(t *testing.T, srv *testx.AuthServerOIDC, dir string, opts ...oidcuser.Option) *oidcuser.Service {
+	t.Helper()
+	opts = append([]oidcuser.Option{oidcuser.WithTokenCache(tokencache.New(dir, "local")), oidcuser.WithBrowser(browser)}, opts...)
+	svc, err := oidcuser.New(oidcConfig(srv), srv.TS.Client(), slog.Default(), opts...)
+	require.NoError(t, err)
+	require.NoError(t, svc.Init(context.Background()))
+	return svc
+}
+
+func TestOIDCUser_BrowserLoginStoresSession(t *testing.T) {
+	srv := testx.StartAuthServerOIDC(t, testx.OIDCAuthOpts{ExpiresIn: 3600})
+	defer srv.Close()
+	dir := t.TempDir()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	svc := newService(t, srv, dir)
+	_, err := svc.Token(ctx, "")
+	require.ErrorIs(t, err, oidcuser.ErrNotLoggedIn)
+
+	require.NoError(t, svc.Login(ctx, config.FlowBrowser, io.Discard))
+	sess, err := svc.Session(ctx)
+	require.NoError(t, err)
+	require.True(t, sess.LoggedIn)
+	require.True(t, sess.Refreshable)
+	require.Equal(t, "demo", sess.Subject)
+
+	tok, err := newService(t, srv, dir).Token(ctx, "")
+	require.NoError(t, err, "the next invocation must reuse the stored session")
+	require.NotEmpty(t, tok)
+	require.Equal(t, 1, srv.Issued())
+}
+
+func TestOIDCUser_DeviceLoginAndRefresh(t *testing.T) {
+	srv := testx.StartAuthServerOIDC(t, testx.OIDCAuthOpts{ExpiresIn: 10, DevicePending: 1})
+	defer srv.Close()
+	dir := t.TempDir()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	require.NoError(t, newService(t, srv, dir).Login(ctx, config.FlowDevice, io.Discard))
+	require.Equal(t, 1, srv.Issued())
+
+	// the access token expires within the skew, so each invocation refreshes it with the rotated refresh token
+	tok1, err := newService(t, srv, dir, oidcuser.WithExpirySkew(30*time.Second)).Token(ctx, "")
+	require.NoError(t, err)
+	tok2, err := newService(t, srv, dir, oidcuser.WithExpirySkew(30*time.Second)).Token(ctx, "")
+	require.NoError(t, err)
+	require.NotEqual(t, tok1, tok2)
+	require.Equal(t, 3, srv.Issued())
+}
+
+func TestOIDCUser_RevokedRefreshEndsSession(t *testing.T) {
+	srv := testx.StartAuthServerOIDC(t, testx.OIDCAuthOpts{ExpiresIn: 10})
+	defer srv.Close()
+	dir := t.TempDir()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, newService(t, srv, dir).Login(ctx, config.FlowBrowser, io.Discard))
+
+	srv.RevokeRefresh()
+	_, err := newService(t, srv, dir, oidcuser.WithExpirySkew(30*time.Second)).Token(ctx, "")
+	require.ErrorIs(t, err, oidcuser.ErrNotLoggedIn)
+
+	sess, err := newService(t, srv, dir).Session(ctx)
+	require.NoError(t, err)
+	require.False(t, sess.LoggedIn, "the stored session must be removed")
+}
@@ -0,0 +1,268 @@
+// Package tokencache keeps OAuth2 access tokens encrypted on disk, so that consecutive
+// invocations of kamunder reuse a token instead of requesting a new one each time.
+package tokencache
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	appDir     = "kamunder"
+	tokenExt   = ".token"
+	lockExt    = ".lock"
+	secretFile = "secret.key"
+	dirPerm    = 0o700
+	filePerm   = 0o600
+	keyContext = "kamunder token cache v1"
+)
+
+// Key identifies a cached token: one token per token URL, client id and scope.
+type Key struct {
+	TokenURL string
+	ClientID string
+	Scope    string
+}
+
+// clientPrefix is shared by all files of one client, so they can be cleared together.
+func (k Key) clientPrefix() string {
+	return hash(k.TokenURL, k.ClientID)[:16]
+}
+
+func (k Key) fileName() string {
+	return k.clientPrefix() + "-" + hash(k.TokenURL, k.ClientID, k.Scope)[:32]
+}
+
+// Entry is a cached access token and, for user logins, the refresh token to renew it;
+// a zero ExpiresAt means the server did not tell.
+type Entry struct {
+	AccessToken  string    `json:"access_token,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+}
+
+// Cache stores entries in a directory, encrypted with a key derived from the client secret.
+type Cache struct {
+	dir    string
+	secret string
+}
+
+// DefaultDir returns $XDG_CACHE_HOME/kamunder or the platform equivalent.
+func DefaultDir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("user cache dir: %w", err)
+	}
+	return filepath.Join(base, appDir), nil
+}
+
+// LocalSecret returns the random secret kept in dir, creating it on first use. It encrypts the
+// tokens of public clients, which have no client secret to derive a key from.
+func LocalSecret(dir string) (string, error) {
+	p := filepath.Join(dir, secretFile)
+	b, err := os.ReadFile(p)
+	if err == nil && len(b) > 0 {
+		return string(b), nil
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("read token cache secret: %w", err)
+	}
+	if err := os.MkdirAll(dir, dirPerm); err != nil {
+		return "", fmt.Errorf("create token cache dir: %w", err)
+	}
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generate token cache secret: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, secretFile+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("write token cache secret: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	_, err = tmp.WriteString(hex.EncodeToString(raw))
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", fmt.Errorf("write token cache secret: %w", err)
+	}
+	// link fails if a parallel invocation created the secret in the meantime, theirs wins
+	if err := os.Link(tmp.Name(), p); err != nil && !errors.Is(err, fs.ErrExist) {
+		return "", fmt.Errorf("write token cache secret: %w", err)
+	}
+	b, err = os.ReadFile(p)
+	if err != nil {
+		return "", fmt.Errorf("read token cache secret: %w", err)
+	}
+	return string(b), nil
+}
+
+func New(dir, secret string) *Cache {
+	return &Cache{dir: dir, secret: secret}
+}
+
+func (c *Cache) Dir() string { return c.dir }
+
+// Load returns the entry for k. A missing entry or one that cannot be decrypted, e.g. because
+// the client secret changed, is reported as not found.
+func (c *Cache) Load(k Key) (Entry, bool, error) {
+	b, err := os.ReadFile(c.path(k, tokenExt))
+	if errors.Is(err, fs.ErrNotExist) {
+		return Entry{}, false, nil
+	}
+	if err != nil {
+		return Entry{}, false, fmt.Errorf("read cached token: %w", err)
+	}
+	plain, err := c.open(k, b)
+	if err != nil {
+		return Entry{}, false, nil
+	}
+	var e Entry
+	if err := json.Unmarshal(plain, &e); err != nil || (e.AccessToken == "" && e.RefreshToken == "") {
+		return Entry{}, false, nil
+	}
+	return e, true, nil
+}
+
+// Store writes the entry for k, replacing an existing one atomically.
+func (c *Cache) Store(k Key, e Entry) error {
+	if err := os.MkdirAll(c.dir, dirPerm); err != nil {
+		return fmt.Errorf("create token cache dir: %w", err)
+	}
+	plain, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshal cached token: %w", err)
+	}
+	sealed, err := c.seal(k, plain)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(c.dir, k.fileName()+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("write cached token: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(sealed); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write cached token: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write cached token: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path(k, tokenExt)); err != nil {
+		return fmt.Errorf("write cached token: %w", err)
+	}
+	return nil
+}
+
+// Lock takes an exclusive lock for k, so parallel invocations do not request the same token.
+// The returned function releases it.
+func (c *Cache) Lock(k Key) (func(), error) {
+	if err := os.MkdirAll(c.dir, dirPerm); err != nil {
+		return nil, fmt.Errorf("create token cache dir: %w", err)
+	}
+	f, err := os.OpenFile(c.path(k, lockExt), os.O_CREATE|os.O_RDWR, filePerm)
+	if err != nil {
+		return nil, fmt.Errorf("open token cache lock: %w", err)
+	}
+	if err := lockFile(f); err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("lock token cache: %w", err)
+	}
+	return func() {
+		_ = unlockFile(f)
+		_ = f.Close()
+	}, nil
+}
+
+// ClearClient removes all cached tokens of the client identified by the token URL and client id of k.
+func (c *Cache) ClearClient(k Key) (int, error) {
+	return c.remove(k.clientPrefix() + "-")
+}
+
+// Clear removes all cached tokens and returns how many were removed.
+func (c *Cache) Clear() (int, error) {
+	return c.remove("")
+}
+
+func (c *Cache) remove(prefix string) (int, error) {
+	entries, err := os.ReadDir(c.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("read token cache dir: %w", err)
+	}
+	var n int
+	var errs []error
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if !strings.HasSuffix(name, tokenExt) && !strings.HasSuffix(name, lockExt) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.dir, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, err)
+			continue
+		}
+		if strings.HasSuffix(name, tokenExt) {
+			n++
+		}
+	}
+	return n, errors.Join(errs...)
+}
+
+func (c *Cache) path(k Key, ext string) string {
+	return filepath.Join(c.dir, k.fileName()+ext)
+}
+
+func (c *Cache) aead(k Key) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(strings.Join([]string{keyContext, k.TokenURL, k.ClientID, c.secret}, "\x00")))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("token cache cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func (c *Cache) seal(k Key, plain []byte) ([]byte, error) {
+	gcm, err := c.aead(k)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("token cache nonce: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, plain, []byte(k.fileName())), nil
+}
+
+func (c *Cache) open(k Key, sealed []byte) ([]byte, error) {
+	gcm, err := c.aead(k)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("cached token too short")
+	}
+	nonce, data := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, data, []byte(k.fileName()))
+}
+
+func hash(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,98 @@
+package tokencache_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grafvonb/kamunder/internal/services/auth/tokencache"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(scope string) tokencache.Key {
+	return tokencache.Key{TokenURL: "http://idp/token", ClientID: "kamunder", Scope: scope}
+}
+
+func TestCache_StoreLoadEncrypted(t *testing.T) {
+	dir := t.TempDir()
+	c := tokencache.New(dir, "secret")
+	exp := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	_, ok, err := c.Load(testKey("profile"))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, c.Store(testKey("profile"), tokencache.Entry{AccessToken: "tok-1", ExpiresAt: exp}))
+	e, ok, err := c.Load(testKey("profile"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "tok-1", e.AccessToken)
+	require.True(t, exp.Equal(e.ExpiresAt))
+
+	_, ok, err = c.Load(testKey("other"))
+	require.NoError(t, err)
+	require.False(t, ok, "tokens are keyed by scope")
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, f := range files {
+		b, err := os.ReadFile(filepath.Join(dir, f.Name()))
+		require.NoError(t, err)
+		require.False(t, strings.Contains(string(b), "tok-1"), "token must not be stored in plain text")
+		info, err := f.Info()
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	}
+
+	_, ok, err = tokencache.New(dir, "changed").Load(testKey("profile"))
+	require.NoError(t, err)
+	require.False(t, ok, "a changed secret must not decrypt the cached token")
+}
+
+func TestCache_ClearClient(t *testing.T) {
+	c := tokencache.New(t.TempDir(), "secret")
+	other := tokencache.Key{TokenURL: "http://idp/token", ClientID: "other"}
+	require.NoError(t, c.Store(testKey("a"), tokencache.Entry{AccessToken: "a"}))
+	require.NoError(t, c.Store(testKey("b"), tokencache.Entry{AccessToken: "b"}))
+	require.NoError(t, c.Store(other, tokencache.Entry{AccessToken: "o"}))
+
+	n, err := c.ClearClient(testKey(""))
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	_, ok, _ := c.Load(other)
+	require.True(t, ok, "tokens of other clients are kept")
+
+	n, err = c.Clear()
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
+
+func TestCache_LockSerializes(t *testing.T) {
+	c := tokencache.New(t.TempDir(), "secret")
+	var mu sync.Mutex
+	var inside, maxInside int
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// a separate Cache per goroutine, like separate invocations
+			unlock, err := tokencache.New(c.Dir(), "secret").Lock(testKey("profile"))
+			require.NoError(t, err)
+			defer unlock()
+			mu.Lock()
+			inside++
+			maxInside = max(maxInside, inside)
+			mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			inside--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, 1, maxInside)
+}
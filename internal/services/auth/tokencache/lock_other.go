@@ -0,0 +1,11 @@
+//go:build !unix
+
+package tokencache
+
+import "os"
+
+// Without flock parallel invocations may both request a token, the last one stored wins.
+
+func lockFile(_ *os.File) error { return nil }
+
+func unlockFile(_ *os.File) error { return nil }
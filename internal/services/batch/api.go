@@ -0,0 +1,24 @@
+package batch
+
+import (
+	"context"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	v87 "github.com/grafvonb/kamunder/internal/services/batch/v87"
+	v88 "github.com/grafvonb/kamunder/internal/services/batch/v88"
+)
+
+type API interface {
+	SearchBatchOperations(ctx context.Context, filter d.BatchOperationSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.BatchOperation, error)
+	GetBatchOperation(ctx context.Context, key string, opts ...services.CallOption) (d.BatchOperation, error)
+	SearchBatchOperationItems(ctx context.Context, filter d.BatchOperationItemSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.BatchOperationItem, error)
+	CancelBatchOperation(ctx context.Context, key string, opts ...services.CallOption) error
+	SuspendBatchOperation(ctx context.Context, key string, opts ...services.CallOption) error
+	ResumeBatchOperation(ctx context.Context, key string, opts ...services.CallOption) error
+	CancelProcessInstancesBatch(ctx context.Context, filter d.ProcessInstanceSearchFilterOpts, opts ...services.CallOption) (d.BatchOperation, error)
+	WaitForBatchOperation(ctx context.Context, key string, opts ...services.CallOption) (d.BatchOperation, error)
+}
+
+var _ API = (*v87.Service)(nil)
+var _ API = (*v88.Service)(nil)
@@ -0,0 +1,58 @@
+package v87
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/grafvonb/kamunder/config"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+)
+
+// Service is a stub, batch operations are only available from camunda 8.8 on.
+type Service struct {
+	cfg *config.Config
+	log *slog.Logger
+}
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger) (*Service, error) {
+	return &Service{cfg: cfg, log: log}, nil
+}
+
+func (s *Service) SearchBatchOperations(ctx context.Context, filter d.BatchOperationSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.BatchOperation, error) {
+	return nil, errNotSupported("searching batch operations")
+}
+
+func (s *Service) GetBatchOperation(ctx context.Context, key string, opts ...services.CallOption) (d.BatchOperation, error) {
+	return d.BatchOperation{}, errNotSupported("getting batch operations")
+}
+
+func (s *Service) SearchBatchOperationItems(ctx context.Context, filter d.BatchOperationItemSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.BatchOperationItem, error) {
+	return nil, errNotSupported("searching batch operation items")
+}
+
+func (s *Service) CancelBatchOperation(ctx context.Context, key string, opts ...services.CallOption) error {
+	return errNotSupported("cancelling batch operations")
+}
+
+func (s *Service) SuspendBatchOperation(ctx context.Context, key string, opts ...services.CallOption) error {
+	return errNotSupported("suspending batch operations")
+}
+
+func (s *Service) ResumeBatchOperation(ctx context.Context, key string, opts ...services.CallOption) error {
+	return errNotSupported("resuming batch operations")
+}
+
+func (s *Service) CancelProcessInstancesBatch(ctx context.Context, filter d.ProcessInstanceSearchFilterOpts, opts ...services.CallOption) (d.BatchOperation, error) {
+	return d.BatchOperation{}, errNotSupported("server-side batch cancellation")
+}
+
+func (s *Service) WaitForBatchOperation(ctx context.Context, key string, opts ...services.CallOption) (d.BatchOperation, error) {
+	return d.BatchOperation{}, errNotSupported("waiting for batch operations")
+}
+
+func errNotSupported(what string) error {
+	return fmt.Errorf("%w: %s requires camunda 8.8", d.ErrNotSupported, what)
+}
@@ -0,0 +1,20 @@
+package v88
+
+import (
+	"context"
+	"io"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+)
+
+type GenBatchClient interface {
+	SearchBatchOperationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchBatchOperationsResponse, error)
+	GetBatchOperationWithResponse(ctx context.Context, batchOperationKey camundav88.BatchOperationKey, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetBatchOperationResponse, error)
+	SearchBatchOperationItemsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchBatchOperationItemsResponse, error)
+	CancelBatchOperationWithBodyWithResponse(ctx context.Context, batchOperationKey camundav88.BatchOperationKey, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CancelBatchOperationResponse, error)
+	SuspendBatchOperationWithBodyWithResponse(ctx context.Context, batchOperationKey camundav88.BatchOperationKey, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SuspendBatchOperationResponse, error)
+	ResumeBatchOperationWithBodyWithResponse(ctx context.Context, batchOperationKey camundav88.BatchOperationKey, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.ResumeBatchOperationResponse, error)
+	CancelProcessInstancesBatchOperationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CancelProcessInstancesBatchOperationResponse, error)
+}
+
+var _ GenBatchClient = (*camundav88.ClientWithResponses)(nil)
@@ -0,0 +1,62 @@
+package v88
+
+import (
+	"time"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromBatchOperationResponse(r camundav88.BatchOperationResponse) d.BatchOperation {
+	var errs []string
+	for _, e := range toolx.DerefSlice(r.Errors) {
+		errs = append(errs, toolx.Deref(e.Message, string(toolx.Deref(e.Type, ""))))
+	}
+	return d.BatchOperation{
+		Key:                      toolx.Deref(r.BatchOperationKey, ""),
+		Type:                     string(toolx.Deref(r.BatchOperationType, "")),
+		State:                    string(toolx.Deref(r.State, "")),
+		StartDate:                formatTime(r.StartDate),
+		EndDate:                  formatTime(r.EndDate),
+		OperationsTotalCount:     toolx.Deref(r.OperationsTotalCount, 0),
+		OperationsCompletedCount: toolx.Deref(r.OperationsCompletedCount, 0),
+		OperationsFailedCount:    toolx.Deref(r.OperationsFailedCount, 0),
+		Errors:                   errs,
+	}
+}
+
+func fromBatchOperationItemResponse(r camundav88.BatchOperationItemResponse) d.BatchOperationItem {
+	return d.BatchOperationItem{
+		BatchOperationKey:  toolx.Deref(r.BatchOperationKey, ""),
+		ItemKey:            toolx.Deref(r.ItemKey, ""),
+		ProcessInstanceKey: toolx.Deref(r.ProcessInstanceKey, ""),
+		OperationType:      string(toolx.Deref(r.OperationType, "")),
+		State:              string(toolx.Deref(r.State, "")),
+		ProcessedDate:      formatTime(r.ProcessedDate),
+		ErrorMessage:       toolx.Deref(r.ErrorMessage, ""),
+	}
+}
+
+// toProcessInstanceFilter builds the 8.8 process instance filter used by the batch operation endpoints.
+func toProcessInstanceFilter(f d.ProcessInstanceSearchFilterOpts, tenant string) map[string]any {
+	m := map[string]any{}
+	httpc.PutIf(m, "processInstanceKey", f.Key)
+	httpc.PutIf(m, "processDefinitionId", f.BpmnProcessId)
+	httpc.PutIf(m, "processDefinitionVersion", f.ProcessVersion)
+	httpc.PutIf(m, "processDefinitionVersionTag", f.ProcessVersionTag)
+	httpc.PutIf(m, "parentProcessInstanceKey", f.ParentKey)
+	if f.State != "" && !f.State.EqualsIgnoreCase(d.StateAll) {
+		m["state"] = f.State.String()
+	}
+	httpc.PutIf(m, "tenantId", tenant)
+	return m
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
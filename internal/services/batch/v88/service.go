@@ -0,0 +1,171 @@
+package v88
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/batch/waiter"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type Service struct {
+	c   GenBatchClient
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+//nolint:unused
+func WithClient(c GenBatchClient) Option { return func(s *Service) { s.c = c } }
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	c, err := camundav88.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav88.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{c: c, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) SearchBatchOperations(ctx context.Context, filter d.BatchOperationSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.BatchOperation, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for batch operations with filter: %+v", filter))
+	f := map[string]any{}
+	httpc.PutIf(f, "batchOperationKey", filter.Key)
+	httpc.PutIf(f, "operationType", filter.Type)
+	httpc.PutIf(f, "state", filter.State)
+	items, err := httpc.Search[camundav88.BatchOperationResponse](ctx, f, size,
+		func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchBatchOperationsWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return toolx.MapSlice(items, fromBatchOperationResponse), nil
+}
+
+func (s *Service) GetBatchOperation(ctx context.Context, key string, opts ...services.CallOption) (d.BatchOperation, error) {
+	_ = services.ApplyCallOptions(opts)
+	resp, err := s.c.GetBatchOperationWithResponse(ctx, key)
+	if err != nil {
+		return d.BatchOperation{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.BatchOperation{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.BatchOperation{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s", d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromBatchOperationResponse(*resp.JSON200), nil
+}
+
+func (s *Service) SearchBatchOperationItems(ctx context.Context, filter d.BatchOperationItemSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.BatchOperationItem, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for batch operation items with filter: %+v", filter))
+	f := map[string]any{}
+	httpc.PutIf(f, "batchOperationKey", filter.BatchOperationKey)
+	httpc.PutIf(f, "processInstanceKey", filter.ProcessInstanceKey)
+	httpc.PutIf(f, "state", filter.State)
+	items, err := httpc.Search[camundav88.BatchOperationItemResponse](ctx, f, size,
+		func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchBatchOperationItemsWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return toolx.MapSlice(items, fromBatchOperationItemResponse), nil
+}
+
+func (s *Service) CancelBatchOperation(ctx context.Context, key string, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("cancelling batch operation %s", key))
+	resp, err := s.c.CancelBatchOperationWithBodyWithResponse(ctx, key, "application/json", bytes.NewReader([]byte("{}")))
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) SuspendBatchOperation(ctx context.Context, key string, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("suspending batch operation %s", key))
+	resp, err := s.c.SuspendBatchOperationWithBodyWithResponse(ctx, key, "application/json", bytes.NewReader([]byte("{}")))
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) ResumeBatchOperation(ctx context.Context, key string, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("resuming batch operation %s", key))
+	resp, err := s.c.ResumeBatchOperationWithBodyWithResponse(ctx, key, "application/json", bytes.NewReader([]byte("{}")))
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+// CancelProcessInstancesBatch submits a server-side batch cancelling all process instances matching the filter.
+// With the Wait call option the batch operation is followed until it finished.
+func (s *Service) CancelProcessInstancesBatch(ctx context.Context, filter d.ProcessInstanceSearchFilterOpts, opts ...services.CallOption) (d.BatchOperation, error) {
+	cCfg := services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("submitting batch cancellation for process instances with filter: %+v", filter))
+	b, err := json.Marshal(map[string]any{"filter": toProcessInstanceFilter(filter, s.cfg.App.Tenant)})
+	if err != nil {
+		return d.BatchOperation{}, fmt.Errorf("marshalling batch cancellation request: %w", err)
+	}
+	resp, err := s.c.CancelProcessInstancesBatchOperationWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return d.BatchOperation{}, err
+	}
+	return s.created(ctx, resp.HTTPResponse, resp.Body, resp.JSON200, cCfg.Wait)
+}
+
+func (s *Service) WaitForBatchOperation(ctx context.Context, key string, opts ...services.CallOption) (d.BatchOperation, error) {
+	return waiter.WaitForBatchOperation(ctx, s, s.cfg, s.log, key, opts...)
+}
+
+func (s *Service) created(ctx context.Context, hr *http.Response, body []byte, r *camundav88.BatchOperationCreatedResult, wait bool) (d.BatchOperation, error) {
+	if err := httpc.HttpStatusErr(hr, body); err != nil {
+		return d.BatchOperation{}, err
+	}
+	if r == nil || r.BatchOperationKey == nil {
+		return d.BatchOperation{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s", d.ErrMalformedResponse, string(body))
+	}
+	bo := d.BatchOperation{
+		Key:   *r.BatchOperationKey,
+		Type:  string(toolx.Deref(r.BatchOperationType, "")),
+		State: "CREATED",
+	}
+	s.log.Info(fmt.Sprintf("batch operation %s (%s) submitted", bo.Key, bo.Type))
+	if !wait {
+		return bo, nil
+	}
+	return s.WaitForBatchOperation(ctx, bo.Key)
+}
@@ -0,0 +1,52 @@
+package v88
+
+import (
+	"testing"
+	"time"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Internal_Batch_v88_CancelProcessInstancesBatch_Wait_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	bo, err := svc.CancelProcessInstancesBatch(ctx, d.ProcessInstanceSearchFilterOpts{BpmnProcessId: "order-process", State: d.StateActive}, services.WithWait())
+	require.NoError(t, err)
+	require.Equal(t, "2251799813690401", bo.Key)
+	require.Equal(t, "PARTIALLY_COMPLETED", bo.State)
+	require.True(t, bo.IsFinished())
+	require.Equal(t, int32(1), bo.OperationsFailedCount)
+
+	testx.LogJson(t, bo)
+}
+
+func Test_Internal_Batch_v88_SearchBatchOperationItems_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	items, err := svc.SearchBatchOperationItems(ctx, d.BatchOperationItemSearchFilterOpts{BatchOperationKey: "2251799813690401", State: "FAILED"}, 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, "2251799813690099", items[0].ProcessInstanceKey)
+	require.Equal(t, "process instance is not active", items[0].ErrorMessage)
+}
@@ -0,0 +1,64 @@
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+)
+
+type BatchOperationGetter interface {
+	GetBatchOperation(ctx context.Context, key string, opts ...services.CallOption) (d.BatchOperation, error)
+}
+
+// WaitForBatchOperation polls the batch operation until it reached a terminal state.
+// - Respects ctx cancellation/deadline; augments with cfg.App.Backoff.Timeout if set
+// - Returns the last known batch operation, also on failure/timeout.
+func WaitForBatchOperation(ctx context.Context, s BatchOperationGetter, cfg *config.Config, log *slog.Logger, key string, opts ...services.CallOption) (d.BatchOperation, error) {
+	_ = services.ApplyCallOptions(opts)
+	backoff := cfg.App.Backoff
+	if backoff.Timeout > 0 {
+		deadline := time.Now().Add(backoff.Timeout)
+		if dl, ok := ctx.Deadline(); !ok || deadline.Before(dl) {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithDeadline(ctx, deadline)
+			defer cancel()
+		}
+	}
+
+	var last d.BatchOperation
+	attempts := 0
+	delay := backoff.InitialDelay
+	for {
+		attempts++
+		bo, err := s.GetBatchOperation(ctx, key)
+		if err == nil {
+			last = bo
+			if bo.IsFinished() {
+				log.Debug(fmt.Sprintf("batch operation %s finished in state %s after %d check(s)", key, bo.State, attempts))
+				return bo, nil
+			}
+			log.Info(fmt.Sprintf("batch operation %s %s: %d of %d item(s) processed (%d failed); waiting...",
+				key, bo.State, bo.OperationsCompletedCount+bo.OperationsFailedCount, bo.OperationsTotalCount, bo.OperationsFailedCount))
+		} else if strings.Contains(err.Error(), "404") {
+			// the batch operation becomes visible with a short delay after it was created
+			log.Debug(fmt.Sprintf("batch operation %s not found yet; waiting...", key))
+		} else {
+			log.Error(fmt.Sprintf("fetching batch operation %s failed: %v (will retry)", key, err))
+		}
+		if backoff.MaxRetries > 0 && attempts >= backoff.MaxRetries {
+			return last, fmt.Errorf("exceeded max_retries (%d) waiting for batch operation %s to finish", backoff.MaxRetries, key)
+		}
+		select {
+		case <-time.After(delay):
+			delay = backoff.NextDelay(delay)
+		case <-ctx.Done():
+			return last, fmt.Errorf("%w: %s", d.ErrGatewayTimeout, ctx.Err().Error())
+		}
+	}
+}
@@ -3,6 +3,7 @@ package services
 func WithNoStateCheck() CallOption { return func(c *CallCfg) { c.NoStateCheck = true } }
 func WithCancel() CallOption       { return func(c *CallCfg) { c.Cancel = true } }
 func WithWait() CallOption         { return func(c *CallCfg) { c.Wait = true } }
+func WithVerify() CallOption       { return func(c *CallCfg) { c.Verify = true } }
 
 type CallOption func(*CallCfg)
 
@@ -10,6 +11,7 @@ type CallCfg struct {
 	NoStateCheck bool
 	Cancel       bool
 	Wait         bool
+	Verify       bool
 }
 
 func ApplyCallOptions(opts []CallOption) *CallCfg {
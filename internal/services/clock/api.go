@@ -0,0 +1,19 @@
+package clock
+
+import (
+	"context"
+	"time"
+
+	"github.com/grafvonb/kamunder/internal/services"
+	v87 "github.com/grafvonb/kamunder/internal/services/clock/v87"
+	v88 "github.com/grafvonb/kamunder/internal/services/clock/v88"
+)
+
+type API interface {
+	PinClock(ctx context.Context, t time.Time, opts ...services.CallOption) error
+	ResetClock(ctx context.Context, opts ...services.CallOption) error
+	GetGatewayTime(ctx context.Context, opts ...services.CallOption) (time.Time, error)
+}
+
+var _ API = (*v87.Service)(nil)
+var _ API = (*v88.Service)(nil)
@@ -0,0 +1,16 @@
+package v87
+
+import (
+	"context"
+	"io"
+
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+)
+
+type GenClockClient interface {
+	PutClockWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PutClockResponse, error)
+	PostClockResetWithResponse(ctx context.Context, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PostClockResetResponse, error)
+	GetTopologyWithResponse(ctx context.Context, reqEditors ...camundav87.RequestEditorFn) (*camundav87.GetTopologyResponse, error)
+}
+
+var _ GenClockClient = (*camundav87.ClientWithResponses)(nil)
@@ -0,0 +1,86 @@
+package v87
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+)
+
+type Service struct {
+	c   GenClockClient
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+//nolint:unused
+func WithClient(c GenClockClient) Option { return func(s *Service) { s.c = c } }
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	c, err := camundav87.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav87.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{c: c, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// PinClock freezes the engine clock at t until it is reset; the cluster must run with the clock controllable.
+func (s *Service) PinClock(ctx context.Context, t time.Time, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("pinning clock to %s", t.Format(time.RFC3339)))
+	b, err := json.Marshal(camundav87.ClockPinRequest{Timestamp: t.UnixMilli()})
+	if err != nil {
+		return fmt.Errorf("marshalling clock pin request: %w", err)
+	}
+	resp, err := s.c.PutClockWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) ResetClock(ctx context.Context, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug("resetting clock")
+	resp, err := s.c.PostClockResetWithResponse(ctx)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+// GetGatewayTime returns the wall clock of the gateway taken from the Date header of a topology request.
+// The pinned engine clock cannot be read back through the API.
+func (s *Service) GetGatewayTime(ctx context.Context, opts ...services.CallOption) (time.Time, error) {
+	_ = services.ApplyCallOptions(opts)
+	resp, err := s.c.GetTopologyWithResponse(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return time.Time{}, err
+	}
+	t, err := http.ParseTime(resp.HTTPResponse.Header.Get("Date"))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: no valid Date header in gateway response: %v", d.ErrMalformedResponse, err)
+	}
+	return t, nil
+}
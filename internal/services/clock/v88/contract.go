@@ -0,0 +1,16 @@
+package v88
+
+import (
+	"context"
+	"io"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+)
+
+type GenClockClient interface {
+	PinClockWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.PinClockResponse, error)
+	ResetClockWithResponse(ctx context.Context, reqEditors ...camundav88.RequestEditorFn) (*camundav88.ResetClockResponse, error)
+	GetTopologyWithResponse(ctx context.Context, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetTopologyResponse, error)
+}
+
+var _ GenClockClient = (*camundav88.ClientWithResponses)(nil)
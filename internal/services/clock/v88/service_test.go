@@ -0,0 +1,42 @@
+package v88
+
+import (
+	"testing"
+	"time"
+
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Internal_Clock_v88_PinResetClock_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	require.NoError(t, svc.PinClock(ctx, time.Date(2025, 12, 24, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, svc.ResetClock(ctx))
+}
+
+func Test_Internal_Clock_v88_GetGatewayTime_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	gw, err := svc.GetGatewayTime(ctx)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), gw, 5*time.Second)
+}
@@ -11,6 +11,9 @@ import (
 
 type API interface {
 	GetClusterTopology(ctx context.Context, opts ...services.CallOption) (d.Topology, error)
+	GetClusterStatus(ctx context.Context, opts ...services.CallOption) (bool, error)
+	GetLicense(ctx context.Context, opts ...services.CallOption) (d.License, error)
+	PingEndpoints(ctx context.Context, opts ...services.CallOption) []d.EndpointStatus
 }
 
 var _ API = (*v87.Service)(nil)
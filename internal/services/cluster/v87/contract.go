@@ -8,6 +8,7 @@ import (
 
 type GenClusterClient interface {
 	GetTopologyWithResponse(ctx context.Context, reqEditors ...camundav87.RequestEditorFn) (*camundav87.GetTopologyResponse, error)
+	GetLicenseWithResponse(ctx context.Context, reqEditors ...camundav87.RequestEditorFn) (*camundav87.GetLicenseResponse, error)
 }
 
 var _ GenClusterClient = (*camundav87.ClientWithResponses)(nil)
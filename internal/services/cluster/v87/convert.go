@@ -33,3 +33,10 @@ func fromPartition(p camundav87.Partition) d.Partition {
 		Role:        d.PartitionRole(toolx.Deref(p.Role, "")),
 	}
 }
+
+func fromLicenseResponse(r camundav87.LicenseResponse) d.License {
+	return d.License{
+		ValidLicense: toolx.Deref(r.ValidLicense, false),
+		LicenseType:  toolx.Deref(r.LicenseType, ""),
+	}
+}
@@ -10,11 +10,13 @@ import (
 	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
 	d "github.com/grafvonb/kamunder/internal/domain"
 	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/common"
 	"github.com/grafvonb/kamunder/internal/services/httpc"
 )
 
 type Service struct {
 	c   GenClusterClient
+	hc  *http.Client
 	cfg *config.Config
 	log *slog.Logger
 }
@@ -31,7 +33,7 @@ func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...
 	if err != nil {
 		return nil, err
 	}
-	s := &Service{c: c, cfg: cfg, log: log}
+	s := &Service{c: c, hc: httpClient, cfg: cfg, log: log}
 	for _, opt := range opts {
 		opt(s)
 	}
@@ -53,3 +55,36 @@ func (s *Service) GetClusterTopology(ctx context.Context, opts ...services.CallO
 	}
 	return fromTopologyResponse(*resp.JSON200), nil
 }
+
+func (s *Service) GetClusterStatus(ctx context.Context, opts ...services.CallOption) (bool, error) {
+	return false, fmt.Errorf("%w: cluster status requires camunda 8.8", d.ErrNotSupported)
+}
+
+func (s *Service) GetLicense(ctx context.Context, opts ...services.CallOption) (d.License, error) {
+	_ = services.ApplyCallOptions(opts)
+	resp, err := s.c.GetLicenseWithResponse(ctx)
+	if err != nil {
+		return d.License{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.License{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.License{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromLicenseResponse(*resp.JSON200), nil
+}
+
+// PingEndpoints probes the configured Operate and Tasklist base URLs.
+func (s *Service) PingEndpoints(ctx context.Context, opts ...services.CallOption) []d.EndpointStatus {
+	_ = services.ApplyCallOptions(opts)
+	var out []d.EndpointStatus
+	for _, a := range []config.API{s.cfg.APIs.Operate, s.cfg.APIs.Tasklist} {
+		if a.BaseURL == "" {
+			continue
+		}
+		out = append(out, common.PingEndpoint(ctx, s.hc, a.Key, a.BaseURL))
+	}
+	return out
+}
@@ -8,6 +8,8 @@ import (
 
 type GenClusterClient interface {
 	GetTopologyWithResponse(ctx context.Context, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetTopologyResponse, error)
+	GetLicenseWithResponse(ctx context.Context, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetLicenseResponse, error)
+	GetStatusWithResponse(ctx context.Context, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetStatusResponse, error)
 }
 
 var _ GenClusterClient = (*camundav88.ClientWithResponses)(nil)
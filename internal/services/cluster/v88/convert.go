@@ -1,6 +1,8 @@
 package v88
 
 import (
+	"time"
+
 	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
 	d "github.com/grafvonb/kamunder/internal/domain"
 	"github.com/grafvonb/kamunder/toolx"
@@ -33,3 +35,15 @@ func fromPartition(p camundav88.Partition) d.Partition {
 		Role:        d.PartitionRole(p.Role),
 	}
 }
+
+func fromLicenseResponse(r camundav88.LicenseResponse) d.License {
+	l := d.License{
+		ValidLicense: r.ValidLicense,
+		LicenseType:  r.LicenseType,
+		IsCommercial: r.IsCommercial,
+	}
+	if r.ExpiresAt != nil {
+		l.ExpiresAt = r.ExpiresAt.Format(time.RFC3339)
+	}
+	return l
+}
@@ -27,3 +27,47 @@ func Test_Internal_Cluster_v88_GetClusterTopology_OK(t *testing.T) {
 	t.Logf("success: got cluster topology")
 	testx.LogJson(t, topology)
 }
+
+func Test_Internal_Cluster_v88_GetClusterStatus_GetLicense_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	healthy, err := svc.GetClusterStatus(ctx)
+	require.NoError(t, err)
+	require.True(t, healthy)
+
+	license, err := svc.GetLicense(ctx)
+	require.NoError(t, err)
+	require.True(t, license.ValidLicense)
+	require.Equal(t, "production", license.LicenseType)
+	require.Equal(t, "2030-01-01T00:00:00Z", license.ExpiresAt)
+}
+
+func Test_Internal_Cluster_v88_PingEndpoints_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+	cfg.APIs.Operate.BaseURL = fs.BaseURL + "/v1"
+	cfg.APIs.Tasklist.BaseURL = ""
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	eps := svc.PingEndpoints(ctx)
+	require.Len(t, eps, 1)
+	require.Equal(t, cfg.APIs.Operate.Key, eps[0].Name)
+	require.Equal(t, 404, eps[0].StatusCode)
+	require.Empty(t, eps[0].Error)
+}
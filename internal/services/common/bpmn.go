@@ -0,0 +1,60 @@
+package common
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// SignalCatchEventIds returns the ids of intermediate catch events in the BPMN model that wait for the signal with the given name.
+// Signals with expression names (e.g. "=signalName") cannot be resolved statically and are never matched.
+func SignalCatchEventIds(bpmn []byte, signalName string) (map[string]bool, error) {
+	dec := xml.NewDecoder(bytes.NewReader(bpmn))
+	signalNames := map[string]string{} // signal id -> name
+	eventRefs := map[string]string{}   // catch event id -> signal id
+	currentEvent := ""
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing bpmn: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "signal":
+				signalNames[attr(t, "id")] = attr(t, "name")
+			case "intermediateCatchEvent":
+				currentEvent = attr(t, "id")
+			case "signalEventDefinition":
+				if currentEvent != "" {
+					eventRefs[currentEvent] = attr(t, "signalRef")
+				}
+			}
+		case xml.EndElement:
+			if t.Name.Local == "intermediateCatchEvent" {
+				currentEvent = ""
+			}
+		}
+	}
+	out := map[string]bool{}
+	for eventId, ref := range eventRefs {
+		if signalNames[ref] == signalName {
+			out[eventId] = true
+		}
+	}
+	return out, nil
+}
+
+func attr(e xml.StartElement, name string) string {
+	for _, a := range e.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,29 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+)
+
+// PingEndpoint probes an API base URL with a GET request. Any HTTP response below 500 counts as
+// reachable, the caller decides what to make of the status code.
+func PingEndpoint(ctx context.Context, hc *http.Client, name, url string) d.EndpointStatus {
+	st := d.EndpointStatus{Name: name, URL: url}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		st.Error = err.Error()
+		return st
+	}
+	resp, err := hc.Do(req)
+	if err != nil {
+		st.Error = err.Error()
+		return st
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	st.StatusCode = resp.StatusCode
+	return st
+}
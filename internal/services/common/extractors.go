@@ -11,3 +11,24 @@ func KeysFromItems[T any](items *[]T, getKey func(T) int64) []int64 {
 	}
 	return out
 }
+
+// DistinctKeys returns the non-empty keys of items in first-seen order without duplicates.
+func DistinctKeys[T any](items []T, getKey func(T) string) []string {
+	seen := make(map[string]bool, len(items))
+	var out []string
+	for _, it := range items {
+		if k := getKey(it); k != "" && !seen[k] {
+			seen[k] = true
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+// Truncate returns at most size items and whether items were cut off, searches ask for size+1 items to detect it.
+func Truncate[T any](items []T, size int32) ([]T, bool) {
+	if size <= 0 || int32(len(items)) <= size {
+		return items, false
+	}
+	return items[:size], true
+}
@@ -0,0 +1,21 @@
+package decision
+
+import (
+	"context"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	v87 "github.com/grafvonb/kamunder/internal/services/decision/v87"
+	v88 "github.com/grafvonb/kamunder/internal/services/decision/v88"
+)
+
+type API interface {
+	GetDecisionDefinitionByKey(ctx context.Context, key string, opts ...services.CallOption) (d.DecisionDefinition, error)
+	SearchDecisionDefinitions(ctx context.Context, filter d.DecisionDefinitionSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.DecisionDefinition, error)
+	GetDecisionInstanceById(ctx context.Context, id string, opts ...services.CallOption) (d.DecisionInstance, error)
+	SearchDecisionInstances(ctx context.Context, filter d.DecisionInstanceSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.DecisionInstance, error)
+	EvaluateDecision(ctx context.Context, ev d.DecisionEvaluation, opts ...services.CallOption) (d.DecisionEvaluationResult, error)
+}
+
+var _ API = (*v87.Service)(nil)
+var _ API = (*v88.Service)(nil)
@@ -0,0 +1,23 @@
+package v87
+
+import (
+	"context"
+	"io"
+
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+	operatev87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/operate"
+)
+
+type GenDecisionClientCamunda interface {
+	PostDecisionDefinitionsEvaluationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PostDecisionDefinitionsEvaluationResponse, error)
+}
+
+type GenDecisionClientOperate interface {
+	GetDecisionDefinitionByKeyWithResponse(ctx context.Context, key int64, reqEditors ...operatev87.RequestEditorFn) (*operatev87.GetDecisionDefinitionByKeyResponse, error)
+	SearchDecisionDefinitionsWithResponse(ctx context.Context, body operatev87.SearchDecisionDefinitionsJSONRequestBody, reqEditors ...operatev87.RequestEditorFn) (*operatev87.SearchDecisionDefinitionsResponse, error)
+	GetDecisionInstanceByIdWithResponse(ctx context.Context, id string, reqEditors ...operatev87.RequestEditorFn) (*operatev87.GetDecisionInstanceByIdResponse, error)
+	SearchDecisionInstancesWithResponse(ctx context.Context, body operatev87.SearchDecisionInstancesJSONRequestBody, reqEditors ...operatev87.RequestEditorFn) (*operatev87.SearchDecisionInstancesResponse, error)
+}
+
+var _ GenDecisionClientCamunda = (*camundav87.ClientWithResponses)(nil)
+var _ GenDecisionClientOperate = (*operatev87.ClientWithResponses)(nil)
@@ -0,0 +1,74 @@
+package v87
+
+import (
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+	operatev87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/operate"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromDecisionDefinitionResponse(r operatev87.DecisionDefinition) d.DecisionDefinition {
+	return d.DecisionDefinition{
+		DecisionId:                  toolx.Deref(r.DecisionId, ""),
+		DecisionRequirementsId:      toolx.Deref(r.DecisionRequirementsId, ""),
+		DecisionRequirementsKey:     toolx.Int64PtrToString(r.DecisionRequirementsKey),
+		DecisionRequirementsName:    toolx.Deref(r.DecisionRequirementsName, ""),
+		DecisionRequirementsVersion: toolx.Deref(r.DecisionRequirementsVersion, int32(0)),
+		Key:                         toolx.Int64PtrToString(r.Key),
+		Name:                        toolx.Deref(r.Name, ""),
+		TenantId:                    toolx.Deref(r.TenantId, ""),
+		Version:                     toolx.Deref(r.Version, int32(0)),
+	}
+}
+
+func fromDecisionInstanceResponse(r operatev87.DecisionInstance) d.DecisionInstance {
+	return d.DecisionInstance{
+		DecisionDefinitionId: toolx.Deref(r.DecisionDefinitionId, ""),
+		DecisionId:           toolx.Deref(r.DecisionId, ""),
+		DecisionName:         toolx.Deref(r.DecisionName, ""),
+		DecisionType:         string(toolx.Deref(r.DecisionType, "")),
+		DecisionVersion:      toolx.Deref(r.DecisionVersion, int32(0)),
+		EvaluatedInputs:      toolx.DerefSlicePtr(r.EvaluatedInputs, fromDecisionInstanceInput),
+		EvaluatedOutputs:     toolx.DerefSlicePtr(r.EvaluatedOutputs, fromDecisionInstanceOutput),
+		EvaluationDate:       toolx.Deref(r.EvaluationDate, ""),
+		EvaluationFailure:    toolx.Deref(r.EvaluationFailure, ""),
+		Id:                   toolx.Deref(r.Id, ""),
+		Key:                  toolx.Int64PtrToString(r.Key),
+		ProcessDefinitionKey: toolx.Int64PtrToString(r.ProcessDefinitionKey),
+		ProcessInstanceKey:   toolx.Int64PtrToString(r.ProcessInstanceKey),
+		Result:               toolx.Deref(r.Result, ""),
+		State:                string(toolx.Deref(r.State, "")),
+		TenantId:             toolx.Deref(r.TenantId, ""),
+	}
+}
+
+func fromDecisionInstanceInput(r operatev87.DecisionInstanceInput) d.DecisionInput {
+	return d.DecisionInput{
+		Id:    toolx.Deref(r.Id, ""),
+		Name:  toolx.Deref(r.Name, ""),
+		Value: toolx.Deref(r.Value, ""),
+	}
+}
+
+func fromDecisionInstanceOutput(r operatev87.DecisionInstanceOutput) d.DecisionOutput {
+	return d.DecisionOutput{
+		Id:        toolx.Deref(r.Id, ""),
+		Name:      toolx.Deref(r.Name, ""),
+		RuleId:    toolx.Deref(r.RuleId, ""),
+		RuleIndex: toolx.Deref(r.RuleIndex, int32(0)),
+		Value:     toolx.Deref(r.Value, ""),
+	}
+}
+
+func fromEvaluateDecisionResult(r camundav87.EvaluateDecisionResult) d.DecisionEvaluationResult {
+	return d.DecisionEvaluationResult{
+		DecisionId:             toolx.Deref(r.DecisionDefinitionId, ""),
+		DecisionName:           toolx.Deref(r.DecisionDefinitionName, ""),
+		DecisionVersion:        toolx.Deref(r.DecisionDefinitionVersion, int32(0)),
+		DecisionRequirementsId: toolx.Deref(r.DecisionRequirementsId, ""),
+		FailedDecisionId:       toolx.Deref(r.FailedDecisionDefinitionId, ""),
+		FailureMessage:         toolx.Deref(r.FailureMessage, ""),
+		Output:                 toolx.Deref(r.Output, ""),
+		TenantId:               toolx.Deref(r.TenantId, ""),
+	}
+}
@@ -0,0 +1,22 @@
+package v88
+
+import (
+	"context"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	operatev88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/operate"
+)
+
+type GenDecisionClientCamunda interface {
+	EvaluateDecisionWithResponse(ctx context.Context, body camundav88.EvaluateDecisionJSONRequestBody, reqEditors ...camundav88.RequestEditorFn) (*camundav88.EvaluateDecisionResponse, error)
+}
+
+type GenDecisionClientOperate interface {
+	GetDecisionDefinitionByKeyWithResponse(ctx context.Context, key int64, reqEditors ...operatev88.RequestEditorFn) (*operatev88.GetDecisionDefinitionByKeyResponse, error)
+	SearchDecisionDefinitionsWithResponse(ctx context.Context, body operatev88.SearchDecisionDefinitionsJSONRequestBody, reqEditors ...operatev88.RequestEditorFn) (*operatev88.SearchDecisionDefinitionsResponse, error)
+	GetDecisionInstanceByIdWithResponse(ctx context.Context, id string, reqEditors ...operatev88.RequestEditorFn) (*operatev88.GetDecisionInstanceByIdResponse, error)
+	SearchDecisionInstancesWithResponse(ctx context.Context, body operatev88.SearchDecisionInstancesJSONRequestBody, reqEditors ...operatev88.RequestEditorFn) (*operatev88.SearchDecisionInstancesResponse, error)
+}
+
+var _ GenDecisionClientCamunda = (*camundav88.ClientWithResponses)(nil)
+var _ GenDecisionClientOperate = (*operatev88.ClientWithResponses)(nil)
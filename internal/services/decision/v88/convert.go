@@ -0,0 +1,115 @@
+package v88
+
+import (
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	operatev88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/operate"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromDecisionDefinitionResponse(r operatev88.DecisionDefinition) d.DecisionDefinition {
+	return d.DecisionDefinition{
+		DecisionId:                  toolx.Deref(r.DecisionId, ""),
+		DecisionRequirementsId:      toolx.Deref(r.DecisionRequirementsId, ""),
+		DecisionRequirementsKey:     toolx.Int64PtrToString(r.DecisionRequirementsKey),
+		DecisionRequirementsName:    toolx.Deref(r.DecisionRequirementsName, ""),
+		DecisionRequirementsVersion: toolx.Deref(r.DecisionRequirementsVersion, int32(0)),
+		Key:                         toolx.Int64PtrToString(r.Key),
+		Name:                        toolx.Deref(r.Name, ""),
+		TenantId:                    toolx.Deref(r.TenantId, ""),
+		Version:                     toolx.Deref(r.Version, int32(0)),
+	}
+}
+
+func fromDecisionInstanceResponse(r operatev88.DecisionInstance) d.DecisionInstance {
+	return d.DecisionInstance{
+		DecisionDefinitionId: toolx.Deref(r.DecisionDefinitionId, ""),
+		DecisionId:           toolx.Deref(r.DecisionId, ""),
+		DecisionName:         toolx.Deref(r.DecisionName, ""),
+		DecisionType:         string(toolx.Deref(r.DecisionType, "")),
+		DecisionVersion:      toolx.Deref(r.DecisionVersion, int32(0)),
+		EvaluatedInputs:      toolx.DerefSlicePtr(r.EvaluatedInputs, fromDecisionInstanceInput),
+		EvaluatedOutputs:     toolx.DerefSlicePtr(r.EvaluatedOutputs, fromDecisionInstanceOutput),
+		EvaluationDate:       toolx.Deref(r.EvaluationDate, ""),
+		EvaluationFailure:    toolx.Deref(r.EvaluationFailure, ""),
+		Id:                   toolx.Deref(r.Id, ""),
+		Key:                  toolx.Int64PtrToString(r.Key),
+		ProcessDefinitionKey: toolx.Int64PtrToString(r.ProcessDefinitionKey),
+		ProcessInstanceKey:   toolx.Int64PtrToString(r.ProcessInstanceKey),
+		Result:               toolx.Deref(r.Result, ""),
+		State:                string(toolx.Deref(r.State, "")),
+		TenantId:             toolx.Deref(r.TenantId, ""),
+	}
+}
+
+func fromDecisionInstanceInput(r operatev88.DecisionInstanceInput) d.DecisionInput {
+	return d.DecisionInput{
+		Id:    toolx.Deref(r.Id, ""),
+		Name:  toolx.Deref(r.Name, ""),
+		Value: toolx.Deref(r.Value, ""),
+	}
+}
+
+func fromDecisionInstanceOutput(r operatev88.DecisionInstanceOutput) d.DecisionOutput {
+	return d.DecisionOutput{
+		Id:        toolx.Deref(r.Id, ""),
+		Name:      toolx.Deref(r.Name, ""),
+		RuleId:    toolx.Deref(r.RuleId, ""),
+		RuleIndex: toolx.Deref(r.RuleIndex, int32(0)),
+		Value:     toolx.Deref(r.Value, ""),
+	}
+}
+
+func fromEvaluateDecisionResult(r camundav88.EvaluateDecisionResult) d.DecisionEvaluationResult {
+	return d.DecisionEvaluationResult{
+		DecisionId:             r.DecisionDefinitionId,
+		DecisionKey:            r.DecisionDefinitionKey,
+		DecisionName:           r.DecisionDefinitionName,
+		DecisionVersion:        r.DecisionDefinitionVersion,
+		DecisionInstanceKey:    toolx.Deref(r.DecisionInstanceKey, r.DecisionEvaluationKey),
+		DecisionRequirementsId: r.DecisionRequirementsId,
+		EvaluatedDecisions:     toolx.MapSlice(r.EvaluatedDecisions, fromEvaluatedDecisionResult),
+		FailedDecisionId:       r.FailedDecisionDefinitionId,
+		FailureMessage:         r.FailureMessage,
+		Output:                 r.Output,
+		TenantId:               r.TenantId,
+	}
+}
+
+func fromEvaluatedDecisionResult(r camundav88.EvaluatedDecisionResult) d.EvaluatedDecision {
+	return d.EvaluatedDecision{
+		DecisionId:       toolx.Deref(r.DecisionDefinitionId, ""),
+		DecisionKey:      toolx.Deref(r.DecisionDefinitionKey, ""),
+		DecisionName:     toolx.Deref(r.DecisionDefinitionName, ""),
+		DecisionType:     toolx.Deref(r.DecisionDefinitionType, ""),
+		DecisionVersion:  toolx.Deref(r.DecisionDefinitionVersion, int32(0)),
+		EvaluatedInputs:  toolx.DerefSlicePtr(r.EvaluatedInputs, fromEvaluatedDecisionInputItem),
+		EvaluatedOutputs: fromMatchedDecisionRuleItems(r.MatchedRules),
+		Output:           toolx.Deref(r.Output, ""),
+	}
+}
+
+func fromEvaluatedDecisionInputItem(r camundav88.EvaluatedDecisionInputItem) d.DecisionInput {
+	return d.DecisionInput{
+		Id:    toolx.Deref(r.InputId, ""),
+		Name:  toolx.Deref(r.InputName, ""),
+		Value: toolx.Deref(r.InputValue, ""),
+	}
+}
+
+// fromMatchedDecisionRuleItems flattens the outputs of all matched rules, keeping the rule reference on each output.
+func fromMatchedDecisionRuleItems(p *[]camundav88.MatchedDecisionRuleItem) []d.DecisionOutput {
+	var out []d.DecisionOutput
+	for _, rule := range toolx.DerefSlice(p) {
+		for _, o := range toolx.DerefSlice(rule.EvaluatedOutputs) {
+			out = append(out, d.DecisionOutput{
+				Id:        toolx.Deref(o.OutputId, ""),
+				Name:      toolx.Deref(o.OutputName, ""),
+				RuleId:    toolx.Deref(rule.RuleId, ""),
+				RuleIndex: toolx.Deref(rule.RuleIndex, int32(0)),
+				Value:     toolx.Deref(o.OutputValue, ""),
+			})
+		}
+	}
+	return out
+}
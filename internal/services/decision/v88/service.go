@@ -0,0 +1,197 @@
+package v88
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	operatev88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/operate"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type Service struct {
+	cc  GenDecisionClientCamunda
+	oc  GenDecisionClientOperate
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	cc, err := camundav88.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav88.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	co, err := operatev88.NewClientWithResponses(
+		cfg.APIs.Operate.BaseURL,
+		operatev88.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{oc: co, cc: cc, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) GetDecisionDefinitionByKey(ctx context.Context, key string, opts ...services.CallOption) (d.DecisionDefinition, error) {
+	_ = services.ApplyCallOptions(opts)
+	oldKey, err := toolx.StringToInt64(key)
+	if err != nil {
+		return d.DecisionDefinition{}, fmt.Errorf("converting decision definition key %q to int64: %w", key, err)
+	}
+	resp, err := s.oc.GetDecisionDefinitionByKeyWithResponse(ctx, oldKey)
+	if err != nil {
+		return d.DecisionDefinition{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.DecisionDefinition{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.DecisionDefinition{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromDecisionDefinitionResponse(*resp.JSON200), nil
+}
+
+func (s *Service) SearchDecisionDefinitions(ctx context.Context, filter d.DecisionDefinitionSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.DecisionDefinition, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for decision definitions with filter: %+v", filter))
+	key, err := toolx.StringToInt64Ptr(filter.Key)
+	if err != nil {
+		return nil, fmt.Errorf("parsing decision definition key %q to int64: %w", filter.Key, err)
+	}
+	drk, err := toolx.StringToInt64Ptr(filter.DecisionRequirementsKey)
+	if err != nil {
+		return nil, fmt.Errorf("parsing decision requirements key %q to int64: %w", filter.DecisionRequirementsKey, err)
+	}
+	body := operatev88.QueryDecisionDefinition{
+		Filter: &operatev88.DecisionDefinition{
+			DecisionId:              toolx.PtrIf(filter.DecisionId, ""),
+			DecisionRequirementsKey: drk,
+			Key:                     key,
+			Name:                    toolx.PtrIf(filter.Name, ""),
+			TenantId:                toolx.PtrIf(s.cfg.App.Tenant, ""),
+			Version:                 toolx.PtrIfNonZero(filter.Version),
+		},
+		Size: &size,
+	}
+	resp, err := s.oc.SearchDecisionDefinitionsWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return toolx.DerefSlicePtr(resp.JSON200.Items, fromDecisionDefinitionResponse), nil
+}
+
+func (s *Service) GetDecisionInstanceById(ctx context.Context, id string, opts ...services.CallOption) (d.DecisionInstance, error) {
+	_ = services.ApplyCallOptions(opts)
+	resp, err := s.oc.GetDecisionInstanceByIdWithResponse(ctx, id)
+	if err != nil {
+		return d.DecisionInstance{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.DecisionInstance{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.DecisionInstance{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromDecisionInstanceResponse(*resp.JSON200), nil
+}
+
+func (s *Service) SearchDecisionInstances(ctx context.Context, filter d.DecisionInstanceSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.DecisionInstance, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for decision instances with filter: %+v", filter))
+	pik, err := toolx.StringToInt64Ptr(filter.ProcessInstanceKey)
+	if err != nil {
+		return nil, fmt.Errorf("parsing process instance key %q to int64: %w", filter.ProcessInstanceKey, err)
+	}
+	f := operatev88.DecisionInstance{
+		DecisionDefinitionId: toolx.PtrIf(filter.DecisionDefinitionId, ""),
+		DecisionId:           toolx.PtrIf(filter.DecisionId, ""),
+		DecisionVersion:      toolx.PtrIfNonZero(filter.DecisionVersion),
+		ProcessInstanceKey:   pik,
+		TenantId:             toolx.PtrIf(s.cfg.App.Tenant, ""),
+	}
+	if filter.State != "" {
+		f.State = toolx.Ptr(operatev88.DecisionInstanceState(filter.State))
+	}
+	body := operatev88.QueryDecisionInstance{
+		Filter: &f,
+		Size:   &size,
+	}
+	resp, err := s.oc.SearchDecisionInstancesWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return toolx.DerefSlicePtr(resp.JSON200.Items, fromDecisionInstanceResponse), nil
+}
+
+func (s *Service) EvaluateDecision(ctx context.Context, ev d.DecisionEvaluation, opts ...services.CallOption) (d.DecisionEvaluationResult, error) {
+	_ = services.ApplyCallOptions(opts)
+	var vars *map[string]interface{}
+	if len(ev.Variables) > 0 {
+		vars = &ev.Variables
+	}
+	var body camundav88.EvaluateDecisionJSONRequestBody
+	var err error
+	switch {
+	case ev.DecisionKey != "":
+		s.log.Debug(fmt.Sprintf("evaluating decision with key %s", ev.DecisionKey))
+		err = body.FromDecisionEvaluationByKey(camundav88.DecisionEvaluationByKey{
+			DecisionDefinitionKey: ev.DecisionKey,
+			TenantId:              toolx.PtrIf(ev.TenantId, ""),
+			Variables:             vars,
+		})
+	case ev.DecisionId != "":
+		s.log.Debug(fmt.Sprintf("evaluating decision with id %s", ev.DecisionId))
+		err = body.FromDecisionEvaluationById(camundav88.DecisionEvaluationById{
+			DecisionDefinitionId: ev.DecisionId,
+			TenantId:             toolx.PtrIf(ev.TenantId, ""),
+			Variables:            vars,
+		})
+	default:
+		return d.DecisionEvaluationResult{}, fmt.Errorf("%w: either decision id or decision key must be provided", d.ErrBadRequest)
+	}
+	if err != nil {
+		return d.DecisionEvaluationResult{}, fmt.Errorf("building decision evaluation request: %w", err)
+	}
+	resp, err := s.cc.EvaluateDecisionWithResponse(ctx, body)
+	if err != nil {
+		return d.DecisionEvaluationResult{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.DecisionEvaluationResult{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.DecisionEvaluationResult{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromEvaluateDecisionResult(*resp.JSON200), nil
+}
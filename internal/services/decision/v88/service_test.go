@@ -0,0 +1,50 @@
+package v88
+
+import (
+	"testing"
+	"time"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Internal_Decision_v88_EvaluateDecision_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	res, err := svc.EvaluateDecision(ctx, d.DecisionEvaluation{
+		DecisionId: "invoiceClassification",
+		TenantId:   cfg.App.Tenant,
+		Variables:  map[string]any{"amount": 250},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "invoiceClassification", res.DecisionId)
+	require.Equal(t, "2251799813700001", res.DecisionInstanceKey)
+	require.Len(t, res.EvaluatedDecisions, 1)
+	require.Len(t, res.EvaluatedDecisions[0].EvaluatedInputs, 1)
+	require.Len(t, res.EvaluatedDecisions[0].EvaluatedOutputs, 1)
+	require.Equal(t, "Rule_1", res.EvaluatedDecisions[0].EvaluatedOutputs[0].RuleId)
+
+	testx.LogJson(t, res)
+}
+
+func Test_Internal_Decision_v88_EvaluateDecision_MissingIdAndKey(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	svc, err := New(cfg, nil, log)
+	require.NoError(t, err)
+
+	_, err = svc.EvaluateDecision(ctx, d.DecisionEvaluation{})
+	require.ErrorIs(t, err, d.ErrBadRequest)
+}
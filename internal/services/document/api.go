@@ -0,0 +1,21 @@
+package document
+
+import (
+	"context"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	v87 "github.com/grafvonb/kamunder/internal/services/document/v87"
+	v88 "github.com/grafvonb/kamunder/internal/services/document/v88"
+)
+
+type API interface {
+	CreateDocument(ctx context.Context, u d.DocumentUpload, opts ...services.CallOption) (d.DocumentReference, error)
+	CreateDocuments(ctx context.Context, storeId string, us []d.DocumentUpload, opts ...services.CallOption) (d.DocumentBatch, error)
+	GetDocument(ctx context.Context, documentId, storeId, contentHash string, opts ...services.CallOption) ([]byte, error)
+	DeleteDocument(ctx context.Context, documentId, storeId string, opts ...services.CallOption) error
+	CreateDocumentLink(ctx context.Context, documentId string, r d.DocumentLinkRequest, opts ...services.CallOption) (d.DocumentLink, error)
+}
+
+var _ API = (*v87.Service)(nil)
+var _ API = (*v88.Service)(nil)
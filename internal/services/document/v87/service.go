@@ -0,0 +1,46 @@
+package v87
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/grafvonb/kamunder/config"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+)
+
+// Service is a stub, the 8.7 document API is an alpha feature that kamunder does not support.
+type Service struct {
+	cfg *config.Config
+	log *slog.Logger
+}
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger) (*Service, error) {
+	return &Service{cfg: cfg, log: log}, nil
+}
+
+func (s *Service) CreateDocument(ctx context.Context, u d.DocumentUpload, opts ...services.CallOption) (d.DocumentReference, error) {
+	return d.DocumentReference{}, errNotSupported("uploading documents")
+}
+
+func (s *Service) CreateDocuments(ctx context.Context, storeId string, us []d.DocumentUpload, opts ...services.CallOption) (d.DocumentBatch, error) {
+	return d.DocumentBatch{}, errNotSupported("uploading documents")
+}
+
+func (s *Service) GetDocument(ctx context.Context, documentId, storeId, contentHash string, opts ...services.CallOption) ([]byte, error) {
+	return nil, errNotSupported("downloading documents")
+}
+
+func (s *Service) DeleteDocument(ctx context.Context, documentId, storeId string, opts ...services.CallOption) error {
+	return errNotSupported("deleting documents")
+}
+
+func (s *Service) CreateDocumentLink(ctx context.Context, documentId string, r d.DocumentLinkRequest, opts ...services.CallOption) (d.DocumentLink, error) {
+	return d.DocumentLink{}, errNotSupported("creating document links")
+}
+
+func errNotSupported(what string) error {
+	return fmt.Errorf("%w: %s requires camunda 8.8", d.ErrNotSupported, what)
+}
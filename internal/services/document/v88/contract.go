@@ -0,0 +1,18 @@
+package v88
+
+import (
+	"context"
+	"io"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+)
+
+type GenDocumentClient interface {
+	CreateDocumentWithBodyWithResponse(ctx context.Context, params *camundav88.CreateDocumentParams, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CreateDocumentResponse, error)
+	CreateDocumentsWithBodyWithResponse(ctx context.Context, params *camundav88.CreateDocumentsParams, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CreateDocumentsResponse, error)
+	GetDocumentWithResponse(ctx context.Context, documentId camundav88.DocumentId, params *camundav88.GetDocumentParams, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetDocumentResponse, error)
+	DeleteDocumentWithResponse(ctx context.Context, documentId camundav88.DocumentId, params *camundav88.DeleteDocumentParams, reqEditors ...camundav88.RequestEditorFn) (*camundav88.DeleteDocumentResponse, error)
+	CreateDocumentLinkWithResponse(ctx context.Context, documentId camundav88.DocumentId, params *camundav88.CreateDocumentLinkParams, body camundav88.CreateDocumentLinkJSONRequestBody, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CreateDocumentLinkResponse, error)
+}
+
+var _ GenDocumentClient = (*camundav88.ClientWithResponses)(nil)
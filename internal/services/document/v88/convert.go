@@ -0,0 +1,72 @@
+package v88
+
+import (
+	"time"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromDocumentReference(r camundav88.DocumentReference) d.DocumentReference {
+	return d.DocumentReference{
+		StoreId:     toolx.Deref(r.StoreId, ""),
+		DocumentId:  toolx.Deref(r.DocumentId, ""),
+		ContentHash: toolx.Deref(r.ContentHash, ""),
+		Metadata:    toolx.DerefMap(r.Metadata, fromDocumentMetadata, d.DocumentMetadata{}),
+	}
+}
+
+func fromDocumentMetadata(m camundav88.DocumentMetadata) d.DocumentMetadata {
+	return d.DocumentMetadata{
+		ContentType:         toolx.Deref(m.ContentType, ""),
+		FileName:            toolx.Deref(m.FileName, ""),
+		Size:                toolx.Deref(m.Size, 0),
+		ExpiresAt:           formatTimePtr(m.ExpiresAt),
+		ProcessDefinitionId: toolx.Deref(m.ProcessDefinitionId, ""),
+		ProcessInstanceKey:  toolx.Deref(m.ProcessInstanceKey, ""),
+		CustomProperties:    toolx.Deref(m.CustomProperties, nil),
+	}
+}
+
+// toDocumentMetadata builds the metadata part of an upload; size and expiry are set by the store.
+func toDocumentMetadata(m d.DocumentMetadata) camundav88.DocumentMetadata {
+	out := camundav88.DocumentMetadata{
+		ContentType:         toolx.PtrIf(m.ContentType, ""),
+		FileName:            toolx.PtrIf(m.FileName, ""),
+		ProcessDefinitionId: toolx.PtrIf(m.ProcessDefinitionId, ""),
+		ProcessInstanceKey:  toolx.PtrIf(m.ProcessInstanceKey, ""),
+	}
+	if len(m.CustomProperties) > 0 {
+		out.CustomProperties = &m.CustomProperties
+	}
+	return out
+}
+
+func fromDocumentCreationFailure(f camundav88.DocumentCreationFailureDetail) d.DocumentCreationFailure {
+	return d.DocumentCreationFailure{
+		FileName: toolx.Deref(f.FileName, ""),
+		Detail:   toolx.Deref(f.Detail, ""),
+	}
+}
+
+func fromDocumentCreationBatchResponse(r camundav88.DocumentCreationBatchResponse) d.DocumentBatch {
+	return d.DocumentBatch{
+		Created: toolx.DerefSlicePtr(r.CreatedDocuments, fromDocumentReference),
+		Failed:  toolx.DerefSlicePtr(r.FailedDocuments, fromDocumentCreationFailure),
+	}
+}
+
+func fromDocumentLink(l camundav88.DocumentLink) d.DocumentLink {
+	return d.DocumentLink{
+		Url:       toolx.Deref(l.Url, ""),
+		ExpiresAt: formatTimePtr(l.ExpiresAt),
+	}
+}
+
+func formatTimePtr(p *time.Time) string {
+	if p == nil {
+		return ""
+	}
+	return p.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,189 @@
+package v88
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/common"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type Service struct {
+	c   GenDocumentClient
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+//nolint:unused
+func WithClient(c GenDocumentClient) Option { return func(s *Service) { s.c = c } }
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	c, err := camundav88.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav88.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{c: c, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) CreateDocument(ctx context.Context, u d.DocumentUpload, opts ...services.CallOption) (d.DocumentReference, error) {
+	_ = services.ApplyCallOptions(opts)
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	if err := writeFilePart(w, "file", u); err != nil {
+		return d.DocumentReference{}, err
+	}
+	if err := writeJSONPart(w, "metadata", toDocumentMetadata(u.Metadata)); err != nil {
+		return d.DocumentReference{}, err
+	}
+	if err := w.Close(); err != nil {
+		return d.DocumentReference{}, err
+	}
+	params := &camundav88.CreateDocumentParams{
+		StoreId:    toolx.PtrIf(u.StoreId, ""),
+		DocumentId: toolx.PtrIf(u.DocumentId, ""),
+	}
+	s.log.Debug(fmt.Sprintf("uploading document %s (%d bytes)", u.Metadata.FileName, len(u.Data)))
+	resp, err := s.c.CreateDocumentWithBodyWithResponse(ctx, params, w.FormDataContentType(), bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		return d.DocumentReference{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.DocumentReference{}, err
+	}
+	if resp.JSON201 == nil {
+		return d.DocumentReference{}, fmt.Errorf("%w: 201 Created but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromDocumentReference(*resp.JSON201), nil
+}
+
+// CreateDocuments uploads all documents in one request. The store may accept only some of them,
+// the rejected ones are reported in DocumentBatch.Failed.
+func (s *Service) CreateDocuments(ctx context.Context, storeId string, us []d.DocumentUpload, opts ...services.CallOption) (d.DocumentBatch, error) {
+	_ = services.ApplyCallOptions(opts)
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	metadata := make([]camundav88.DocumentMetadata, 0, len(us))
+	for _, u := range us {
+		if err := writeFilePart(w, "files", u); err != nil {
+			return d.DocumentBatch{}, err
+		}
+		metadata = append(metadata, toDocumentMetadata(u.Metadata))
+	}
+	if err := writeJSONPart(w, "metadataList", metadata); err != nil {
+		return d.DocumentBatch{}, err
+	}
+	if err := w.Close(); err != nil {
+		return d.DocumentBatch{}, err
+	}
+	params := &camundav88.CreateDocumentsParams{StoreId: toolx.PtrIf(storeId, "")}
+	s.log.Debug(fmt.Sprintf("uploading %d documents", len(us)))
+	resp, err := s.c.CreateDocumentsWithBodyWithResponse(ctx, params, w.FormDataContentType(), bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		return d.DocumentBatch{}, err
+	}
+	// 207 Multi-Status is a partial success, not an error
+	if resp.JSON207 != nil {
+		return fromDocumentCreationBatchResponse(*resp.JSON207), nil
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.DocumentBatch{}, err
+	}
+	if resp.JSON201 == nil {
+		return d.DocumentBatch{}, fmt.Errorf("%w: 201 Created but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromDocumentCreationBatchResponse(*resp.JSON201), nil
+}
+
+func (s *Service) GetDocument(ctx context.Context, documentId, storeId, contentHash string, opts ...services.CallOption) ([]byte, error) {
+	_ = services.ApplyCallOptions(opts)
+	params := &camundav88.GetDocumentParams{StoreId: toolx.PtrIf(storeId, ""), ContentHash: contentHash}
+	s.log.Debug(fmt.Sprintf("downloading document %s", documentId))
+	resp, err := s.c.GetDocumentWithResponse(ctx, documentId, params)
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (s *Service) DeleteDocument(ctx context.Context, documentId, storeId string, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	params := &camundav88.DeleteDocumentParams{StoreId: toolx.PtrIf(storeId, "")}
+	s.log.Debug(fmt.Sprintf("deleting document %s", documentId))
+	resp, err := s.c.DeleteDocumentWithResponse(ctx, documentId, params)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) CreateDocumentLink(ctx context.Context, documentId string, r d.DocumentLinkRequest, opts ...services.CallOption) (d.DocumentLink, error) {
+	_ = services.ApplyCallOptions(opts)
+	params := &camundav88.CreateDocumentLinkParams{StoreId: toolx.PtrIf(r.StoreId, ""), ContentHash: r.ContentHash}
+	body := camundav88.DocumentLinkRequest{TimeToLive: toolx.PtrIfNonZero(r.TimeToLive.Milliseconds())}
+	s.log.Debug(fmt.Sprintf("creating link for document %s", documentId))
+	resp, err := s.c.CreateDocumentLinkWithResponse(ctx, documentId, params, body)
+	if err != nil {
+		return d.DocumentLink{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.DocumentLink{}, err
+	}
+	if resp.JSON201 == nil {
+		return d.DocumentLink{}, fmt.Errorf("%w: 201 Created but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromDocumentLink(*resp.JSON201), nil
+}
+
+func writeFilePart(w *multipart.Writer, field string, u d.DocumentUpload) error {
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="`+field+`"; filename="`+u.Metadata.FileName+`"`)
+	h.Set("Content-Type", common.DefaultVal(u.Metadata.ContentType, "application/octet-stream"))
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return err
+	}
+	_, err = part.Write(u.Data)
+	return err
+}
+
+func writeJSONPart(w *multipart.Writer, field string, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshalling %s: %w", field, err)
+	}
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="`+field+`"`)
+	h.Set("Content-Type", "application/json")
+	part, err := w.CreatePart(h)
+	if err != nil {
+		return err
+	}
+	_, err = part.Write(b)
+	return err
+}
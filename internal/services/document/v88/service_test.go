@@ -0,0 +1,80 @@
+package v88
+
+import (
+	"testing"
+	"time"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Internal_Document_v88_CreateDocument_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	ref, err := svc.CreateDocument(ctx, d.DocumentUpload{
+		Data:     []byte("%PDF-1.7 invoice"),
+		Metadata: d.DocumentMetadata{FileName: "invoice.pdf", ContentType: "application/pdf"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "doc-1", ref.DocumentId)
+	require.Equal(t, "in-memory", ref.StoreId)
+	require.Equal(t, "c0ffee", ref.ContentHash)
+	require.Equal(t, "invoice.pdf", ref.Metadata.FileName)
+	require.Equal(t, int64(16), ref.Metadata.Size)
+}
+
+func Test_Internal_Document_v88_CreateDocuments_PartialSuccess(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	b, err := svc.CreateDocuments(ctx, "", []d.DocumentUpload{
+		{Data: []byte("a"), Metadata: d.DocumentMetadata{FileName: "invoice.pdf"}},
+		{Data: []byte("b"), Metadata: d.DocumentMetadata{FileName: "huge.bin"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, b.Created, 1)
+	require.Len(t, b.Failed, 1)
+	require.Equal(t, "huge.bin", b.Failed[0].FileName)
+}
+
+func Test_Internal_Document_v88_GetLinkDeleteDocument_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	content, err := svc.GetDocument(ctx, "doc-1", "", "c0ffee")
+	require.NoError(t, err)
+	require.Equal(t, "%PDF-1.7 invoice", string(content))
+
+	link, err := svc.CreateDocumentLink(ctx, "doc-1", d.DocumentLinkRequest{ContentHash: "c0ffee", TimeToLive: time.Hour})
+	require.NoError(t, err)
+	require.Contains(t, link.Url, "/documents/doc-1")
+	require.Equal(t, "2030-01-01T00:00:00Z", link.ExpiresAt)
+
+	require.NoError(t, svc.DeleteDocument(ctx, "doc-1", ""))
+}
@@ -0,0 +1,174 @@
+package httpc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services/common"
+)
+
+const (
+	defaultRetryInitialDelay = 500 * time.Millisecond
+	defaultRetryMaxDelay     = 8 * time.Second
+	defaultRetryMultiplier   = 2.0
+	// defaultRetryMaxRetries applies if max_retries is 0, which means unlimited for the waiters;
+	// a single request is not retried forever.
+	defaultRetryMaxRetries = 3
+)
+
+// RetryTransport retries requests that are safe to repeat when the server is temporarily unavailable
+// (503, 502 from a proxy), rate limits (429), times out at the gateway (504) or resets the connection.
+// Delays follow the backoff config and Retry-After, and never exceed the backoff timeout or the request context.
+type RetryTransport struct {
+	base    http.RoundTripper
+	Backoff common.BackoffConfig
+	Log     *slog.Logger
+}
+
+// NewRetryTransport retries requests sent through base, zero values of backoff get defaults.
+func NewRetryTransport(base http.RoundTripper, backoff common.BackoffConfig, log *slog.Logger) *RetryTransport {
+	if backoff.Strategy == "" {
+		backoff.Strategy = common.BackoffExponential
+	}
+	if backoff.InitialDelay <= 0 {
+		backoff.InitialDelay = defaultRetryInitialDelay
+	}
+	if backoff.MaxDelay <= 0 {
+		backoff.MaxDelay = defaultRetryMaxDelay
+	}
+	if backoff.Multiplier <= 1 {
+		backoff.Multiplier = defaultRetryMultiplier
+	}
+	if backoff.MaxRetries <= 0 {
+		backoff.MaxRetries = defaultRetryMaxRetries
+	}
+	if log == nil {
+		log = slog.Default()
+	}
+	return &RetryTransport{base: base, Backoff: backoff, Log: log}
+}
+
+func (t *RetryTransport) rt() http.RoundTripper {
+	if t.base != nil {
+		return t.base
+	}
+	return http.DefaultTransport
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !retryableRequest(req) {
+		return t.rt().RoundTrip(req)
+	}
+	var deadline time.Time
+	if t.Backoff.Timeout > 0 {
+		deadline = time.Now().Add(t.Backoff.Timeout)
+	}
+	var delay time.Duration
+	for attempt := 1; ; attempt++ {
+		resp, err := t.rt().RoundTrip(req)
+		reason := retryReason(resp, err)
+		if reason == "" || attempt > t.Backoff.MaxRetries {
+			return resp, err
+		}
+		delay = t.Backoff.NextDelay(delay)
+		wait := delay
+		if ra, ok := retryAfter(resp); ok {
+			wait = ra
+		}
+		if !deadline.IsZero() && time.Now().Add(wait).After(deadline) {
+			return resp, err
+		}
+		if dl, ok := req.Context().Deadline(); ok && time.Now().Add(wait).After(dl) {
+			return resp, err
+		}
+		next, gerr := rewind(req)
+		if gerr != nil {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		t.Log.Debug(fmt.Sprintf("retrying %s %s after %s in %s (retry %d of %d)", req.Method, req.URL.Redacted(), reason, wait, attempt, t.Backoff.MaxRetries))
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		req = next
+	}
+}
+
+// retryableRequest reports whether req may be sent again: idempotent methods and POST searches,
+// with a body that can be recreated.
+func retryableRequest(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return strings.HasSuffix(strings.TrimSuffix(req.URL.Path, "/"), "/search")
+	}
+	return false
+}
+
+// retryReason describes why the outcome of an attempt is worth a retry, or is empty if it is not.
+func retryReason(resp *http.Response, err error) string {
+	if err != nil {
+		if errors.Is(err, syscall.ECONNRESET) {
+			return "connection reset"
+		}
+		return ""
+	}
+	if resp.StatusCode == http.StatusBadGateway {
+		return resp.Status
+	}
+	if serr := MapHTTPToDomain(resp.StatusCode); errors.Is(serr, d.ErrUnavailable) || errors.Is(serr, d.ErrRateLimited) || errors.Is(serr, d.ErrGatewayTimeout) {
+		return resp.Status
+	}
+	return ""
+}
+
+// retryAfter parses the Retry-After header, given in seconds or as HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	v := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// rewind returns a copy of req with a fresh body for the next attempt.
+func rewind(req *http.Request) (*http.Request, error) {
+	next := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		next.Body = body
+	}
+	return next, nil
+}
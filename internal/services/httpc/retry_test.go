@@ -0,0 +1,96 @@
+package httpc_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/grafvonb/kamunder/internal/services/common"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/stretchr/testify/require"
+)
+
+var fastBackoff = common.BackoffConfig{InitialDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond, MaxRetries: 3}
+
+// flakyServer answers with status for the first fails requests and 200 with the request body afterwards.
+func flakyServer(t *testing.T, fails int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		if n <= fails {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(b)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestRetryTransport_RetriesGetOnUnavailable(t *testing.T) {
+	srv, calls := flakyServer(t, 2, http.StatusServiceUnavailable, nil)
+	c := &http.Client{Transport: httpc.NewRetryTransport(nil, fastBackoff, nil)}
+
+	resp, err := c.Get(srv.URL + "/v2/process-instances/1")
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.EqualValues(t, 3, calls.Load())
+}
+
+func TestRetryTransport_RetriesSearchWithBody(t *testing.T) {
+	srv, calls := flakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}})
+	c := &http.Client{Transport: httpc.NewRetryTransport(nil, fastBackoff, nil)}
+
+	resp, err := c.Post(srv.URL+"/v2/process-instances/search", "application/json", strings.NewReader(`{"page":{"limit":10}}`))
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, `{"page":{"limit":10}}`, string(b))
+	require.EqualValues(t, 2, calls.Load())
+}
+
+func TestRetryTransport_DoesNotRetryMutatingPost(t *testing.T) {
+	srv, calls := flakyServer(t, 1, http.StatusServiceUnavailable, nil)
+	c := &http.Client{Transport: httpc.NewRetryTransport(nil, fastBackoff, nil)}
+
+	resp, err := c.Post(srv.URL+"/v2/process-instances/1/cancellation", "application/json", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.EqualValues(t, 1, calls.Load())
+}
+
+func TestRetryTransport_GivesUp(t *testing.T) {
+	srv, calls := flakyServer(t, 100, http.StatusGatewayTimeout, nil)
+	c := &http.Client{Transport: httpc.NewRetryTransport(nil, fastBackoff, nil)}
+
+	resp, err := c.Get(srv.URL + "/v1/process-instances/1")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusGatewayTimeout, resp.StatusCode)
+	require.EqualValues(t, 4, calls.Load(), "first attempt and max_retries retries")
+}
+
+func TestRetryTransport_RetryAfterBeyondTimeout(t *testing.T) {
+	srv, calls := flakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"120"}})
+	b := fastBackoff
+	b.Timeout = time.Second
+	c := &http.Client{Transport: httpc.NewRetryTransport(nil, b, nil)}
+
+	resp, err := c.Get(srv.URL + "/v2/topology")
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.EqualValues(t, 1, calls.Load())
+}
@@ -16,6 +16,11 @@ type LogTransport struct {
 	Log      *slog.Logger
 }
 
+// NewLogTransport logs requests sent through base, e.g. a transport with a client certificate.
+func NewLogTransport(base http.RoundTripper, log *slog.Logger) *LogTransport {
+	return &LogTransport{base: base, Log: log}
+}
+
 func (t *LogTransport) rt() http.RoundTripper {
 	if t.base != nil {
 		return t.base
@@ -59,6 +64,8 @@ func extractBody(dump []byte) []byte {
 type AuthTransport struct {
 	base   http.RoundTripper
 	Editor authenticator.RequestEditor
+	// Invalidate, if set, is called on a 401 response before the request is retried once.
+	Invalidate func()
 }
 
 func (t *AuthTransport) rt() http.RoundTripper {
@@ -74,5 +81,29 @@ func (t *AuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 			return nil, err
 		}
 	}
-	return t.rt().RoundTrip(req)
+	resp, err := t.rt().RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || t.Invalidate == nil {
+		return resp, err
+	}
+	// the body was consumed by the first attempt, retry only if it can be recreated
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, gerr := req.GetBody()
+		if gerr != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	t.Invalidate()
+	if t.Editor != nil {
+		if err = t.Editor(retry.Context(), retry); err != nil {
+			return nil, err
+		}
+	}
+	return t.rt().RoundTrip(retry)
 }
@@ -0,0 +1,99 @@
+package httpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+)
+
+// SearchDoer performs the raw search call, usually a thin wrapper around a generated ...WithBodyWithResponse method.
+type SearchDoer func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error)
+
+// searchPageSize is the limit of a single search request, larger searches are read page by page.
+const searchPageSize int32 = 1000
+
+type searchPage struct {
+	From  int32 `json:"from"`
+	Limit int32 `json:"limit"`
+}
+
+// SearchSort orders a search, e.g. {Field: "creationTime", Order: "DESC"}.
+type SearchSort struct {
+	Field string `json:"field"`
+	Order string `json:"order,omitempty"`
+}
+
+type searchRequest struct {
+	Filter map[string]any `json:"filter,omitempty"`
+	Sort   []SearchSort   `json:"sort,omitempty"`
+	Page   searchPage     `json:"page"`
+}
+
+type searchResponse[T any] struct {
+	Items []T `json:"items"`
+}
+
+// Search runs a v2 (8.7+) search query with the given filter and decodes up to size items into T,
+// reading pages of at most searchPageSize items.
+// The generated search request/response types carry neither filter nor items, so the payload is handled here.
+func Search[T any](ctx context.Context, filter map[string]any, size int32, do SearchDoer) ([]T, error) {
+	return SearchUntil[T](ctx, filter, nil, size, nil, do)
+}
+
+// SearchUntil is Search in the given sort order that ends early at the first item stop reports true for,
+// that item is not returned. With a sort on a time field this applies a time window before the size limit.
+func SearchUntil[T any](ctx context.Context, filter map[string]any, sort []SearchSort, size int32, stop func(T) bool, do SearchDoer) ([]T, error) {
+	if size <= 0 {
+		size = searchPageSize
+	}
+	var out []T
+	for from := int32(0); ; {
+		limit := min(size-int32(len(out)), searchPageSize)
+		items, err := searchPageOf[T](ctx, searchRequest{Filter: filter, Sort: sort, Page: searchPage{From: from, Limit: limit}}, do)
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range items {
+			if stop != nil && stop(it) {
+				return out, nil
+			}
+			out = append(out, it)
+		}
+		if int32(len(items)) < limit || int32(len(out)) >= size {
+			return out, nil
+		}
+		from += int32(len(items))
+	}
+}
+
+func searchPageOf[T any](ctx context.Context, req searchRequest, do SearchDoer) ([]T, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling search request: %w", err)
+	}
+	hr, body, err := do(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if err = HttpStatusErr(hr, body); err != nil {
+		return nil, err
+	}
+	var resp searchResponse[T]
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("%w: decoding search response: %v; body=%s", d.ErrMalformedResponse, err, string(body))
+	}
+	return resp.Items, nil
+}
+
+// PutIf sets key in the filter map only if v is not the zero value.
+func PutIf[T comparable](filter map[string]any, key string, v T) {
+	var zero T
+	if v != zero {
+		filter[key] = v
+	}
+}
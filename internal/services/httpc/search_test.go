@@ -0,0 +1,75 @@
+package httpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	N int `json:"n"`
+}
+
+// pagedDoer serves total items numbered from 0 and records the requested pages.
+func pagedDoer(total int, pages *[]map[string]any) httpc.SearchDoer {
+	return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+		var req struct {
+			Sort []httpc.SearchSort `json:"sort"`
+			Page struct {
+				From  int `json:"from"`
+				Limit int `json:"limit"`
+			} `json:"page"`
+		}
+		if err := json.NewDecoder(body).Decode(&req); err != nil {
+			return nil, nil, err
+		}
+		*pages = append(*pages, map[string]any{"from": req.Page.From, "limit": req.Page.Limit})
+		var items []item
+		for i := req.Page.From; i < total && i < req.Page.From+req.Page.Limit; i++ {
+			items = append(items, item{N: i})
+		}
+		b, _ := json.Marshal(map[string]any{"items": items})
+		rec := httptest.NewRecorder()
+		rec.WriteHeader(http.StatusOK)
+		hr := rec.Result()
+		hr.Request = httptest.NewRequest(http.MethodPost, "/v2/things/search", nil)
+		return hr, b, nil
+	}
+}
+
+func TestSearch_ReadsPages(t *testing.T) {
+	var pages []map[string]any
+	items, err := httpc.Search[item](context.Background(), nil, 2500, pagedDoer(2300, &pages))
+	require.NoError(t, err)
+	require.Len(t, items, 2300)
+	require.Equal(t, 2299, items[2299].N)
+	require.Equal(t, []map[string]any{
+		{"from": 0, "limit": 1000},
+		{"from": 1000, "limit": 1000},
+		{"from": 2000, "limit": 500},
+	}, pages)
+}
+
+func TestSearch_StopsAtSize(t *testing.T) {
+	var pages []map[string]any
+	items, err := httpc.Search[item](context.Background(), nil, 1001, pagedDoer(5000, &pages))
+	require.NoError(t, err)
+	require.Len(t, items, 1001)
+	require.Len(t, pages, 2)
+	require.Equal(t, 1, pages[1]["limit"])
+}
+
+func TestSearchUntil_Stop(t *testing.T) {
+	var pages []map[string]any
+	items, err := httpc.SearchUntil(context.Background(), nil, []httpc.SearchSort{{Field: "n"}}, 5000,
+		func(it item) bool { return it.N >= 1200 }, pagedDoer(5000, &pages))
+	require.NoError(t, err)
+	require.Len(t, items, 1200)
+	require.Len(t, pages, 2)
+}
@@ -58,7 +58,8 @@ func New(cfg *config.Config, log *slog.Logger, opts ...Option) (*Service, error)
 	if err != nil {
 		return nil, err
 	}
-	httpClient := &http.Client{Timeout: d, Transport: &LogTransport{Log: log, WithBody: false}}
+	transport := NewRetryTransport(&LogTransport{Log: log, WithBody: false}, cfg.App.Backoff, log)
+	httpClient := &http.Client{Timeout: d, Transport: transport}
 	s := &Service{c: httpClient, cfg: cfg, log: log}
 	for _, opt := range opts {
 		opt(s)
@@ -86,6 +87,15 @@ func (s *Service) InstallAuthEditor(ed authenticator.RequestEditor) {
 	s.c.Transport = &AuthTransport{base: s.c.Transport, Editor: ed}
 }
 
+// InstallAuthenticator installs the editor of a and, if a is a Refresher, retries requests once on 401.
+func (s *Service) InstallAuthenticator(a authenticator.Authenticator) {
+	t := &AuthTransport{base: s.c.Transport, Editor: a.Editor()}
+	if r, ok := a.(authenticator.Refresher); ok {
+		t.Invalidate = r.Invalidate
+	}
+	s.c.Transport = t
+}
+
 type ctxKey struct{}
 
 func (s *Service) ToContext(ctx context.Context) context.Context {
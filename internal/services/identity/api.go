@@ -0,0 +1,29 @@
+package identity
+
+import (
+	"context"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	v87 "github.com/grafvonb/kamunder/internal/services/identity/v87"
+	v88 "github.com/grafvonb/kamunder/internal/services/identity/v88"
+)
+
+type API interface {
+	SearchIdentities(ctx context.Context, kind d.IdentityKind, size int32, opts ...services.CallOption) ([]d.Identity, error)
+	GetIdentity(ctx context.Context, kind d.IdentityKind, id string, opts ...services.CallOption) (d.Identity, error)
+	CreateIdentity(ctx context.Context, x d.Identity, opts ...services.CallOption) (d.Identity, error)
+	UpdateIdentity(ctx context.Context, x d.Identity, opts ...services.CallOption) (d.Identity, error)
+	DeleteIdentity(ctx context.Context, kind d.IdentityKind, id string, opts ...services.CallOption) error
+	SearchIdentityMembers(ctx context.Context, owner d.Identity, kind d.IdentityKind, size int32, opts ...services.CallOption) ([]d.Identity, error)
+	AssignIdentity(ctx context.Context, owner d.Identity, member d.Identity, opts ...services.CallOption) error
+	UnassignIdentity(ctx context.Context, owner d.Identity, member d.Identity, opts ...services.CallOption) error
+	SearchAuthorizations(ctx context.Context, filter d.AuthorizationSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.Authorization, error)
+	GetAuthorization(ctx context.Context, key string, opts ...services.CallOption) (d.Authorization, error)
+	CreateAuthorization(ctx context.Context, a d.Authorization, opts ...services.CallOption) (d.Authorization, error)
+	UpdateAuthorization(ctx context.Context, a d.Authorization, opts ...services.CallOption) error
+	DeleteAuthorization(ctx context.Context, key string, opts ...services.CallOption) error
+}
+
+var _ API = (*v87.Service)(nil)
+var _ API = (*v88.Service)(nil)
@@ -0,0 +1,78 @@
+package v87
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/grafvonb/kamunder/config"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+)
+
+// Service is a stub, identity management through the orchestration cluster API is only available from camunda 8.8 on.
+type Service struct {
+	cfg *config.Config
+	log *slog.Logger
+}
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger) (*Service, error) {
+	return &Service{cfg: cfg, log: log}, nil
+}
+
+func (s *Service) SearchIdentities(ctx context.Context, kind d.IdentityKind, size int32, opts ...services.CallOption) ([]d.Identity, error) {
+	return nil, errNotSupported("searching " + string(kind) + "s")
+}
+
+func (s *Service) GetIdentity(ctx context.Context, kind d.IdentityKind, id string, opts ...services.CallOption) (d.Identity, error) {
+	return d.Identity{}, errNotSupported("getting " + string(kind) + "s")
+}
+
+func (s *Service) CreateIdentity(ctx context.Context, x d.Identity, opts ...services.CallOption) (d.Identity, error) {
+	return d.Identity{}, errNotSupported("creating " + string(x.Kind) + "s")
+}
+
+func (s *Service) UpdateIdentity(ctx context.Context, x d.Identity, opts ...services.CallOption) (d.Identity, error) {
+	return d.Identity{}, errNotSupported("updating " + string(x.Kind) + "s")
+}
+
+func (s *Service) DeleteIdentity(ctx context.Context, kind d.IdentityKind, id string, opts ...services.CallOption) error {
+	return errNotSupported("deleting " + string(kind) + "s")
+}
+
+func (s *Service) SearchIdentityMembers(ctx context.Context, owner d.Identity, kind d.IdentityKind, size int32, opts ...services.CallOption) ([]d.Identity, error) {
+	return nil, errNotSupported("searching " + string(owner.Kind) + " members")
+}
+
+func (s *Service) AssignIdentity(ctx context.Context, owner d.Identity, member d.Identity, opts ...services.CallOption) error {
+	return errNotSupported("assigning " + string(member.Kind) + "s")
+}
+
+func (s *Service) UnassignIdentity(ctx context.Context, owner d.Identity, member d.Identity, opts ...services.CallOption) error {
+	return errNotSupported("unassigning " + string(member.Kind) + "s")
+}
+
+func (s *Service) SearchAuthorizations(ctx context.Context, filter d.AuthorizationSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.Authorization, error) {
+	return nil, errNotSupported("searching authorizations")
+}
+
+func (s *Service) GetAuthorization(ctx context.Context, key string, opts ...services.CallOption) (d.Authorization, error) {
+	return d.Authorization{}, errNotSupported("getting authorizations")
+}
+
+func (s *Service) CreateAuthorization(ctx context.Context, a d.Authorization, opts ...services.CallOption) (d.Authorization, error) {
+	return d.Authorization{}, errNotSupported("creating authorizations")
+}
+
+func (s *Service) UpdateAuthorization(ctx context.Context, a d.Authorization, opts ...services.CallOption) error {
+	return errNotSupported("updating authorizations")
+}
+
+func (s *Service) DeleteAuthorization(ctx context.Context, key string, opts ...services.CallOption) error {
+	return errNotSupported("deleting authorizations")
+}
+
+func errNotSupported(what string) error {
+	return fmt.Errorf("%w: %s requires camunda 8.8", d.ErrNotSupported, what)
+}
@@ -0,0 +1,80 @@
+package v88
+
+import (
+	"context"
+	"io"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+)
+
+type GenIdentityClient interface {
+	SearchUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchUsersResponse, error)
+	GetUserWithResponse(ctx context.Context, username camundav88.Username, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetUserResponse, error)
+	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CreateUserResponse, error)
+	UpdateUserWithBodyWithResponse(ctx context.Context, username camundav88.Username, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UpdateUserResponse, error)
+	DeleteUserWithResponse(ctx context.Context, username camundav88.Username, reqEditors ...camundav88.RequestEditorFn) (*camundav88.DeleteUserResponse, error)
+	SearchGroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchGroupsResponse, error)
+	GetGroupWithResponse(ctx context.Context, groupId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetGroupResponse, error)
+	CreateGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CreateGroupResponse, error)
+	UpdateGroupWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UpdateGroupResponse, error)
+	DeleteGroupWithResponse(ctx context.Context, groupId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.DeleteGroupResponse, error)
+	SearchRolesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchRolesResponse, error)
+	GetRoleWithResponse(ctx context.Context, roleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetRoleResponse, error)
+	CreateRoleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CreateRoleResponse, error)
+	UpdateRoleWithBodyWithResponse(ctx context.Context, roleId string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UpdateRoleResponse, error)
+	DeleteRoleWithResponse(ctx context.Context, roleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.DeleteRoleResponse, error)
+	SearchTenantsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchTenantsResponse, error)
+	GetTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetTenantResponse, error)
+	CreateTenantWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CreateTenantResponse, error)
+	UpdateTenantWithBodyWithResponse(ctx context.Context, tenantId camundav88.TenantId, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UpdateTenantResponse, error)
+	DeleteTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, reqEditors ...camundav88.RequestEditorFn) (*camundav88.DeleteTenantResponse, error)
+	SearchMappingRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchMappingRuleResponse, error)
+	GetMappingRuleWithResponse(ctx context.Context, mappingRuleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetMappingRuleResponse, error)
+	CreateMappingRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CreateMappingRuleResponse, error)
+	UpdateMappingRuleWithBodyWithResponse(ctx context.Context, mappingRuleId string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UpdateMappingRuleResponse, error)
+	DeleteMappingRuleWithResponse(ctx context.Context, mappingRuleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.DeleteMappingRuleResponse, error)
+	SearchUsersForGroupWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchUsersForGroupResponse, error)
+	SearchClientsForGroupWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchClientsForGroupResponse, error)
+	SearchMappingRulesForGroupWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchMappingRulesForGroupResponse, error)
+	SearchRolesForGroupWithBodyWithResponse(ctx context.Context, groupId string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchRolesForGroupResponse, error)
+	SearchUsersForRoleWithBodyWithResponse(ctx context.Context, roleId string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchUsersForRoleResponse, error)
+	SearchGroupsForRoleWithBodyWithResponse(ctx context.Context, roleId string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchGroupsForRoleResponse, error)
+	SearchClientsForRoleWithBodyWithResponse(ctx context.Context, roleId string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchClientsForRoleResponse, error)
+	SearchMappingRulesForRoleWithBodyWithResponse(ctx context.Context, roleId string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchMappingRulesForRoleResponse, error)
+	SearchUsersForTenantWithBodyWithResponse(ctx context.Context, tenantId camundav88.TenantId, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchUsersForTenantResponse, error)
+	SearchGroupIdsForTenantWithBodyWithResponse(ctx context.Context, tenantId camundav88.TenantId, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchGroupIdsForTenantResponse, error)
+	SearchClientsForTenantWithBodyWithResponse(ctx context.Context, tenantId camundav88.TenantId, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchClientsForTenantResponse, error)
+	SearchMappingRulesForTenantWithBodyWithResponse(ctx context.Context, tenantId camundav88.TenantId, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchMappingRulesForTenantResponse, error)
+	SearchRolesForTenantWithBodyWithResponse(ctx context.Context, tenantId camundav88.TenantId, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchRolesForTenantResponse, error)
+	AssignUserToGroupWithResponse(ctx context.Context, groupId string, username camundav88.Username, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignUserToGroupResponse, error)
+	UnassignUserFromGroupWithResponse(ctx context.Context, groupId string, username camundav88.Username, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignUserFromGroupResponse, error)
+	AssignClientToGroupWithResponse(ctx context.Context, groupId string, clientId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignClientToGroupResponse, error)
+	UnassignClientFromGroupWithResponse(ctx context.Context, groupId string, clientId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignClientFromGroupResponse, error)
+	AssignMappingRuleToGroupWithResponse(ctx context.Context, groupId string, mappingRuleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignMappingRuleToGroupResponse, error)
+	UnassignMappingRuleFromGroupWithResponse(ctx context.Context, groupId string, mappingRuleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignMappingRuleFromGroupResponse, error)
+	AssignRoleToUserWithResponse(ctx context.Context, roleId string, username camundav88.Username, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignRoleToUserResponse, error)
+	UnassignRoleFromUserWithResponse(ctx context.Context, roleId string, username camundav88.Username, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignRoleFromUserResponse, error)
+	AssignRoleToGroupWithResponse(ctx context.Context, roleId string, groupId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignRoleToGroupResponse, error)
+	UnassignRoleFromGroupWithResponse(ctx context.Context, roleId string, groupId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignRoleFromGroupResponse, error)
+	AssignRoleToClientWithResponse(ctx context.Context, roleId string, clientId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignRoleToClientResponse, error)
+	UnassignRoleFromClientWithResponse(ctx context.Context, roleId string, clientId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignRoleFromClientResponse, error)
+	AssignRoleToMappingRuleWithResponse(ctx context.Context, roleId string, mappingRuleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignRoleToMappingRuleResponse, error)
+	UnassignRoleFromMappingRuleWithResponse(ctx context.Context, roleId string, mappingRuleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignRoleFromMappingRuleResponse, error)
+	AssignUserToTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, username camundav88.Username, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignUserToTenantResponse, error)
+	UnassignUserFromTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, username camundav88.Username, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignUserFromTenantResponse, error)
+	AssignGroupToTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, groupId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignGroupToTenantResponse, error)
+	UnassignGroupFromTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, groupId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignGroupFromTenantResponse, error)
+	AssignClientToTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, clientId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignClientToTenantResponse, error)
+	UnassignClientFromTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, clientId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignClientFromTenantResponse, error)
+	AssignMappingRuleToTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, mappingRuleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignMappingRuleToTenantResponse, error)
+	UnassignMappingRuleFromTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, mappingRuleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignMappingRuleFromTenantResponse, error)
+	AssignRoleToTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, roleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.AssignRoleToTenantResponse, error)
+	UnassignRoleFromTenantWithResponse(ctx context.Context, tenantId camundav88.TenantId, roleId string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UnassignRoleFromTenantResponse, error)
+	SearchAuthorizationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchAuthorizationsResponse, error)
+	GetAuthorizationWithResponse(ctx context.Context, authorizationKey string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetAuthorizationResponse, error)
+	CreateAuthorizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CreateAuthorizationResponse, error)
+	UpdateAuthorizationWithBodyWithResponse(ctx context.Context, authorizationKey string, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UpdateAuthorizationResponse, error)
+	DeleteAuthorizationWithResponse(ctx context.Context, authorizationKey string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.DeleteAuthorizationResponse, error)
+}
+
+var _ GenIdentityClient = (*camundav88.ClientWithResponses)(nil)
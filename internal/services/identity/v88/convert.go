@@ -0,0 +1,123 @@
+package v88
+
+import (
+	"fmt"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+// identityItem covers the fields of all identity results (users, groups, roles, tenants,
+// mapping rules and clients), which differ only in the name of their id field.
+type identityItem struct {
+	Username      string `json:"username"`
+	GroupId       string `json:"groupId"`
+	RoleId        string `json:"roleId"`
+	TenantId      string `json:"tenantId"`
+	MappingRuleId string `json:"mappingRuleId"`
+	ClientId      string `json:"clientId"`
+	Name          string `json:"name"`
+	Email         string `json:"email"`
+	Description   string `json:"description"`
+	ClaimName     string `json:"claimName"`
+	ClaimValue    string `json:"claimValue"`
+}
+
+func (x identityItem) toDomain(kind d.IdentityKind) d.Identity {
+	return d.Identity{
+		Kind:        kind,
+		Id:          x.id(kind),
+		Name:        x.Name,
+		Email:       x.Email,
+		Description: x.Description,
+		ClaimName:   x.ClaimName,
+		ClaimValue:  x.ClaimValue,
+	}
+}
+
+func (x identityItem) id(kind d.IdentityKind) string {
+	switch kind {
+	case d.IdentityUser:
+		return x.Username
+	case d.IdentityGroup:
+		return x.GroupId
+	case d.IdentityRole:
+		return x.RoleId
+	case d.IdentityTenant:
+		return x.TenantId
+	case d.IdentityMappingRule:
+		return x.MappingRuleId
+	case d.IdentityClient:
+		return x.ClientId
+	}
+	return ""
+}
+
+// idField returns the JSON name of the id of the given kind.
+func idField(kind d.IdentityKind) string {
+	switch kind {
+	case d.IdentityUser:
+		return "username"
+	case d.IdentityMappingRule:
+		return "mappingRuleId"
+	default:
+		return string(kind) + "Id"
+	}
+}
+
+// toIdentityBody builds the create (with id) or update (without id) request body for x.
+func toIdentityBody(x d.Identity, create bool) map[string]any {
+	b := map[string]any{"name": x.Name}
+	if create {
+		b[idField(x.Kind)] = x.Id
+	}
+	switch x.Kind {
+	case d.IdentityUser:
+		if x.Email != "" {
+			b["email"] = x.Email
+		}
+		if x.Password != "" || create {
+			b["password"] = x.Password
+		}
+	case d.IdentityMappingRule:
+		b["claimName"] = x.ClaimName
+		b["claimValue"] = x.ClaimValue
+	default:
+		b["description"] = x.Description
+	}
+	return b
+}
+
+func fromAuthorizationResult(r camundav88.AuthorizationResult) d.Authorization {
+	return d.Authorization{
+		Key:          toolx.Deref(r.AuthorizationKey, ""),
+		OwnerId:      toolx.Deref(r.OwnerId, ""),
+		OwnerType:    string(toolx.Deref(r.OwnerType, "")),
+		ResourceType: string(toolx.Deref(r.ResourceType, "")),
+		ResourceId:   toolx.Deref(r.ResourceId, ""),
+		Permissions:  toolx.DerefSlicePtr(r.PermissionTypes, func(p camundav88.PermissionTypeEnum) string { return string(p) }),
+	}
+}
+
+func toAuthorizationBody(a d.Authorization) map[string]any {
+	permissions := a.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return map[string]any{
+		"ownerId":         a.OwnerId,
+		"ownerType":       a.OwnerType,
+		"resourceType":    a.ResourceType,
+		"resourceId":      a.ResourceId,
+		"permissionTypes": permissions,
+	}
+}
+
+func errUnsupportedKind(what string, kind d.IdentityKind) error {
+	return fmt.Errorf("%w: %s %q is not supported", d.ErrBadRequest, what, kind)
+}
+
+func errUnsupportedMembership(what string, owner, member d.IdentityKind) error {
+	return fmt.Errorf("%w: %s a %s to a %s is not supported", d.ErrBadRequest, what, member, owner)
+}
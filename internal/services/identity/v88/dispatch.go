@@ -0,0 +1,475 @@
+package v88
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+)
+
+// The generated client has one method per identity kind and per membership, the helpers below
+// route a kind (or owner/member pair) to the matching call and return the raw response.
+
+// membership is an owner/member kind pair, e.g. a user (member) in a group (owner).
+type membership struct {
+	owner  d.IdentityKind
+	member d.IdentityKind
+}
+
+func (s *Service) searchDoer(kind d.IdentityKind) httpc.SearchDoer {
+	switch kind {
+	case d.IdentityUser:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchUsersWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case d.IdentityGroup:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchGroupsWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case d.IdentityRole:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchRolesWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case d.IdentityTenant:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchTenantsWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case d.IdentityMappingRule:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchMappingRuleWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	}
+	return nil
+}
+
+func (s *Service) get(ctx context.Context, kind d.IdentityKind, id string) (*http.Response, []byte, error) {
+	switch kind {
+	case d.IdentityUser:
+		resp, err := s.c.GetUserWithResponse(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityGroup:
+		resp, err := s.c.GetGroupWithResponse(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityRole:
+		resp, err := s.c.GetRoleWithResponse(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityTenant:
+		resp, err := s.c.GetTenantWithResponse(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityMappingRule:
+		resp, err := s.c.GetMappingRuleWithResponse(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	}
+	return nil, nil, errUnsupportedKind("getting", kind)
+}
+
+func (s *Service) create(ctx context.Context, kind d.IdentityKind, body io.Reader) (*http.Response, []byte, error) {
+	switch kind {
+	case d.IdentityUser:
+		resp, err := s.c.CreateUserWithBodyWithResponse(ctx, "application/json", body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityGroup:
+		resp, err := s.c.CreateGroupWithBodyWithResponse(ctx, "application/json", body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityRole:
+		resp, err := s.c.CreateRoleWithBodyWithResponse(ctx, "application/json", body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityTenant:
+		resp, err := s.c.CreateTenantWithBodyWithResponse(ctx, "application/json", body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityMappingRule:
+		resp, err := s.c.CreateMappingRuleWithBodyWithResponse(ctx, "application/json", body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	}
+	return nil, nil, errUnsupportedKind("creating", kind)
+}
+
+func (s *Service) update(ctx context.Context, kind d.IdentityKind, id string, body io.Reader) (*http.Response, []byte, error) {
+	switch kind {
+	case d.IdentityUser:
+		resp, err := s.c.UpdateUserWithBodyWithResponse(ctx, id, "application/json", body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityGroup:
+		resp, err := s.c.UpdateGroupWithBodyWithResponse(ctx, id, "application/json", body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityRole:
+		resp, err := s.c.UpdateRoleWithBodyWithResponse(ctx, id, "application/json", body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityTenant:
+		resp, err := s.c.UpdateTenantWithBodyWithResponse(ctx, id, "application/json", body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityMappingRule:
+		resp, err := s.c.UpdateMappingRuleWithBodyWithResponse(ctx, id, "application/json", body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	}
+	return nil, nil, errUnsupportedKind("updating", kind)
+}
+
+func (s *Service) delete(ctx context.Context, kind d.IdentityKind, id string) (*http.Response, []byte, error) {
+	switch kind {
+	case d.IdentityUser:
+		resp, err := s.c.DeleteUserWithResponse(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityGroup:
+		resp, err := s.c.DeleteGroupWithResponse(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityRole:
+		resp, err := s.c.DeleteRoleWithResponse(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityTenant:
+		resp, err := s.c.DeleteTenantWithResponse(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case d.IdentityMappingRule:
+		resp, err := s.c.DeleteMappingRuleWithResponse(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	}
+	return nil, nil, errUnsupportedKind("deleting", kind)
+}
+
+func (s *Service) membersDoer(ownerKind d.IdentityKind, owner string, kind d.IdentityKind) httpc.SearchDoer {
+	switch (membership{ownerKind, kind}) {
+	case membership{d.IdentityGroup, d.IdentityUser}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchUsersForGroupWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityGroup, d.IdentityClient}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchClientsForGroupWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityGroup, d.IdentityMappingRule}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchMappingRulesForGroupWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityGroup, d.IdentityRole}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchRolesForGroupWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityRole, d.IdentityUser}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchUsersForRoleWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityRole, d.IdentityGroup}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchGroupsForRoleWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityRole, d.IdentityClient}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchClientsForRoleWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityRole, d.IdentityMappingRule}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchMappingRulesForRoleWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityTenant, d.IdentityUser}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchUsersForTenantWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityTenant, d.IdentityGroup}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchGroupIdsForTenantWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityTenant, d.IdentityClient}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchClientsForTenantWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityTenant, d.IdentityMappingRule}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchMappingRulesForTenantWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	case membership{d.IdentityTenant, d.IdentityRole}:
+		return func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchRolesForTenantWithBodyWithResponse(ctx, owner, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		}
+	}
+	return nil
+}
+
+func (s *Service) assign(ctx context.Context, owner d.Identity, member d.Identity) (*http.Response, []byte, error) {
+	switch (membership{owner.Kind, member.Kind}) {
+	case membership{d.IdentityGroup, d.IdentityUser}:
+		resp, err := s.c.AssignUserToGroupWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityGroup, d.IdentityClient}:
+		resp, err := s.c.AssignClientToGroupWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityGroup, d.IdentityMappingRule}:
+		resp, err := s.c.AssignMappingRuleToGroupWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityRole, d.IdentityUser}:
+		resp, err := s.c.AssignRoleToUserWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityRole, d.IdentityGroup}:
+		resp, err := s.c.AssignRoleToGroupWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityRole, d.IdentityClient}:
+		resp, err := s.c.AssignRoleToClientWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityRole, d.IdentityMappingRule}:
+		resp, err := s.c.AssignRoleToMappingRuleWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityTenant, d.IdentityUser}:
+		resp, err := s.c.AssignUserToTenantWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityTenant, d.IdentityGroup}:
+		resp, err := s.c.AssignGroupToTenantWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityTenant, d.IdentityClient}:
+		resp, err := s.c.AssignClientToTenantWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityTenant, d.IdentityMappingRule}:
+		resp, err := s.c.AssignMappingRuleToTenantWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityTenant, d.IdentityRole}:
+		resp, err := s.c.AssignRoleToTenantWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	}
+	return nil, nil, errUnsupportedMembership("assigning", owner.Kind, member.Kind)
+}
+
+func (s *Service) unassign(ctx context.Context, owner d.Identity, member d.Identity) (*http.Response, []byte, error) {
+	switch (membership{owner.Kind, member.Kind}) {
+	case membership{d.IdentityGroup, d.IdentityUser}:
+		resp, err := s.c.UnassignUserFromGroupWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityGroup, d.IdentityClient}:
+		resp, err := s.c.UnassignClientFromGroupWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityGroup, d.IdentityMappingRule}:
+		resp, err := s.c.UnassignMappingRuleFromGroupWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityRole, d.IdentityUser}:
+		resp, err := s.c.UnassignRoleFromUserWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityRole, d.IdentityGroup}:
+		resp, err := s.c.UnassignRoleFromGroupWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityRole, d.IdentityClient}:
+		resp, err := s.c.UnassignRoleFromClientWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityRole, d.IdentityMappingRule}:
+		resp, err := s.c.UnassignRoleFromMappingRuleWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityTenant, d.IdentityUser}:
+		resp, err := s.c.UnassignUserFromTenantWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityTenant, d.IdentityGroup}:
+		resp, err := s.c.UnassignGroupFromTenantWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityTenant, d.IdentityClient}:
+		resp, err := s.c.UnassignClientFromTenantWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityTenant, d.IdentityMappingRule}:
+		resp, err := s.c.UnassignMappingRuleFromTenantWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	case membership{d.IdentityTenant, d.IdentityRole}:
+		resp, err := s.c.UnassignRoleFromTenantWithResponse(ctx, owner.Id, member.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.HTTPResponse, resp.Body, nil
+	}
+	return nil, nil, errUnsupportedMembership("unassigning", owner.Kind, member.Kind)
+}
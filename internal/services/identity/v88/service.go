@@ -0,0 +1,255 @@
+package v88
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type Service struct {
+	c   GenIdentityClient
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+//nolint:unused
+func WithClient(c GenIdentityClient) Option { return func(s *Service) { s.c = c } }
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	c, err := camundav88.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav88.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{c: c, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) SearchIdentities(ctx context.Context, kind d.IdentityKind, size int32, opts ...services.CallOption) ([]d.Identity, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for %ss", kind))
+	do := s.searchDoer(kind)
+	if do == nil {
+		return nil, errUnsupportedKind("searching", kind)
+	}
+	items, err := httpc.Search[identityItem](ctx, map[string]any{}, size, do)
+	if err != nil {
+		return nil, err
+	}
+	return toolx.MapSlice(items, func(x identityItem) d.Identity { return x.toDomain(kind) }), nil
+}
+
+func (s *Service) GetIdentity(ctx context.Context, kind d.IdentityKind, id string, opts ...services.CallOption) (d.Identity, error) {
+	_ = services.ApplyCallOptions(opts)
+	hr, body, err := s.get(ctx, kind, id)
+	if err != nil {
+		return d.Identity{}, err
+	}
+	return decodeIdentity(kind, hr, body)
+}
+
+func (s *Service) CreateIdentity(ctx context.Context, x d.Identity, opts ...services.CallOption) (d.Identity, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("creating %s %s", x.Kind, x.Id))
+	b, err := json.Marshal(toIdentityBody(x, true))
+	if err != nil {
+		return d.Identity{}, fmt.Errorf("marshalling %s create request: %w", x.Kind, err)
+	}
+	hr, body, err := s.create(ctx, x.Kind, bytes.NewReader(b))
+	if err != nil {
+		return d.Identity{}, err
+	}
+	return decodeIdentity(x.Kind, hr, body)
+}
+
+// UpdateIdentity replaces name and the kind specific attributes of the identity given by x.Kind and x.Id.
+func (s *Service) UpdateIdentity(ctx context.Context, x d.Identity, opts ...services.CallOption) (d.Identity, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("updating %s %s", x.Kind, x.Id))
+	b, err := json.Marshal(toIdentityBody(x, false))
+	if err != nil {
+		return d.Identity{}, fmt.Errorf("marshalling %s update request: %w", x.Kind, err)
+	}
+	hr, body, err := s.update(ctx, x.Kind, x.Id, bytes.NewReader(b))
+	if err != nil {
+		return d.Identity{}, err
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		if err = httpc.HttpStatusErr(hr, body); err != nil {
+			return d.Identity{}, err
+		}
+		x.Password = ""
+		return x, nil
+	}
+	return decodeIdentity(x.Kind, hr, body)
+}
+
+func (s *Service) DeleteIdentity(ctx context.Context, kind d.IdentityKind, id string, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("deleting %s %s", kind, id))
+	hr, body, err := s.delete(ctx, kind, id)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(hr, body)
+}
+
+// SearchIdentityMembers lists the identities of the given kind assigned to owner, e.g. the users of a group.
+func (s *Service) SearchIdentityMembers(ctx context.Context, owner d.Identity, kind d.IdentityKind, size int32, opts ...services.CallOption) ([]d.Identity, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for %ss of %s %s", kind, owner.Kind, owner.Id))
+	do := s.membersDoer(owner.Kind, owner.Id, kind)
+	if do == nil {
+		return nil, errUnsupportedMembership("searching", owner.Kind, kind)
+	}
+	items, err := httpc.Search[identityItem](ctx, map[string]any{}, size, do)
+	if err != nil {
+		return nil, err
+	}
+	return toolx.MapSlice(items, func(x identityItem) d.Identity { return x.toDomain(kind) }), nil
+}
+
+func (s *Service) AssignIdentity(ctx context.Context, owner d.Identity, member d.Identity, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	owner, member = canonicalMembership(owner, member)
+	s.log.Debug(fmt.Sprintf("assigning %s %s to %s %s", member.Kind, member.Id, owner.Kind, owner.Id))
+	hr, body, err := s.assign(ctx, owner, member)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(hr, body)
+}
+
+func (s *Service) UnassignIdentity(ctx context.Context, owner d.Identity, member d.Identity, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	owner, member = canonicalMembership(owner, member)
+	s.log.Debug(fmt.Sprintf("unassigning %s %s from %s %s", member.Kind, member.Id, owner.Kind, owner.Id))
+	hr, body, err := s.unassign(ctx, owner, member)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(hr, body)
+}
+
+func (s *Service) SearchAuthorizations(ctx context.Context, filter d.AuthorizationSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.Authorization, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for authorizations with filter: %+v", filter))
+	f := map[string]any{}
+	httpc.PutIf(f, "ownerId", filter.OwnerId)
+	httpc.PutIf(f, "ownerType", filter.OwnerType)
+	httpc.PutIf(f, "resourceType", filter.ResourceType)
+	if filter.ResourceId != "" {
+		f["resourceIds"] = []string{filter.ResourceId}
+	}
+	items, err := httpc.Search[camundav88.AuthorizationResult](ctx, f, size,
+		func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchAuthorizationsWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return toolx.MapSlice(items, fromAuthorizationResult), nil
+}
+
+func (s *Service) GetAuthorization(ctx context.Context, key string, opts ...services.CallOption) (d.Authorization, error) {
+	_ = services.ApplyCallOptions(opts)
+	resp, err := s.c.GetAuthorizationWithResponse(ctx, key)
+	if err != nil {
+		return d.Authorization{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.Authorization{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.Authorization{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s", d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromAuthorizationResult(*resp.JSON200), nil
+}
+
+func (s *Service) CreateAuthorization(ctx context.Context, a d.Authorization, opts ...services.CallOption) (d.Authorization, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("creating authorization: %+v", a))
+	b, err := json.Marshal(toAuthorizationBody(a))
+	if err != nil {
+		return d.Authorization{}, fmt.Errorf("marshalling authorization create request: %w", err)
+	}
+	resp, err := s.c.CreateAuthorizationWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return d.Authorization{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.Authorization{}, err
+	}
+	if resp.JSON201 == nil || resp.JSON201.AuthorizationKey == nil {
+		return d.Authorization{}, fmt.Errorf("%w: 201 Created but empty payload; body=%s", d.ErrMalformedResponse, string(resp.Body))
+	}
+	a.Key = *resp.JSON201.AuthorizationKey
+	return a, nil
+}
+
+// UpdateAuthorization replaces owner, resource and permissions of the authorization given by a.Key.
+func (s *Service) UpdateAuthorization(ctx context.Context, a d.Authorization, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("updating authorization %s", a.Key))
+	b, err := json.Marshal(toAuthorizationBody(a))
+	if err != nil {
+		return fmt.Errorf("marshalling authorization update request: %w", err)
+	}
+	resp, err := s.c.UpdateAuthorizationWithBodyWithResponse(ctx, a.Key, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) DeleteAuthorization(ctx context.Context, key string, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("deleting authorization %s", key))
+	resp, err := s.c.DeleteAuthorizationWithResponse(ctx, key)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func decodeIdentity(kind d.IdentityKind, hr *http.Response, body []byte) (d.Identity, error) {
+	if err := httpc.HttpStatusErr(hr, body); err != nil {
+		return d.Identity{}, err
+	}
+	var x identityItem
+	if err := json.Unmarshal(body, &x); err != nil || x.id(kind) == "" {
+		return d.Identity{}, fmt.Errorf("%w: %d but no %s in payload; body=%s", d.ErrMalformedResponse, hr.StatusCode, kind, string(body))
+	}
+	return x.toDomain(kind), nil
+}
+
+// canonicalMembership turns owner and member around where the API models the relation the other way:
+// a role "in" a group is the group assigned to the role.
+func canonicalMembership(owner, member d.Identity) (d.Identity, d.Identity) {
+	if owner.Kind == d.IdentityGroup && member.Kind == d.IdentityRole {
+		return member, owner
+	}
+	return owner, member
+}
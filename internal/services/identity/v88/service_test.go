@@ -0,0 +1,87 @@
+package v88
+
+import (
+	"testing"
+	"time"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Internal_Identity_v88_GetIdentity_User_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	u, err := svc.GetIdentity(ctx, d.IdentityUser, "alice")
+	require.NoError(t, err)
+	require.Equal(t, d.Identity{Kind: d.IdentityUser, Id: "alice", Name: "Alice", Email: "alice@example.com"}, u)
+}
+
+func Test_Internal_Identity_v88_CreateIdentity_Group_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	g, err := svc.CreateIdentity(ctx, d.Identity{Kind: d.IdentityGroup, Id: "ops", Name: "Operations", Description: "Operations team"})
+	require.NoError(t, err)
+	require.Equal(t, "ops", g.Id)
+	require.Equal(t, d.IdentityGroup, g.Kind)
+	require.Equal(t, "Operations team", g.Description)
+}
+
+func Test_Internal_Identity_v88_SearchIdentityMembers_GroupUsers_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	users, err := svc.SearchIdentityMembers(ctx, d.Identity{Kind: d.IdentityGroup, Id: "ops"}, d.IdentityUser, 10)
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	require.Equal(t, "alice", users[0].Id)
+	require.Equal(t, d.IdentityUser, users[1].Kind)
+
+	_, err = svc.SearchIdentityMembers(ctx, d.Identity{Kind: d.IdentityUser, Id: "alice"}, d.IdentityGroup, 10)
+	require.ErrorIs(t, err, d.ErrBadRequest)
+}
+
+func Test_Internal_Identity_v88_SearchAuthorizations_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	as, err := svc.SearchAuthorizations(ctx, d.AuthorizationSearchFilterOpts{OwnerId: "ops", OwnerType: "GROUP"}, 10)
+	require.NoError(t, err)
+	require.Len(t, as, 1)
+	require.Equal(t, "2251799813690501", as[0].Key)
+	require.Equal(t, []string{"READ_PROCESS_INSTANCE", "UPDATE_PROCESS_INSTANCE"}, as[0].Permissions)
+}
@@ -0,0 +1,19 @@
+package incident
+
+import (
+	"context"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	v87 "github.com/grafvonb/kamunder/internal/services/incident/v87"
+	v88 "github.com/grafvonb/kamunder/internal/services/incident/v88"
+)
+
+type API interface {
+	SearchIncidents(ctx context.Context, filter d.IncidentSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.Incident, error)
+	ResolveIncidents(ctx context.Context, filter d.IncidentSearchFilterOpts, size int32, opts ...services.CallOption) (d.IncidentResolution, error)
+	ProcessInstanceHasIncident(ctx context.Context, key string, opts ...services.CallOption) (bool, error)
+}
+
+var _ API = (*v87.Service)(nil)
+var _ API = (*v88.Service)(nil)
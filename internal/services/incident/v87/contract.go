@@ -0,0 +1,21 @@
+package v87
+
+import (
+	"context"
+	"io"
+
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+	operatev87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/operate"
+)
+
+type GenIncidentClientCamunda interface {
+	PostIncidentsSearchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PostIncidentsSearchResponse, error)
+	PostIncidentsIncidentKeyResolutionWithResponse(ctx context.Context, incidentKey string, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PostIncidentsIncidentKeyResolutionResponse, error)
+}
+
+type GenIncidentClientOperate interface {
+	GetProcessInstanceByKeyWithResponse(ctx context.Context, key int64, reqEditors ...operatev87.RequestEditorFn) (*operatev87.GetProcessInstanceByKeyResponse, error)
+}
+
+var _ GenIncidentClientCamunda = (*camundav87.ClientWithResponses)(nil)
+var _ GenIncidentClientOperate = (*operatev87.ClientWithResponses)(nil)
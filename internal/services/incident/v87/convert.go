@@ -0,0 +1,41 @@
+package v87
+
+import (
+	"encoding/json"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+)
+
+// incidentItem mirrors the 8.7 incident search items; the generated types drop all keys.
+// Keys are returned as strings or numbers depending on the accepted media type, json.Number handles both.
+type incidentItem struct {
+	IncidentKey          json.Number `json:"incidentKey"`
+	ProcessInstanceKey   json.Number `json:"processInstanceKey"`
+	ProcessDefinitionKey json.Number `json:"processDefinitionKey"`
+	ProcessDefinitionId  string      `json:"processDefinitionId"`
+	ErrorType            string      `json:"errorType"`
+	ErrorMessage         string      `json:"errorMessage"`
+	ElementId            string      `json:"elementId"`
+	ElementInstanceKey   json.Number `json:"elementInstanceKey"`
+	JobKey               json.Number `json:"jobKey"`
+	State                string      `json:"state"`
+	CreationTime         string      `json:"creationTime"`
+	TenantId             string      `json:"tenantId"`
+}
+
+func fromIncidentItem(r incidentItem) d.Incident {
+	return d.Incident{
+		Key:                  r.IncidentKey.String(),
+		ProcessInstanceKey:   r.ProcessInstanceKey.String(),
+		ProcessDefinitionKey: r.ProcessDefinitionKey.String(),
+		ProcessDefinitionId:  r.ProcessDefinitionId,
+		ErrorType:            r.ErrorType,
+		ErrorMessage:         r.ErrorMessage,
+		ElementId:            r.ElementId,
+		ElementInstanceKey:   r.ElementInstanceKey.String(),
+		JobKey:               r.JobKey.String(),
+		State:                r.State,
+		CreationTime:         r.CreationTime,
+		TenantId:             r.TenantId,
+	}
+}
@@ -0,0 +1,139 @@
+package v87
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+	operatev87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/operate"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/common"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/internal/services/incident/waiter"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type Service struct {
+	cc  GenIncidentClientCamunda
+	oc  GenIncidentClientOperate
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	cc, err := camundav87.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav87.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	co, err := operatev87.NewClientWithResponses(
+		cfg.APIs.Operate.BaseURL,
+		operatev87.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{cc: cc, oc: co, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// SearchIncidents searches incidents; 8.7 only supports exact creation times, so Since is applied client-side
+// by reading the newest incidents first and stopping at the first one created before it.
+func (s *Service) SearchIncidents(ctx context.Context, filter d.IncidentSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.Incident, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for incidents with filter: %+v", filter))
+	f := map[string]any{}
+	httpc.PutIf(f, "processDefinitionId", filter.ProcessDefinitionId)
+	httpc.PutIf(f, "processInstanceKey", filter.ProcessInstanceKey)
+	httpc.PutIf(f, "errorType", filter.ErrorType)
+	httpc.PutIf(f, "state", filter.State)
+	httpc.PutIf(f, "tenantId", s.cfg.App.Tenant)
+	var sort []httpc.SearchSort
+	var stop func(incidentItem) bool
+	if !filter.Since.IsZero() {
+		sort = []httpc.SearchSort{{Field: "creationTime", Order: "DESC"}}
+		stop = func(it incidentItem) bool {
+			created, err := time.Parse(time.RFC3339, it.CreationTime)
+			return err == nil && created.Before(filter.Since)
+		}
+	}
+	items, err := httpc.SearchUntil[incidentItem](ctx, f, sort, size, stop,
+		func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.cc.PostIncidentsSearchWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return toolx.MapSlice(items, fromIncidentItem), nil
+}
+
+// ResolveIncidents resolves the incidents matching the filter one by one from the client, 8.7 has no batch operations.
+// With the Wait call option it then waits until the affected process instances no longer report an incident.
+func (s *Service) ResolveIncidents(ctx context.Context, filter d.IncidentSearchFilterOpts, size int32, opts ...services.CallOption) (d.IncidentResolution, error) {
+	cCfg := services.ApplyCallOptions(opts)
+	filter.State = "ACTIVE"
+	incidents, err := s.SearchIncidents(ctx, filter, size+1)
+	if err != nil {
+		return d.IncidentResolution{}, err
+	}
+	incidents, truncated := common.Truncate(incidents, size)
+	res := d.IncidentResolution{Incidents: len(incidents), Truncated: truncated, ProcessInstanceKeys: common.DistinctKeys(incidents, func(in d.Incident) string { return in.ProcessInstanceKey })}
+	if len(incidents) == 0 {
+		return res, nil
+	}
+
+	s.log.Info(fmt.Sprintf("resolving %d incident(s) of %d process instance(s) client-side", res.Incidents, len(res.ProcessInstanceKeys)))
+	results := common.RunBulk(ctx, incidents, 0, func(ctx context.Context, in d.Incident) error {
+		resp, err := s.cc.PostIncidentsIncidentKeyResolutionWithResponse(ctx, in.Key)
+		if err != nil {
+			return err
+		}
+		return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+	})
+	for _, r := range results {
+		if r.Err != nil {
+			res.Failures = append(res.Failures, d.IncidentResolutionFailure{IncidentKey: r.Item.Key, ProcessInstanceKey: r.Item.ProcessInstanceKey, Error: r.Err.Error()})
+		}
+	}
+	if !cCfg.Wait {
+		return res, nil
+	}
+	res.Pending, err = waiter.WaitUntilNoIncidents(ctx, s, s.cfg, s.log, res.ResolvedProcessInstanceKeys())
+	return res, err
+}
+
+func (s *Service) ProcessInstanceHasIncident(ctx context.Context, key string, opts ...services.CallOption) (bool, error) {
+	_ = services.ApplyCallOptions(opts)
+	oldKey, err := toolx.StringToInt64(key)
+	if err != nil {
+		return false, fmt.Errorf("converting process instance key %q to int64: %w", key, err)
+	}
+	resp, err := s.oc.GetProcessInstanceByKeyWithResponse(ctx, oldKey)
+	if err != nil {
+		return false, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return false, err
+	}
+	if resp.JSON200 == nil {
+		return false, fmt.Errorf("%w: 200 OK but empty payload; body=%s", d.ErrMalformedResponse, string(resp.Body))
+	}
+	return toolx.Deref(resp.JSON200.Incident, false), nil
+}
@@ -0,0 +1,16 @@
+package v88
+
+import (
+	"context"
+	"io"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+)
+
+type GenIncidentClient interface {
+	SearchIncidentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchIncidentsResponse, error)
+	ResolveIncidentsBatchOperationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.ResolveIncidentsBatchOperationResponse, error)
+	GetProcessInstanceWithResponse(ctx context.Context, processInstanceKey string, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetProcessInstanceResponse, error)
+}
+
+var _ GenIncidentClient = (*camundav88.ClientWithResponses)(nil)
@@ -0,0 +1,30 @@
+package v88
+
+import (
+	"time"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromIncidentResult(r camundav88.IncidentResult) d.Incident {
+	var created string
+	if r.CreationTime != nil {
+		created = r.CreationTime.Format(time.RFC3339)
+	}
+	return d.Incident{
+		Key:                  toolx.Deref(r.IncidentKey, ""),
+		ProcessInstanceKey:   toolx.Deref(r.ProcessInstanceKey, ""),
+		ProcessDefinitionKey: toolx.Deref(r.ProcessDefinitionKey, ""),
+		ProcessDefinitionId:  toolx.Deref(r.ProcessDefinitionId, ""),
+		ErrorType:            string(toolx.Deref(r.ErrorType, "")),
+		ErrorMessage:         toolx.Deref(r.ErrorMessage, ""),
+		ElementId:            toolx.Deref(r.ElementId, ""),
+		ElementInstanceKey:   toolx.Deref(r.ElementInstanceKey, ""),
+		JobKey:               toolx.Deref(r.JobKey, ""),
+		State:                string(toolx.Deref(r.State, "")),
+		CreationTime:         created,
+		TenantId:             toolx.Deref(r.TenantId, ""),
+	}
+}
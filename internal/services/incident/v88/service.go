@@ -0,0 +1,152 @@
+package v88
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	bv88 "github.com/grafvonb/kamunder/internal/services/batch/v88"
+	"github.com/grafvonb/kamunder/internal/services/common"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/internal/services/incident/waiter"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type Service struct {
+	c   GenIncidentClient
+	b   *bv88.Service
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+//nolint:unused
+func WithClient(c GenIncidentClient) Option { return func(s *Service) { s.c = c } }
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	c, err := camundav88.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav88.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	b, err := bv88.New(cfg, httpClient, log)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{c: c, b: b, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) SearchIncidents(ctx context.Context, filter d.IncidentSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.Incident, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for incidents with filter: %+v", filter))
+	f := map[string]any{}
+	httpc.PutIf(f, "processDefinitionId", filter.ProcessDefinitionId)
+	httpc.PutIf(f, "processInstanceKey", filter.ProcessInstanceKey)
+	httpc.PutIf(f, "errorType", filter.ErrorType)
+	httpc.PutIf(f, "state", filter.State)
+	httpc.PutIf(f, "tenantId", s.cfg.App.Tenant)
+	if !filter.Since.IsZero() {
+		f["creationTime"] = map[string]any{"$gte": filter.Since.UTC().Format(time.RFC3339)}
+	}
+	items, err := httpc.Search[camundav88.IncidentResult](ctx, f, size,
+		func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchIncidentsWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return toolx.MapSlice(items, fromIncidentResult), nil
+}
+
+// ResolveIncidents resolves the incidents matching the filter with a single server-side batch operation
+// over the affected process instances. With the Wait call option it follows the batch operation and then
+// waits until the process instances no longer report an incident.
+func (s *Service) ResolveIncidents(ctx context.Context, filter d.IncidentSearchFilterOpts, size int32, opts ...services.CallOption) (d.IncidentResolution, error) {
+	cCfg := services.ApplyCallOptions(opts)
+	filter.State = "ACTIVE"
+	incidents, err := s.SearchIncidents(ctx, filter, size+1)
+	if err != nil {
+		return d.IncidentResolution{}, err
+	}
+	incidents, truncated := common.Truncate(incidents, size)
+	res := d.IncidentResolution{Incidents: len(incidents), Truncated: truncated, ProcessInstanceKeys: common.DistinctKeys(incidents, func(in d.Incident) string { return in.ProcessInstanceKey })}
+	if len(res.ProcessInstanceKeys) == 0 {
+		return res, nil
+	}
+
+	f := map[string]any{
+		"processInstanceKey": map[string]any{"$in": res.ProcessInstanceKeys},
+		"hasIncident":        true,
+	}
+	httpc.PutIf(f, "tenantId", s.cfg.App.Tenant)
+	b, err := json.Marshal(map[string]any{"filter": f})
+	if err != nil {
+		return res, fmt.Errorf("marshalling batch incident resolution request: %w", err)
+	}
+	resp, err := s.c.ResolveIncidentsBatchOperationWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return res, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return res, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.BatchOperationKey == nil {
+		return res, fmt.Errorf("%w: 200 OK but empty payload; body=%s", d.ErrMalformedResponse, string(resp.Body))
+	}
+	res.BatchOperationKey = *resp.JSON200.BatchOperationKey
+	s.log.Info(fmt.Sprintf("batch operation %s submitted to resolve %d incident(s) of %d process instance(s)", res.BatchOperationKey, res.Incidents, len(res.ProcessInstanceKeys)))
+	if !cCfg.Wait {
+		return res, nil
+	}
+
+	bo, err := s.b.WaitForBatchOperation(ctx, res.BatchOperationKey)
+	if err != nil {
+		return res, err
+	}
+	if bo.OperationsFailedCount > 0 {
+		items, err := s.b.SearchBatchOperationItems(ctx, d.BatchOperationItemSearchFilterOpts{BatchOperationKey: bo.Key, State: "FAILED"}, size)
+		if err != nil {
+			return res, fmt.Errorf("fetching failed items of batch operation %s: %w", bo.Key, err)
+		}
+		for _, it := range items {
+			res.Failures = append(res.Failures, d.IncidentResolutionFailure{IncidentKey: it.ItemKey, ProcessInstanceKey: it.ProcessInstanceKey, Error: it.ErrorMessage})
+		}
+	}
+	res.Pending, err = waiter.WaitUntilNoIncidents(ctx, s, s.cfg, s.log, res.ResolvedProcessInstanceKeys())
+	return res, err
+}
+
+func (s *Service) ProcessInstanceHasIncident(ctx context.Context, key string, opts ...services.CallOption) (bool, error) {
+	_ = services.ApplyCallOptions(opts)
+	resp, err := s.c.GetProcessInstanceWithResponse(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return false, err
+	}
+	if resp.JSON200 == nil {
+		return false, fmt.Errorf("%w: 200 OK but empty payload; body=%s", d.ErrMalformedResponse, string(resp.Body))
+	}
+	return resp.JSON200.HasIncident, nil
+}
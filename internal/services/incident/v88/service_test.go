@@ -0,0 +1,38 @@
+package v88
+
+import (
+	"testing"
+	"time"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Internal_Incident_v88_ResolveIncidents_Wait_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	res, err := svc.ResolveIncidents(ctx, d.IncidentSearchFilterOpts{
+		ProcessDefinitionId: "order-process",
+		ErrorType:           "JOB_NO_RETRIES",
+		Since:               time.Now().Add(-2 * time.Hour),
+	}, 100, services.WithWait())
+	require.NoError(t, err)
+	require.Equal(t, "2251799813690501", res.BatchOperationKey)
+	require.Equal(t, 1, res.Incidents)
+	require.Equal(t, []string{"2251799813690099"}, res.ProcessInstanceKeys)
+	require.Empty(t, res.Failures)
+	require.Empty(t, res.Pending)
+
+	testx.LogJson(t, res)
+}
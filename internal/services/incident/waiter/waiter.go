@@ -0,0 +1,65 @@
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+)
+
+type IncidentChecker interface {
+	ProcessInstanceHasIncident(ctx context.Context, key string, opts ...services.CallOption) (bool, error)
+}
+
+// WaitUntilNoIncidents polls the given process instances until none of them reports an incident anymore.
+// - Respects ctx cancellation/deadline; augments with cfg.App.Backoff.Timeout if set
+// - Returns the keys still reporting an incident, also on failure/timeout.
+func WaitUntilNoIncidents(ctx context.Context, s IncidentChecker, cfg *config.Config, log *slog.Logger, keys []string) ([]string, error) {
+	backoff := cfg.App.Backoff
+	if backoff.Timeout > 0 {
+		deadline := time.Now().Add(backoff.Timeout)
+		if dl, ok := ctx.Deadline(); !ok || deadline.Before(dl) {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithDeadline(ctx, deadline)
+			defer cancel()
+		}
+	}
+
+	pending := append([]string(nil), keys...)
+	attempts := 0
+	delay := backoff.InitialDelay
+	for {
+		attempts++
+		var still []string
+		for _, key := range pending {
+			has, err := s.ProcessInstanceHasIncident(ctx, key)
+			if err != nil {
+				log.Error(fmt.Sprintf("fetching incident flag of process instance %s failed: %v (will retry)", key, err))
+				still = append(still, key)
+				continue
+			}
+			if has {
+				still = append(still, key)
+			}
+		}
+		pending = still
+		if len(pending) == 0 {
+			log.Debug(fmt.Sprintf("all %d process instance(s) are free of incidents after %d check(s)", len(keys), attempts))
+			return nil, nil
+		}
+		log.Info(fmt.Sprintf("%d of %d process instance(s) still report an incident; waiting...", len(pending), len(keys)))
+		if backoff.MaxRetries > 0 && attempts >= backoff.MaxRetries {
+			return pending, fmt.Errorf("exceeded max_retries (%d), %d process instance(s) still report an incident", backoff.MaxRetries, len(pending))
+		}
+		select {
+		case <-time.After(delay):
+			delay = backoff.NextDelay(delay)
+		case <-ctx.Done():
+			return pending, fmt.Errorf("%w: %s, %d process instance(s) still report an incident", d.ErrGatewayTimeout, ctx.Err().Error(), len(pending))
+		}
+	}
+}
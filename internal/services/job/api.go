@@ -0,0 +1,22 @@
+package job
+
+import (
+	"context"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	v87 "github.com/grafvonb/kamunder/internal/services/job/v87"
+	v88 "github.com/grafvonb/kamunder/internal/services/job/v88"
+)
+
+type API interface {
+	SearchJobs(ctx context.Context, filter d.JobSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.Job, error)
+	UpdateJob(ctx context.Context, key string, u d.JobUpdate, opts ...services.CallOption) error
+	CompleteJob(ctx context.Context, key string, vars map[string]any, opts ...services.CallOption) error
+	FailJob(ctx context.Context, key string, f d.JobFailure, opts ...services.CallOption) error
+	ThrowJobError(ctx context.Context, key string, e d.JobError, opts ...services.CallOption) error
+	ActivateJobs(ctx context.Context, a d.JobActivation, opts ...services.CallOption) ([]d.ActivatedJob, error)
+}
+
+var _ API = (*v87.Service)(nil)
+var _ API = (*v88.Service)(nil)
@@ -0,0 +1,18 @@
+package v87
+
+import (
+	"context"
+	"io"
+
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+)
+
+type GenJobClient interface {
+	PatchJobsJobKeyWithResponse(ctx context.Context, jobKey string, body camundav87.PatchJobsJobKeyJSONRequestBody, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PatchJobsJobKeyResponse, error)
+	PostJobsJobKeyCompletionWithResponse(ctx context.Context, jobKey string, body camundav87.PostJobsJobKeyCompletionJSONRequestBody, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PostJobsJobKeyCompletionResponse, error)
+	PostJobsJobKeyFailureWithResponse(ctx context.Context, jobKey string, body camundav87.PostJobsJobKeyFailureJSONRequestBody, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PostJobsJobKeyFailureResponse, error)
+	ThrowJobErrorWithResponse(ctx context.Context, jobKey string, body camundav87.ThrowJobErrorJSONRequestBody, reqEditors ...camundav87.RequestEditorFn) (*camundav87.ThrowJobErrorResponse, error)
+	PostJobsActivationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PostJobsActivationResponse, error)
+}
+
+var _ GenJobClient = (*camundav87.ClientWithResponses)(nil)
@@ -0,0 +1,79 @@
+package v87
+
+import (
+	"encoding/json"
+	"fmt"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+)
+
+// activatedJob mirrors the 8.7 activation response; the generated types drop all keys.
+// Keys are returned as strings or numbers depending on the accepted media type, json.Number handles both.
+type activatedJob struct {
+	JobKey                   json.Number    `json:"jobKey"`
+	Type                     string         `json:"type"`
+	Worker                   string         `json:"worker"`
+	Retries                  int32          `json:"retries"`
+	Deadline                 int64          `json:"deadline"`
+	ElementId                string         `json:"elementId"`
+	ElementInstanceKey       json.Number    `json:"elementInstanceKey"`
+	ProcessDefinitionId      string         `json:"processDefinitionId"`
+	ProcessDefinitionKey     json.Number    `json:"processDefinitionKey"`
+	ProcessDefinitionVersion int32          `json:"processDefinitionVersion"`
+	ProcessInstanceKey       json.Number    `json:"processInstanceKey"`
+	TenantId                 string         `json:"tenantId"`
+	CustomHeaders            map[string]any `json:"customHeaders"`
+	Variables                map[string]any `json:"variables"`
+}
+
+func decodeActivatedJobs(body []byte) ([]d.ActivatedJob, error) {
+	var r struct {
+		Jobs []activatedJob `json:"jobs"`
+	}
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil, fmt.Errorf("%w: decoding job activation response: %v; body=%s", d.ErrMalformedResponse, err, string(body))
+	}
+	out := make([]d.ActivatedJob, 0, len(r.Jobs))
+	for _, j := range r.Jobs {
+		out = append(out, d.ActivatedJob{
+			Key:                      j.JobKey.String(),
+			Type:                     j.Type,
+			Worker:                   j.Worker,
+			Retries:                  j.Retries,
+			Deadline:                 j.Deadline,
+			ElementId:                j.ElementId,
+			ElementInstanceKey:       j.ElementInstanceKey.String(),
+			ProcessDefinitionId:      j.ProcessDefinitionId,
+			ProcessDefinitionKey:     j.ProcessDefinitionKey.String(),
+			ProcessDefinitionVersion: j.ProcessDefinitionVersion,
+			ProcessInstanceKey:       j.ProcessInstanceKey.String(),
+			TenantId:                 j.TenantId,
+			CustomHeaders:            j.CustomHeaders,
+			Variables:                j.Variables,
+		})
+	}
+	return out, nil
+}
+
+func toJobActivationBody(a d.JobActivation, tenant string) map[string]any {
+	body := map[string]any{
+		"type":              a.Type,
+		"timeout":           a.Timeout,
+		"maxJobsToActivate": a.MaxJobsToActivate,
+	}
+	if a.Worker != "" {
+		body["worker"] = a.Worker
+	}
+	if a.RequestTimeout != 0 {
+		body["requestTimeout"] = a.RequestTimeout
+	}
+	if len(a.FetchVariables) > 0 {
+		body["fetchVariable"] = a.FetchVariables
+	}
+	if len(a.TenantIds) > 0 {
+		body["tenantIds"] = a.TenantIds
+	} else if tenant != "" {
+		body["tenantIds"] = []string{tenant}
+	}
+	return body
+}
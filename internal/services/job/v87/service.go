@@ -0,0 +1,130 @@
+package v87
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type Service struct {
+	c   GenJobClient
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+//nolint:unused
+func WithClient(c GenJobClient) Option { return func(s *Service) { s.c = c } }
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	c, err := camundav87.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav87.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{c: c, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) SearchJobs(ctx context.Context, filter d.JobSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.Job, error) {
+	_ = services.ApplyCallOptions(opts)
+	return nil, fmt.Errorf("%w: searching jobs requires camunda 8.8", d.ErrNotSupported)
+}
+
+func (s *Service) UpdateJob(ctx context.Context, key string, u d.JobUpdate, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("updating job %s with %+v", key, u))
+	body := camundav87.PatchJobsJobKeyJSONRequestBody{
+		Changeset: camundav87.JobChangeset{
+			Retries: toolx.PtrIfNonZero(u.Retries),
+			Timeout: toolx.PtrIfNonZero(u.Timeout),
+		},
+	}
+	resp, err := s.c.PatchJobsJobKeyWithResponse(ctx, key, body)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) CompleteJob(ctx context.Context, key string, vars map[string]any, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("completing job %s", key))
+	body := camundav87.PostJobsJobKeyCompletionJSONRequestBody{}
+	if len(vars) > 0 {
+		body.Variables = &vars
+	}
+	resp, err := s.c.PostJobsJobKeyCompletionWithResponse(ctx, key, body)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) FailJob(ctx context.Context, key string, f d.JobFailure, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("failing job %s with %d retries left", key, f.Retries))
+	body := camundav87.PostJobsJobKeyFailureJSONRequestBody{
+		ErrorMessage: toolx.PtrIf(f.ErrorMessage, ""),
+		Retries:      toolx.Ptr(f.Retries),
+		RetryBackOff: toolx.PtrIfNonZero(f.RetryBackOff),
+	}
+	if len(f.Variables) > 0 {
+		body.Variables = &f.Variables
+	}
+	resp, err := s.c.PostJobsJobKeyFailureWithResponse(ctx, key, body)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) ThrowJobError(ctx context.Context, key string, e d.JobError, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("throwing error %q for job %s", e.ErrorCode, key))
+	body := camundav87.ThrowJobErrorJSONRequestBody{
+		ErrorCode:    e.ErrorCode,
+		ErrorMessage: toolx.PtrIf(e.ErrorMessage, ""),
+	}
+	if len(e.Variables) > 0 {
+		body.Variables = &e.Variables
+	}
+	resp, err := s.c.ThrowJobErrorWithResponse(ctx, key, body)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) ActivateJobs(ctx context.Context, a d.JobActivation, opts ...services.CallOption) ([]d.ActivatedJob, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("activating up to %d job(s) of type %q as worker %q", a.MaxJobsToActivate, a.Type, a.Worker))
+	b, err := json.Marshal(toJobActivationBody(a, s.cfg.App.Tenant))
+	if err != nil {
+		return nil, fmt.Errorf("marshalling job activation request: %w", err)
+	}
+	resp, err := s.c.PostJobsActivationWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	return decodeActivatedJobs(resp.Body)
+}
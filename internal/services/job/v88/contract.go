@@ -0,0 +1,19 @@
+package v88
+
+import (
+	"context"
+	"io"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+)
+
+type GenJobClient interface {
+	SearchJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchJobsResponse, error)
+	UpdateJobWithResponse(ctx context.Context, jobKey string, body camundav88.UpdateJobJSONRequestBody, reqEditors ...camundav88.RequestEditorFn) (*camundav88.UpdateJobResponse, error)
+	CompleteJobWithResponse(ctx context.Context, jobKey string, body camundav88.CompleteJobJSONRequestBody, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CompleteJobResponse, error)
+	FailJobWithResponse(ctx context.Context, jobKey string, body camundav88.FailJobJSONRequestBody, reqEditors ...camundav88.RequestEditorFn) (*camundav88.FailJobResponse, error)
+	ThrowJobErrorWithResponse(ctx context.Context, jobKey string, body camundav88.ThrowJobErrorJSONRequestBody, reqEditors ...camundav88.RequestEditorFn) (*camundav88.ThrowJobErrorResponse, error)
+	ActivateJobsWithResponse(ctx context.Context, body camundav88.ActivateJobsJSONRequestBody, reqEditors ...camundav88.RequestEditorFn) (*camundav88.ActivateJobsResponse, error)
+}
+
+var _ GenJobClient = (*camundav88.ClientWithResponses)(nil)
@@ -0,0 +1,58 @@
+package v88
+
+import (
+	"time"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromJobSearchResult(r camundav88.JobSearchResult) d.Job {
+	return d.Job{
+		Key:                      r.JobKey,
+		Type:                     r.Type,
+		Kind:                     string(r.Kind),
+		State:                    string(r.State),
+		Worker:                   r.Worker,
+		Retries:                  r.Retries,
+		HasFailedWithRetriesLeft: r.HasFailedWithRetriesLeft,
+		ErrorCode:                toolx.Deref(r.ErrorCode, ""),
+		ErrorMessage:             toolx.Deref(r.ErrorMessage, ""),
+		Deadline:                 formatTime(r.Deadline),
+		EndTime:                  formatTime(r.EndTime),
+		ElementId:                r.ElementId,
+		ElementInstanceKey:       r.ElementInstanceKey,
+		ProcessDefinitionId:      r.ProcessDefinitionId,
+		ProcessDefinitionKey:     r.ProcessDefinitionKey,
+		ProcessInstanceKey:       r.ProcessInstanceKey,
+		TenantId:                 r.TenantId,
+		CustomHeaders:            r.CustomHeaders,
+	}
+}
+
+func fromActivatedJobResult(r camundav88.ActivatedJobResult) d.ActivatedJob {
+	return d.ActivatedJob{
+		Key:                      r.JobKey,
+		Type:                     r.Type,
+		Worker:                   r.Worker,
+		Retries:                  r.Retries,
+		Deadline:                 r.Deadline,
+		ElementId:                r.ElementId,
+		ElementInstanceKey:       r.ElementInstanceKey,
+		ProcessDefinitionId:      r.ProcessDefinitionId,
+		ProcessDefinitionKey:     r.ProcessDefinitionKey,
+		ProcessDefinitionVersion: r.ProcessDefinitionVersion,
+		ProcessInstanceKey:       r.ProcessInstanceKey,
+		TenantId:                 r.TenantId,
+		CustomHeaders:            r.CustomHeaders,
+		Variables:                r.Variables,
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
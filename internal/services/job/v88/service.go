@@ -0,0 +1,164 @@
+package v88
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type Service struct {
+	c   GenJobClient
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+//nolint:unused
+func WithClient(c GenJobClient) Option { return func(s *Service) { s.c = c } }
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	c, err := camundav88.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav88.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{c: c, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) SearchJobs(ctx context.Context, filter d.JobSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.Job, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for jobs with filter: %+v", filter))
+	f := map[string]any{}
+	httpc.PutIf(f, "jobKey", filter.Key)
+	httpc.PutIf(f, "type", filter.Type)
+	httpc.PutIf(f, "state", filter.State)
+	httpc.PutIf(f, "worker", filter.Worker)
+	httpc.PutIf(f, "elementId", filter.ElementId)
+	httpc.PutIf(f, "processDefinitionId", filter.ProcessDefinitionId)
+	httpc.PutIf(f, "processInstanceKey", filter.ProcessInstanceKey)
+	httpc.PutIf(f, "tenantId", s.cfg.App.Tenant)
+	items, err := httpc.Search[camundav88.JobSearchResult](ctx, f, size,
+		func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchJobsWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return toolx.MapSlice(items, fromJobSearchResult), nil
+}
+
+func (s *Service) UpdateJob(ctx context.Context, key string, u d.JobUpdate, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("updating job %s with %+v", key, u))
+	body := camundav88.UpdateJobJSONRequestBody{
+		Changeset: camundav88.JobChangeset{
+			Retries: toolx.PtrIfNonZero(u.Retries),
+			Timeout: toolx.PtrIfNonZero(u.Timeout),
+		},
+	}
+	resp, err := s.c.UpdateJobWithResponse(ctx, key, body)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) CompleteJob(ctx context.Context, key string, vars map[string]any, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("completing job %s", key))
+	body := camundav88.CompleteJobJSONRequestBody{}
+	if len(vars) > 0 {
+		body.Variables = &vars
+	}
+	resp, err := s.c.CompleteJobWithResponse(ctx, key, body)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) FailJob(ctx context.Context, key string, f d.JobFailure, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("failing job %s with %d retries left", key, f.Retries))
+	body := camundav88.FailJobJSONRequestBody{
+		ErrorMessage: toolx.PtrIf(f.ErrorMessage, ""),
+		Retries:      toolx.Ptr(f.Retries),
+		RetryBackOff: toolx.PtrIfNonZero(f.RetryBackOff),
+	}
+	if len(f.Variables) > 0 {
+		body.Variables = &f.Variables
+	}
+	resp, err := s.c.FailJobWithResponse(ctx, key, body)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) ThrowJobError(ctx context.Context, key string, e d.JobError, opts ...services.CallOption) error {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("throwing error %q for job %s", e.ErrorCode, key))
+	body := camundav88.ThrowJobErrorJSONRequestBody{
+		ErrorCode:    e.ErrorCode,
+		ErrorMessage: toolx.PtrIf(e.ErrorMessage, ""),
+	}
+	if len(e.Variables) > 0 {
+		body.Variables = &e.Variables
+	}
+	resp, err := s.c.ThrowJobErrorWithResponse(ctx, key, body)
+	if err != nil {
+		return err
+	}
+	return httpc.HttpStatusErr(resp.HTTPResponse, resp.Body)
+}
+
+func (s *Service) ActivateJobs(ctx context.Context, a d.JobActivation, opts ...services.CallOption) ([]d.ActivatedJob, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("activating up to %d job(s) of type %q as worker %q", a.MaxJobsToActivate, a.Type, a.Worker))
+	body := camundav88.ActivateJobsJSONRequestBody{
+		Type:              a.Type,
+		Worker:            toolx.PtrIf(a.Worker, ""),
+		Timeout:           a.Timeout,
+		MaxJobsToActivate: a.MaxJobsToActivate,
+		RequestTimeout:    toolx.PtrIfNonZero(a.RequestTimeout),
+	}
+	if len(a.FetchVariables) > 0 {
+		body.FetchVariable = &a.FetchVariables
+	}
+	if len(a.TenantIds) > 0 {
+		body.TenantIds = &a.TenantIds
+	} else if s.cfg.App.Tenant != "" {
+		body.TenantIds = &[]string{s.cfg.App.Tenant}
+	}
+	resp, err := s.c.ActivateJobsWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("%w: 200 OK but empty payload; body=%s", d.ErrMalformedResponse, string(resp.Body))
+	}
+	return toolx.MapSlice(resp.JSON200.Jobs, fromActivatedJobResult), nil
+}
@@ -0,0 +1,56 @@
+package v88
+
+import (
+	"testing"
+	"time"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Internal_Job_v88_SearchJobs_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	items, err := svc.SearchJobs(ctx, d.JobSearchFilterOpts{Type: "payment-service", State: "FAILED"}, 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, "2251799813690201", items[0].Key)
+	require.Equal(t, "FAILED", items[0].State)
+	require.Equal(t, int32(0), items[0].Retries)
+	require.Equal(t, "connection refused", items[0].ErrorMessage)
+	require.Equal(t, "2251799813690099", items[0].ProcessInstanceKey)
+
+	testx.LogJson(t, items)
+}
+
+func Test_Internal_Job_v88_ActivateJobs_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	jobs, err := svc.ActivateJobs(ctx, d.JobActivation{Type: "shipping", Worker: "kamunder-worker", Timeout: 30000, MaxJobsToActivate: 1})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, "2251799813690301", jobs[0].Key)
+	require.Equal(t, int32(3), jobs[0].Retries)
+	require.Equal(t, "order-4711", jobs[0].Variables["orderId"])
+
+	testx.LogJson(t, jobs)
+}
@@ -0,0 +1,20 @@
+package message
+
+import (
+	"context"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	v87 "github.com/grafvonb/kamunder/internal/services/message/v87"
+	v88 "github.com/grafvonb/kamunder/internal/services/message/v88"
+)
+
+type API interface {
+	PublishMessage(ctx context.Context, m d.MessagePublication, opts ...services.CallOption) (d.MessagePublicationResult, error)
+	CorrelateMessage(ctx context.Context, m d.MessageCorrelation, opts ...services.CallOption) (d.MessageCorrelationResult, error)
+	SearchMessageSubscriptions(ctx context.Context, filter d.MessageSubscriptionSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.MessageSubscription, error)
+	SearchCorrelatedMessageSubscriptions(ctx context.Context, filter d.MessageSubscriptionSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.CorrelatedMessageSubscription, error)
+}
+
+var _ API = (*v87.Service)(nil)
+var _ API = (*v88.Service)(nil)
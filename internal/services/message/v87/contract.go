@@ -0,0 +1,15 @@
+package v87
+
+import (
+	"context"
+	"io"
+
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+)
+
+type GenMessageClient interface {
+	PostMessagesPublicationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PostMessagesPublicationResponse, error)
+	PostMessagesCorrelationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PostMessagesCorrelationResponse, error)
+}
+
+var _ GenMessageClient = (*camundav87.ClientWithResponses)(nil)
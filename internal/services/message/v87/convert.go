@@ -0,0 +1,60 @@
+package v87
+
+import (
+	"encoding/json"
+	"fmt"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+)
+
+// messageResult mirrors the 8.7 message responses; the generated types only keep the tenant id.
+// Keys are returned as strings or numbers depending on the accepted media type, json.Number handles both.
+type messageResult struct {
+	MessageKey         json.Number `json:"messageKey"`
+	ProcessInstanceKey json.Number `json:"processInstanceKey"`
+	TenantId           string      `json:"tenantId"`
+}
+
+func decodeMessageResult(body []byte) (messageResult, error) {
+	var r messageResult
+	if err := json.Unmarshal(body, &r); err != nil {
+		return messageResult{}, fmt.Errorf("%w: decoding message response: %v; body=%s", d.ErrMalformedResponse, err, string(body))
+	}
+	return r, nil
+}
+
+func toMessagePublicationBody(m d.MessagePublication) map[string]any {
+	body := map[string]any{
+		"name":           m.Name,
+		"correlationKey": m.CorrelationKey,
+	}
+	if m.MessageId != "" {
+		body["messageId"] = m.MessageId
+	}
+	if m.TimeToLive != 0 {
+		body["timeToLive"] = m.TimeToLive
+	}
+	if m.TenantId != "" {
+		body["tenantId"] = m.TenantId
+	}
+	if len(m.Variables) > 0 {
+		body["variables"] = m.Variables
+	}
+	return body
+}
+
+func toMessageCorrelationBody(m d.MessageCorrelation) map[string]any {
+	body := map[string]any{
+		"name": m.Name,
+	}
+	if m.CorrelationKey != "" {
+		body["correlationKey"] = m.CorrelationKey
+	}
+	if m.TenantId != "" {
+		body["tenantId"] = m.TenantId
+	}
+	if len(m.Variables) > 0 {
+		body["variables"] = m.Variables
+	}
+	return body
+}
@@ -0,0 +1,98 @@
+package v87
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+)
+
+type Service struct {
+	c   GenMessageClient
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+//nolint:unused
+func WithClient(c GenMessageClient) Option { return func(s *Service) { s.c = c } }
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	c, err := camundav87.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav87.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{c: c, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) PublishMessage(ctx context.Context, m d.MessagePublication, opts ...services.CallOption) (d.MessagePublicationResult, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("publishing message %q with correlation key %q", m.Name, m.CorrelationKey))
+	b, err := json.Marshal(toMessagePublicationBody(m))
+	if err != nil {
+		return d.MessagePublicationResult{}, fmt.Errorf("marshalling message publication request: %w", err)
+	}
+	resp, err := s.c.PostMessagesPublicationWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return d.MessagePublicationResult{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.MessagePublicationResult{}, err
+	}
+	r, err := decodeMessageResult(resp.Body)
+	if err != nil {
+		return d.MessagePublicationResult{}, err
+	}
+	return d.MessagePublicationResult{MessageKey: r.MessageKey.String(), TenantId: r.TenantId}, nil
+}
+
+func (s *Service) CorrelateMessage(ctx context.Context, m d.MessageCorrelation, opts ...services.CallOption) (d.MessageCorrelationResult, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("correlating message %q with correlation key %q", m.Name, m.CorrelationKey))
+	b, err := json.Marshal(toMessageCorrelationBody(m))
+	if err != nil {
+		return d.MessageCorrelationResult{}, fmt.Errorf("marshalling message correlation request: %w", err)
+	}
+	resp, err := s.c.PostMessagesCorrelationWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return d.MessageCorrelationResult{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.MessageCorrelationResult{}, err
+	}
+	r, err := decodeMessageResult(resp.Body)
+	if err != nil {
+		return d.MessageCorrelationResult{}, err
+	}
+	return d.MessageCorrelationResult{
+		MessageKey:         r.MessageKey.String(),
+		ProcessInstanceKey: r.ProcessInstanceKey.String(),
+		TenantId:           r.TenantId,
+	}, nil
+}
+
+func (s *Service) SearchMessageSubscriptions(ctx context.Context, filter d.MessageSubscriptionSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.MessageSubscription, error) {
+	_ = services.ApplyCallOptions(opts)
+	return nil, fmt.Errorf("%w: searching message subscriptions requires camunda 8.8", d.ErrNotSupported)
+}
+
+func (s *Service) SearchCorrelatedMessageSubscriptions(ctx context.Context, filter d.MessageSubscriptionSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.CorrelatedMessageSubscription, error) {
+	_ = services.ApplyCallOptions(opts)
+	return nil, fmt.Errorf("%w: searching correlated message subscriptions requires camunda 8.8", d.ErrNotSupported)
+}
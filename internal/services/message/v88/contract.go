@@ -0,0 +1,17 @@
+package v88
+
+import (
+	"context"
+	"io"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+)
+
+type GenMessageClient interface {
+	PublishMessageWithResponse(ctx context.Context, body camundav88.PublishMessageJSONRequestBody, reqEditors ...camundav88.RequestEditorFn) (*camundav88.PublishMessageResponse, error)
+	CorrelateMessageWithResponse(ctx context.Context, body camundav88.CorrelateMessageJSONRequestBody, reqEditors ...camundav88.RequestEditorFn) (*camundav88.CorrelateMessageResponse, error)
+	SearchMessageSubscriptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchMessageSubscriptionsResponse, error)
+	SearchCorrelatedMessageSubscriptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav88.RequestEditorFn) (*camundav88.SearchCorrelatedMessageSubscriptionsResponse, error)
+}
+
+var _ GenMessageClient = (*camundav88.ClientWithResponses)(nil)
@@ -0,0 +1,60 @@
+package v88
+
+import (
+	"time"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromMessagePublicationResult(r camundav88.MessagePublicationResult) d.MessagePublicationResult {
+	return d.MessagePublicationResult{
+		MessageKey: toolx.Deref(r.MessageKey, ""),
+		TenantId:   toolx.Deref(r.TenantId, ""),
+	}
+}
+
+func fromMessageCorrelationResult(r camundav88.MessageCorrelationResult) d.MessageCorrelationResult {
+	return d.MessageCorrelationResult{
+		MessageKey:         toolx.Deref(r.MessageKey, ""),
+		ProcessInstanceKey: toolx.Deref(r.ProcessInstanceKey, ""),
+		TenantId:           toolx.Deref(r.TenantId, ""),
+	}
+}
+
+func fromMessageSubscriptionResult(r camundav88.MessageSubscriptionResult) d.MessageSubscription {
+	lastUpdated := ""
+	if r.LastUpdatedDate != nil {
+		lastUpdated = r.LastUpdatedDate.Format(time.RFC3339)
+	}
+	return d.MessageSubscription{
+		Key:                  toolx.Deref(r.MessageSubscriptionKey, ""),
+		MessageName:          toolx.Deref(r.MessageName, ""),
+		CorrelationKey:       toolx.Deref(r.CorrelationKey, ""),
+		ElementId:            toolx.Deref(r.ElementId, ""),
+		ElementInstanceKey:   toolx.Deref(r.ElementInstanceKey, ""),
+		ProcessDefinitionId:  toolx.Deref(r.ProcessDefinitionId, ""),
+		ProcessDefinitionKey: toolx.Deref(r.ProcessDefinitionKey, ""),
+		ProcessInstanceKey:   toolx.Deref(r.ProcessInstanceKey, ""),
+		State:                string(toolx.Deref(r.MessageSubscriptionState, "")),
+		LastUpdatedDate:      lastUpdated,
+		TenantId:             toolx.Deref(r.TenantId, ""),
+	}
+}
+
+func fromCorrelatedMessageSubscriptionResult(r camundav88.CorrelatedMessageSubscriptionResult) d.CorrelatedMessageSubscription {
+	return d.CorrelatedMessageSubscription{
+		SubscriptionKey:      r.SubscriptionKey,
+		MessageKey:           r.MessageKey,
+		MessageName:          r.MessageName,
+		CorrelationKey:       r.CorrelationKey,
+		CorrelationTime:      r.CorrelationTime.Format(time.RFC3339),
+		ElementId:            r.ElementId,
+		ElementInstanceKey:   toolx.Deref(r.ElementInstanceKey, ""),
+		ProcessDefinitionId:  r.ProcessDefinitionId,
+		ProcessDefinitionKey: toolx.Deref(r.ProcessDefinitionKey, ""),
+		ProcessInstanceKey:   r.ProcessInstanceKey,
+		TenantId:             r.TenantId,
+	}
+}
@@ -0,0 +1,142 @@
+package v88
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type Service struct {
+	c   GenMessageClient
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+//nolint:unused
+func WithClient(c GenMessageClient) Option { return func(s *Service) { s.c = c } }
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	c, err := camundav88.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav88.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{c: c, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) PublishMessage(ctx context.Context, m d.MessagePublication, opts ...services.CallOption) (d.MessagePublicationResult, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("publishing message %q with correlation key %q", m.Name, m.CorrelationKey))
+	body := camundav88.PublishMessageJSONRequestBody{
+		Name:           m.Name,
+		CorrelationKey: toolx.PtrIf(m.CorrelationKey, ""),
+		MessageId:      toolx.PtrIf(m.MessageId, ""),
+		TenantId:       toolx.PtrIf(m.TenantId, ""),
+		TimeToLive:     toolx.PtrIfNonZero(m.TimeToLive),
+		Variables:      varsPtr(m.Variables),
+	}
+	resp, err := s.c.PublishMessageWithResponse(ctx, body)
+	if err != nil {
+		return d.MessagePublicationResult{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.MessagePublicationResult{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.MessagePublicationResult{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromMessagePublicationResult(*resp.JSON200), nil
+}
+
+func (s *Service) CorrelateMessage(ctx context.Context, m d.MessageCorrelation, opts ...services.CallOption) (d.MessageCorrelationResult, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("correlating message %q with correlation key %q", m.Name, m.CorrelationKey))
+	body := camundav88.CorrelateMessageJSONRequestBody{
+		Name:           m.Name,
+		CorrelationKey: toolx.PtrIf(m.CorrelationKey, ""),
+		TenantId:       toolx.PtrIf(m.TenantId, ""),
+		Variables:      varsPtr(m.Variables),
+	}
+	resp, err := s.c.CorrelateMessageWithResponse(ctx, body)
+	if err != nil {
+		return d.MessageCorrelationResult{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.MessageCorrelationResult{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.MessageCorrelationResult{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromMessageCorrelationResult(*resp.JSON200), nil
+}
+
+func (s *Service) SearchMessageSubscriptions(ctx context.Context, filter d.MessageSubscriptionSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.MessageSubscription, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for message subscriptions with filter: %+v", filter))
+	f := s.subscriptionFilter(filter)
+	httpc.PutIf(f, "messageSubscriptionState", filter.State)
+	items, err := httpc.Search[camundav88.MessageSubscriptionResult](ctx, f, size,
+		func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchMessageSubscriptionsWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return toolx.MapSlice(items, fromMessageSubscriptionResult), nil
+}
+
+func (s *Service) SearchCorrelatedMessageSubscriptions(ctx context.Context, filter d.MessageSubscriptionSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.CorrelatedMessageSubscription, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("searching for correlated message subscriptions with filter: %+v", filter))
+	items, err := httpc.Search[camundav88.CorrelatedMessageSubscriptionResult](ctx, s.subscriptionFilter(filter), size,
+		func(ctx context.Context, contentType string, body io.Reader) (*http.Response, []byte, error) {
+			resp, err := s.c.SearchCorrelatedMessageSubscriptionsWithBodyWithResponse(ctx, contentType, body)
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.HTTPResponse, resp.Body, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return toolx.MapSlice(items, fromCorrelatedMessageSubscriptionResult), nil
+}
+
+func (s *Service) subscriptionFilter(filter d.MessageSubscriptionSearchFilterOpts) map[string]any {
+	f := map[string]any{}
+	httpc.PutIf(f, "messageName", filter.MessageName)
+	httpc.PutIf(f, "correlationKey", filter.CorrelationKey)
+	httpc.PutIf(f, "processInstanceKey", filter.ProcessInstanceKey)
+	httpc.PutIf(f, "tenantId", s.cfg.App.Tenant)
+	return f
+}
+
+func varsPtr(vars map[string]any) *map[string]interface{} {
+	if len(vars) == 0 {
+		return nil
+	}
+	return &vars
+}
@@ -0,0 +1,55 @@
+package v88
+
+import (
+	"testing"
+	"time"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Internal_Message_v88_PublishMessage_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	res, err := svc.PublishMessage(ctx, d.MessagePublication{
+		Name:           "payment-received",
+		CorrelationKey: "order-4711",
+		TimeToLive:     30000,
+		TenantId:       cfg.App.Tenant,
+		Variables:      map[string]any{"paid": true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "2251799813690001", res.MessageKey)
+}
+
+func Test_Internal_Message_v88_SearchMessageSubscriptions_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	items, err := svc.SearchMessageSubscriptions(ctx, d.MessageSubscriptionSearchFilterOpts{CorrelationKey: "order-4711"}, 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, "payment-received", items[0].MessageName)
+	require.Equal(t, "2251799813690099", items[0].ProcessInstanceKey)
+	require.Equal(t, "CREATED", items[0].State)
+
+	testx.LogJson(t, items)
+}
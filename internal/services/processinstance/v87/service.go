@@ -107,18 +107,9 @@ func (s *Service) FilterProcessInstanceWithOrphanParent(ctx context.Context, ite
 func (s *Service) SearchForProcessInstances(ctx context.Context, filter d.ProcessInstanceSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.ProcessInstance, error) {
 	_ = services.ApplyCallOptions(opts)
 	s.log.Debug(fmt.Sprintf("searching for process instances with filter: %+v", filter))
-	st := operatev87.ProcessInstanceState(filter.State)
-	pk, err := toolx.StringToInt64Ptr(filter.ParentKey)
+	f, err := s.processInstanceFilter(filter)
 	if err != nil {
-		return nil, fmt.Errorf("parsing parent key %q to int64: %w", filter.ParentKey, err)
-	}
-	f := operatev87.ProcessInstance{
-		TenantId:          &s.cfg.App.Tenant,
-		BpmnProcessId:     &filter.BpmnProcessId,
-		ProcessVersion:    toolx.PtrIfNonZero(filter.ProcessVersion),
-		ProcessVersionTag: &filter.ProcessVersionTag,
-		State:             &st,
-		ParentKey:         pk,
+		return nil, err
 	}
 	body := operatev87.SearchProcessInstancesJSONRequestBody{
 		Filter: &f,
@@ -138,6 +129,47 @@ func (s *Service) SearchForProcessInstances(ctx context.Context, filter d.Proces
 	return toolx.DerefSlicePtr(resp.JSON200.Items, fromProcessInstanceResponse), nil
 }
 
+// CountProcessInstances returns the total number of process instances matching the filter, not limited to a page.
+func (s *Service) CountProcessInstances(ctx context.Context, filter d.ProcessInstanceSearchFilterOpts, opts ...services.CallOption) (int64, error) {
+	_ = services.ApplyCallOptions(opts)
+	f, err := s.processInstanceFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+	size := int32(1)
+	resp, err := s.oc.SearchProcessInstancesWithResponse(ctx, operatev87.SearchProcessInstancesJSONRequestBody{Filter: &f, Size: &size})
+	if err != nil {
+		return 0, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return 0, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.Total == nil {
+		return 0, fmt.Errorf("%w: no total in search response; body=%s", d.ErrMalformedResponse, string(resp.Body))
+	}
+	return *resp.JSON200.Total, nil
+}
+
+func (s *Service) processInstanceFilter(filter d.ProcessInstanceSearchFilterOpts) (operatev87.ProcessInstance, error) {
+	st := operatev87.ProcessInstanceState(filter.State)
+	pk, err := toolx.StringToInt64Ptr(filter.ParentKey)
+	if err != nil {
+		return operatev87.ProcessInstance{}, fmt.Errorf("parsing parent key %q to int64: %w", filter.ParentKey, err)
+	}
+	return operatev87.ProcessInstance{
+		TenantId:          &s.cfg.App.Tenant,
+		BpmnProcessId:     &filter.BpmnProcessId,
+		ProcessVersion:    toolx.PtrIfNonZero(filter.ProcessVersion),
+		ProcessVersionTag: &filter.ProcessVersionTag,
+		State:             &st,
+		ParentKey:         pk,
+	}, nil
+}
+
+func (s *Service) CreateProcessInstance(ctx context.Context, c d.ProcessInstanceCreation, opts ...services.CallOption) (d.ProcessInstance, error) {
+	return d.ProcessInstance{}, fmt.Errorf("%w: creating process instances requires camunda 8.8", d.ErrNotSupported)
+}
+
 func (s *Service) CancelProcessInstance(ctx context.Context, key string, opts ...services.CallOption) (d.CancelResponse, error) {
 	cCfg := services.ApplyCallOptions(opts)
 	if !cCfg.NoStateCheck {
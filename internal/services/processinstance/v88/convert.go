@@ -27,6 +27,17 @@ func fromProcessInstanceResponse(r operatev88.ProcessInstance) d.ProcessInstance
 	}
 }
 
+func fromCreateProcessInstanceResult(r camundav88.CreateProcessInstanceResult) d.ProcessInstance {
+	return d.ProcessInstance{
+		BpmnProcessId:        r.ProcessDefinitionId,
+		Key:                  r.ProcessInstanceKey,
+		ProcessDefinitionKey: r.ProcessDefinitionKey,
+		ProcessVersion:       r.ProcessDefinitionVersion,
+		State:                d.StateActive,
+		TenantId:             r.TenantId,
+	}
+}
+
 func fromProcessInstanceResult(r camundav88.ProcessInstanceResult) d.ProcessInstance {
 	return d.ProcessInstance{
 		BpmnProcessId:             r.ProcessDefinitionId,
@@ -1,7 +1,9 @@
 package v88
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -12,6 +14,7 @@ import (
 	operatev88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/operate"
 	d "github.com/grafvonb/kamunder/internal/domain"
 	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/common"
 	"github.com/grafvonb/kamunder/internal/services/httpc"
 	"github.com/grafvonb/kamunder/internal/services/processinstance/waiter"
 	"github.com/grafvonb/kamunder/internal/services/processinstance/walker"
@@ -86,35 +89,97 @@ func (s *Service) FilterProcessInstanceWithOrphanParent(ctx context.Context, ite
 func (s *Service) SearchForProcessInstances(ctx context.Context, filter d.ProcessInstanceSearchFilterOpts, size int32, opts ...services.CallOption) ([]d.ProcessInstance, error) {
 	_ = services.ApplyCallOptions(opts)
 	s.log.Debug(fmt.Sprintf("searching for process instances with filter: %+v", filter))
+	f, err := s.processInstanceFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	body := operatev88.SearchProcessInstancesJSONRequestBody{
+		Filter: &f,
+		Size:   &size,
+	}
+	resp, err := s.oc.SearchProcessInstancesWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return toolx.DerefSlicePtr(resp.JSON200.Items, fromProcessInstanceResponse), nil
+}
+
+// CountProcessInstances returns the total number of process instances matching the filter, not limited to a page.
+func (s *Service) CountProcessInstances(ctx context.Context, filter d.ProcessInstanceSearchFilterOpts, opts ...services.CallOption) (int64, error) {
+	_ = services.ApplyCallOptions(opts)
+	f, err := s.processInstanceFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+	size := int32(1)
+	resp, err := s.oc.SearchProcessInstancesWithResponse(ctx, operatev88.SearchProcessInstancesJSONRequestBody{Filter: &f, Size: &size})
+	if err != nil {
+		return 0, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return 0, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.Total == nil {
+		return 0, fmt.Errorf("%w: no total in search response; body=%s", d.ErrMalformedResponse, string(resp.Body))
+	}
+	return *resp.JSON200.Total, nil
+}
+
+func (s *Service) processInstanceFilter(filter d.ProcessInstanceSearchFilterOpts) (operatev88.ProcessInstance, error) {
 	st := operatev88.ProcessInstanceState(filter.State)
 	pk, err := toolx.StringToInt64Ptr(filter.ParentKey)
 	if err != nil {
-		return nil, fmt.Errorf("parsing parent key %q to int64: %w", filter.ParentKey, err)
+		return operatev88.ProcessInstance{}, fmt.Errorf("parsing parent key %q to int64: %w", filter.ParentKey, err)
 	}
-	f := operatev88.ProcessInstance{
+	return operatev88.ProcessInstance{
 		TenantId:          &s.cfg.App.Tenant,
 		BpmnProcessId:     &filter.BpmnProcessId,
 		ProcessVersion:    toolx.PtrIfNonZero(filter.ProcessVersion),
 		ProcessVersionTag: &filter.ProcessVersionTag,
 		State:             &st,
 		ParentKey:         pk,
+	}, nil
+}
+
+func (s *Service) CreateProcessInstance(ctx context.Context, c d.ProcessInstanceCreation, opts ...services.CallOption) (d.ProcessInstance, error) {
+	_ = services.ApplyCallOptions(opts)
+	body := map[string]any{}
+	if c.ProcessDefinitionKey != "" {
+		body["processDefinitionKey"] = c.ProcessDefinitionKey
+	} else {
+		body["processDefinitionId"] = c.BpmnProcessId
+		if c.ProcessVersion > 0 {
+			body["processDefinitionVersion"] = c.ProcessVersion
+		}
 	}
-	body := operatev88.SearchProcessInstancesJSONRequestBody{
-		Filter: &f,
-		Size:   &size,
+	httpc.PutIf(body, "tenantId", common.DefaultVal(c.TenantId, s.cfg.App.Tenant))
+	if len(c.Variables) > 0 {
+		body["variables"] = c.Variables
 	}
-	resp, err := s.oc.SearchProcessInstancesWithResponse(ctx, body)
+	b, err := json.Marshal(body)
 	if err != nil {
-		return nil, err
+		return d.ProcessInstance{}, fmt.Errorf("marshalling process instance creation: %w", err)
+	}
+	s.log.Debug(fmt.Sprintf("creating process instance of %s", common.DefaultVal(c.ProcessDefinitionKey, c.BpmnProcessId)))
+	resp, err := s.cc.CreateProcessInstanceWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return d.ProcessInstance{}, err
 	}
 	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
-		return nil, err
+		return d.ProcessInstance{}, err
 	}
 	if resp.JSON200 == nil {
-		return nil, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+		return d.ProcessInstance{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
 			d.ErrMalformedResponse, string(resp.Body))
 	}
-	return toolx.DerefSlicePtr(resp.JSON200.Items, fromProcessInstanceResponse), nil
+	return fromCreateProcessInstanceResult(*resp.JSON200), nil
 }
 
 func (s *Service) CancelProcessInstance(ctx context.Context, key string, opts ...services.CallOption) (d.CancelResponse, error) {
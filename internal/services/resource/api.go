@@ -11,6 +11,8 @@ import (
 
 type API interface {
 	Deploy(ctx context.Context, tenantId string, units []d.DeploymentUnitData, opts ...services.CallOption) (d.Deployment, error)
+	GetResource(ctx context.Context, key string, opts ...services.CallOption) (d.Resource, error)
+	GetResourceContent(ctx context.Context, key string, opts ...services.CallOption) ([]byte, error)
 }
 
 var _ API = (*v87.Service)(nil)
@@ -9,6 +9,8 @@ import (
 
 type GenResourceClient interface {
 	PostDeploymentsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PostDeploymentsResponse, error)
+	GetResourcesResourceKeyWithResponse(ctx context.Context, resourceKey string, reqEditors ...camundav87.RequestEditorFn) (*camundav87.GetResourcesResourceKeyResponse, error)
+	GetResourcesResourceKeyContentWithResponse(ctx context.Context, resourceKey string, reqEditors ...camundav87.RequestEditorFn) (*camundav87.GetResourcesResourceKeyContentResponse, error)
 }
 
 var _ GenResourceClient = (*camundav87.ClientWithResponses)(nil)
@@ -13,3 +13,14 @@ func fromDeploymentResult(r camundav87.DeploymentResult) d.Deployment {
 		Key:      "<unknown>",
 	}
 }
+
+func fromResourceResult(r camundav87.ResourceResult) d.Resource {
+	return d.Resource{
+		Id:         toolx.Deref(r.ResourceId, ""),
+		Key:        toolx.Deref(r.ResourceKey, ""),
+		Name:       toolx.Deref(r.ResourceName, ""),
+		TenantId:   toolx.Deref(r.TenantId, ""),
+		Version:    toolx.Deref(r.Version, int32(0)),
+		VersionTag: toolx.Deref(r.VersionTag, ""),
+	}
+}
@@ -2,6 +2,7 @@ package v87
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
 
@@ -9,6 +10,7 @@ import (
 	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
 	d "github.com/grafvonb/kamunder/internal/domain"
 	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
 )
 
 type Service struct {
@@ -41,3 +43,33 @@ func (s *Service) Deploy(ctx context.Context, tenantId string, units []d.Deploym
 	_ = services.ApplyCallOptions(opts)
 	return d.Deployment{}, nil
 }
+
+func (s *Service) GetResource(ctx context.Context, key string, opts ...services.CallOption) (d.Resource, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("fetching resource with key %s", key))
+	resp, err := s.c.GetResourcesResourceKeyWithResponse(ctx, key)
+	if err != nil {
+		return d.Resource{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.Resource{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.Resource{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromResourceResult(*resp.JSON200), nil
+}
+
+func (s *Service) GetResourceContent(ctx context.Context, key string, opts ...services.CallOption) ([]byte, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("fetching content of resource with key %s", key))
+	resp, err := s.c.GetResourcesResourceKeyContentWithResponse(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
@@ -81,3 +81,33 @@ func (s *Service) Deploy(ctx context.Context, tenantId string, units []d.Deploym
 	}
 	return fromDeploymentResult(*resp.JSON200), nil
 }
+
+func (s *Service) GetResource(ctx context.Context, key string, opts ...services.CallOption) (d.Resource, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("fetching resource with key %s", key))
+	resp, err := s.c.GetResourceWithResponse(ctx, key)
+	if err != nil {
+		return d.Resource{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.Resource{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.Resource{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromResourceResult(*resp.JSON200), nil
+}
+
+func (s *Service) GetResourceContent(ctx context.Context, key string, opts ...services.CallOption) ([]byte, error) {
+	_ = services.ApplyCallOptions(opts)
+	s.log.Debug(fmt.Sprintf("fetching content of resource with key %s", key))
+	resp, err := s.c.GetResourceContentWithResponse(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	d "github.com/grafvonb/kamunder/internal/domain"
 	"github.com/grafvonb/kamunder/internal/testx"
 	"github.com/stretchr/testify/require"
 )
@@ -20,11 +21,37 @@ func Test_Internal_Deployment_v88_Deploy_OK(t *testing.T) {
 	svc, err := New(cfg, httpClient, log)
 	require.NoError(t, err)
 
-	s := "<xml>content</xml>"
-	d, err := svc.Deploy(ctx, []byte(s))
+	units := []d.DeploymentUnitData{{
+		Name:        "process.bpmn",
+		ContentType: "application/xml",
+		Data:        []byte("<xml>content</xml>"),
+	}}
+	dep, err := svc.Deploy(ctx, cfg.App.Tenant, units)
 	require.NoError(t, err)
-	require.NotEmpty(t, d)
+	require.NotEmpty(t, dep)
 
 	t.Logf("success: got deployment")
-	testx.LogJson(t, d)
+	testx.LogJson(t, dep)
+}
+
+func Test_Internal_Resource_v88_GetResource_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	r, err := svc.GetResource(ctx, "2251799813686749")
+	require.NoError(t, err)
+	require.Equal(t, "2251799813686749", r.Key)
+	require.Equal(t, "new-account-onboarding-workflow.bpmn", r.Name)
+
+	content, err := svc.GetResourceContent(ctx, "2251799813686749")
+	require.NoError(t, err)
+	require.Contains(t, string(content), "new-account-onboarding-workflow")
 }
@@ -0,0 +1,18 @@
+package signal
+
+import (
+	"context"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	v87 "github.com/grafvonb/kamunder/internal/services/signal/v87"
+	v88 "github.com/grafvonb/kamunder/internal/services/signal/v88"
+)
+
+type API interface {
+	BroadcastSignal(ctx context.Context, sig d.SignalBroadcast, opts ...services.CallOption) (d.SignalBroadcastResult, error)
+	SearchSignalCatchInstances(ctx context.Context, signalName string, opts ...services.CallOption) ([]d.SignalCatchInstance, error)
+}
+
+var _ API = (*v87.Service)(nil)
+var _ API = (*v88.Service)(nil)
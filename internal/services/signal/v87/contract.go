@@ -0,0 +1,22 @@
+package v87
+
+import (
+	"context"
+	"io"
+
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+	operatev87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/operate"
+)
+
+type GenSignalClientCamunda interface {
+	PostSignalsBroadcastWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...camundav87.RequestEditorFn) (*camundav87.PostSignalsBroadcastResponse, error)
+}
+
+type GenSignalClientOperate interface {
+	SearchFlownodeInstancesWithResponse(ctx context.Context, body operatev87.SearchFlownodeInstancesJSONRequestBody, reqEditors ...operatev87.RequestEditorFn) (*operatev87.SearchFlownodeInstancesResponse, error)
+	GetFlowNodeInstanceByKeyWithResponse(ctx context.Context, key int64, reqEditors ...operatev87.RequestEditorFn) (*operatev87.GetFlowNodeInstanceByKeyResponse, error)
+	GetProcessDefinitionAsXmlByKeyWithResponse(ctx context.Context, key int64, reqEditors ...operatev87.RequestEditorFn) (*operatev87.GetProcessDefinitionAsXmlByKeyResponse, error)
+}
+
+var _ GenSignalClientCamunda = (*camundav87.ClientWithResponses)(nil)
+var _ GenSignalClientOperate = (*operatev87.ClientWithResponses)(nil)
@@ -0,0 +1,18 @@
+package v87
+
+import (
+	operatev87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/operate"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromFlowNodeInstance(r operatev87.FlowNodeInstance) d.SignalCatchInstance {
+	return d.SignalCatchInstance{
+		Key:                  toolx.Int64PtrToString(r.Key),
+		ElementId:            toolx.Deref(r.FlowNodeId, ""),
+		ProcessInstanceKey:   toolx.Int64PtrToString(r.ProcessInstanceKey),
+		ProcessDefinitionKey: toolx.Int64PtrToString(r.ProcessDefinitionKey),
+		StateBefore:          string(toolx.Deref(r.State, "")),
+		TenantId:             toolx.Deref(r.TenantId, ""),
+	}
+}
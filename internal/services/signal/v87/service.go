@@ -0,0 +1,190 @@
+package v87
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/grafvonb/kamunder/config"
+	camundav87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/camunda"
+	operatev87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/operate"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/common"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/internal/services/signal/verifier"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+const maxCatchInstancesSearchSize int32 = 1000
+
+type Service struct {
+	cc  GenSignalClientCamunda
+	oc  GenSignalClientOperate
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	cc, err := camundav87.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav87.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	co, err := operatev87.NewClientWithResponses(
+		cfg.APIs.Operate.BaseURL,
+		operatev87.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{oc: co, cc: cc, cfg: cfg, log: log}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) BroadcastSignal(ctx context.Context, sig d.SignalBroadcast, opts ...services.CallOption) (d.SignalBroadcastResult, error) {
+	cCfg := services.ApplyCallOptions(opts)
+	var snapshot []d.SignalCatchInstance
+	if cCfg.Verify {
+		var err error
+		if snapshot, err = s.SearchSignalCatchInstances(ctx, sig.Name); err != nil {
+			return d.SignalBroadcastResult{}, fmt.Errorf("taking snapshot of instances waiting for signal %q: %w", sig.Name, err)
+		}
+		s.log.Info(fmt.Sprintf("%d element instance(s) are waiting for signal %q before the broadcast", len(snapshot), sig.Name))
+	}
+
+	s.log.Debug(fmt.Sprintf("broadcasting signal %q", sig.Name))
+	// the generated 8.7 response type drops the signal key, so request and response are handled as plain JSON
+	body := map[string]any{"signalName": sig.Name}
+	if sig.TenantId != "" {
+		body["tenantId"] = sig.TenantId
+	}
+	if len(sig.Variables) > 0 {
+		body["variables"] = sig.Variables
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return d.SignalBroadcastResult{}, fmt.Errorf("marshalling signal broadcast request: %w", err)
+	}
+	resp, err := s.cc.PostSignalsBroadcastWithBodyWithResponse(ctx, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return d.SignalBroadcastResult{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.SignalBroadcastResult{}, err
+	}
+	var r struct {
+		SignalKey json.Number `json:"signalKey"`
+		TenantId  string      `json:"tenantId"`
+	}
+	if err = json.Unmarshal(resp.Body, &r); err != nil {
+		return d.SignalBroadcastResult{}, fmt.Errorf("%w: decoding signal broadcast response: %v; body=%s",
+			d.ErrMalformedResponse, err, string(resp.Body))
+	}
+	res := d.SignalBroadcastResult{
+		SignalKey: r.SignalKey.String(),
+		TenantId:  r.TenantId,
+	}
+	if !cCfg.Verify {
+		return res, nil
+	}
+	return s.verify(ctx, res, snapshot)
+}
+
+func (s *Service) verify(ctx context.Context, res d.SignalBroadcastResult, snapshot []d.SignalCatchInstance) (d.SignalBroadcastResult, error) {
+	keys := make([]string, 0, len(snapshot))
+	for _, it := range snapshot {
+		keys = append(keys, it.Key)
+	}
+	states, err := verifier.WaitUntilProgressed(ctx, s, s.cfg, s.log, keys)
+	for i := range snapshot {
+		snapshot[i].StateAfter = states[snapshot[i].Key]
+	}
+	res.CatchingInstances = snapshot
+	if err != nil {
+		return res, fmt.Errorf("verifying that waiting instances progressed: %w", err)
+	}
+	res.Verified = true
+	return res, nil
+}
+
+// SearchSignalCatchInstances returns the active intermediate catch events waiting for the signal with the given name.
+func (s *Service) SearchSignalCatchInstances(ctx context.Context, signalName string, opts ...services.CallOption) ([]d.SignalCatchInstance, error) {
+	_ = services.ApplyCallOptions(opts)
+	size := maxCatchInstancesSearchSize
+	body := operatev87.QueryFlowNodeInstance{
+		Filter: &operatev87.FlowNodeInstance{
+			State:    toolx.Ptr(operatev87.FlowNodeInstanceStateACTIVE),
+			Type:     toolx.Ptr(operatev87.FlowNodeInstanceTypeINTERMEDIATECATCHEVENT),
+			TenantId: toolx.PtrIf(s.cfg.App.Tenant, ""),
+		},
+		Size: &size,
+	}
+	resp, err := s.oc.SearchFlownodeInstancesWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	catchIdsByPD := map[int64]map[string]bool{}
+	var out []d.SignalCatchInstance
+	for _, fni := range toolx.DerefSlice(resp.JSON200.Items) {
+		pdKey := toolx.Deref(fni.ProcessDefinitionKey, 0)
+		ids, ok := catchIdsByPD[pdKey]
+		if !ok {
+			if ids, err = s.signalCatchEventIds(ctx, pdKey, signalName); err != nil {
+				return nil, err
+			}
+			catchIdsByPD[pdKey] = ids
+		}
+		if ids[toolx.Deref(fni.FlowNodeId, "")] {
+			out = append(out, fromFlowNodeInstance(fni))
+		}
+	}
+	return out, nil
+}
+
+func (s *Service) signalCatchEventIds(ctx context.Context, pdKey int64, signalName string) (map[string]bool, error) {
+	resp, err := s.oc.GetProcessDefinitionAsXmlByKeyWithResponse(ctx, pdKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, fmt.Errorf("fetching bpmn of process definition %d: %w", pdKey, err)
+	}
+	return common.SignalCatchEventIds(resp.Body, signalName)
+}
+
+func (s *Service) GetElementInstanceStateByKey(ctx context.Context, key string) (string, error) {
+	oldKey, err := toolx.StringToInt64(key)
+	if err != nil {
+		return "", fmt.Errorf("parsing element instance key %q to int64: %w", key, err)
+	}
+	resp, err := s.oc.GetFlowNodeInstanceByKeyWithResponse(ctx, oldKey)
+	if err != nil {
+		return "", err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return "", err
+	}
+	if resp.JSON200 == nil {
+		return "", fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return string(toolx.Deref(resp.JSON200.State, "")), nil
+}
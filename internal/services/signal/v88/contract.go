@@ -0,0 +1,21 @@
+package v88
+
+import (
+	"context"
+
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	operatev88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/operate"
+)
+
+type GenSignalClientCamunda interface {
+	BroadcastSignalWithResponse(ctx context.Context, body camundav88.BroadcastSignalJSONRequestBody, reqEditors ...camundav88.RequestEditorFn) (*camundav88.BroadcastSignalResponse, error)
+}
+
+type GenSignalClientOperate interface {
+	SearchFlownodeInstancesWithResponse(ctx context.Context, body operatev88.SearchFlownodeInstancesJSONRequestBody, reqEditors ...operatev88.RequestEditorFn) (*operatev88.SearchFlownodeInstancesResponse, error)
+	GetFlowNodeInstanceByKeyWithResponse(ctx context.Context, key int64, reqEditors ...operatev88.RequestEditorFn) (*operatev88.GetFlowNodeInstanceByKeyResponse, error)
+	GetProcessDefinitionAsXmlByKeyWithResponse(ctx context.Context, key int64, reqEditors ...operatev88.RequestEditorFn) (*operatev88.GetProcessDefinitionAsXmlByKeyResponse, error)
+}
+
+var _ GenSignalClientCamunda = (*camundav88.ClientWithResponses)(nil)
+var _ GenSignalClientOperate = (*operatev88.ClientWithResponses)(nil)
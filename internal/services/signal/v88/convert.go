@@ -0,0 +1,18 @@
+package v88
+
+import (
+	operatev88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/operate"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromFlowNodeInstance(r operatev88.FlowNodeInstance) d.SignalCatchInstance {
+	return d.SignalCatchInstance{
+		Key:                  toolx.Int64PtrToString(r.Key),
+		ElementId:            toolx.Deref(r.FlowNodeId, ""),
+		ProcessInstanceKey:   toolx.Int64PtrToString(r.ProcessInstanceKey),
+		ProcessDefinitionKey: toolx.Int64PtrToString(r.ProcessDefinitionKey),
+		StateBefore:          string(toolx.Deref(r.State, "")),
+		TenantId:             toolx.Deref(r.TenantId, ""),
+	}
+}
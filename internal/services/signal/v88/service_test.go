@@ -0,0 +1,39 @@
+package v88
+
+import (
+	"testing"
+	"time"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Internal_Signal_v88_BroadcastSignal_Verify_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+	cfg.APIs.Operate.BaseURL = fs.BaseURL
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	res, err := svc.BroadcastSignal(ctx, d.SignalBroadcast{
+		Name:     "release-orders",
+		TenantId: cfg.App.Tenant,
+	}, services.WithVerify())
+	require.NoError(t, err)
+	require.Equal(t, "2251799813690300", res.SignalKey)
+	require.True(t, res.Verified)
+	require.Len(t, res.CatchingInstances, 1, "only the signal catch event must be captured")
+	require.Equal(t, "Event_WaitForRelease", res.CatchingInstances[0].ElementId)
+	require.Equal(t, "ACTIVE", res.CatchingInstances[0].StateBefore)
+	require.Equal(t, "COMPLETED", res.CatchingInstances[0].StateAfter)
+
+	testx.LogJson(t, res)
+}
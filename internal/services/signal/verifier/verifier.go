@@ -0,0 +1,69 @@
+package verifier
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	d "github.com/grafvonb/kamunder/internal/domain"
+)
+
+const stateActive = "ACTIVE"
+
+type ElementInstanceStateGetter interface {
+	GetElementInstanceStateByKey(ctx context.Context, key string) (string, error)
+}
+
+// WaitUntilProgressed polls the given element instances until none of them is ACTIVE anymore.
+// - Respects ctx cancellation/deadline; augments with cfg.App.Backoff.Timeout if set
+// - Returns the last known state per key, also on failure/timeout.
+func WaitUntilProgressed(ctx context.Context, s ElementInstanceStateGetter, cfg *config.Config, log *slog.Logger, keys []string) (map[string]string, error) {
+	backoff := cfg.App.Backoff
+	if backoff.Timeout > 0 {
+		deadline := time.Now().Add(backoff.Timeout)
+		if dl, ok := ctx.Deadline(); !ok || deadline.Before(dl) {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithDeadline(ctx, deadline)
+			defer cancel()
+		}
+	}
+
+	states := make(map[string]string, len(keys))
+	pending := append([]string(nil), keys...)
+	attempts := 0
+	delay := backoff.InitialDelay
+	for {
+		attempts++
+		var still []string
+		for _, key := range pending {
+			st, err := s.GetElementInstanceStateByKey(ctx, key)
+			if err != nil {
+				log.Error(fmt.Sprintf("fetching state for element instance %s failed: %v (will retry)", key, err))
+				still = append(still, key)
+				continue
+			}
+			states[key] = st
+			if strings.EqualFold(st, stateActive) {
+				still = append(still, key)
+			}
+		}
+		pending = still
+		if len(pending) == 0 {
+			log.Debug(fmt.Sprintf("all %d element instance(s) progressed after %d check(s)", len(keys), attempts))
+			return states, nil
+		}
+		log.Info(fmt.Sprintf("%d of %d element instance(s) still waiting for the signal; waiting...", len(pending), len(keys)))
+		if backoff.MaxRetries > 0 && attempts >= backoff.MaxRetries {
+			return states, fmt.Errorf("exceeded max_retries (%d), still waiting: %s", backoff.MaxRetries, strings.Join(pending, ", "))
+		}
+		select {
+		case <-time.After(delay):
+			delay = backoff.NextDelay(delay)
+		case <-ctx.Done():
+			return states, fmt.Errorf("%w: %s, still waiting: %s", d.ErrGatewayTimeout, ctx.Err().Error(), strings.Join(pending, ", "))
+		}
+	}
+}
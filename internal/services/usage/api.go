@@ -0,0 +1,20 @@
+package usage
+
+import (
+	"context"
+	"time"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	v87 "github.com/grafvonb/kamunder/internal/services/usage/v87"
+	v88 "github.com/grafvonb/kamunder/internal/services/usage/v88"
+)
+
+type API interface {
+	GetUsageMetrics(ctx context.Context, from, to time.Time, opts ...services.CallOption) (d.UsageMetrics, error)
+	GetAdminClusters(ctx context.Context, opts ...services.CallOption) ([]d.AdminCluster, error)
+	GetAdminUsageMetrics(ctx context.Context, clusterId string, from, to time.Time, opts ...services.CallOption) (d.UsageMetrics, error)
+}
+
+var _ API = (*v87.Service)(nil)
+var _ API = (*v88.Service)(nil)
@@ -0,0 +1,14 @@
+package v87
+
+import (
+	"context"
+
+	adminv87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/administrationsm"
+)
+
+type GenAdminClient interface {
+	GetClustersWithResponse(ctx context.Context, reqEditors ...adminv87.RequestEditorFn) (*adminv87.GetClustersResponse, error)
+	GetUsageMetricsWithResponse(ctx context.Context, params *adminv87.GetUsageMetricsParams, reqEditors ...adminv87.RequestEditorFn) (*adminv87.GetUsageMetricsResponse, error)
+}
+
+var _ GenAdminClient = (*adminv87.ClientWithResponses)(nil)
@@ -0,0 +1,30 @@
+package v87
+
+import (
+	"time"
+
+	adminv87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/administrationsm"
+	d "github.com/grafvonb/kamunder/internal/domain"
+)
+
+func fromAdminCluster(c adminv87.ConsoleSMAdminApiCluster) d.AdminCluster {
+	return d.AdminCluster{
+		Uuid:       c.Uuid,
+		Name:       c.Name,
+		Namespace:  c.Namespace,
+		Generation: c.Generation,
+		Status:     string(c.Status),
+		Type:       string(c.Type),
+	}
+}
+
+func fromAdminUsageMetrics(r adminv87.ConsoleSMAdminApiUsageMetricsForCluster, from, to time.Time) d.UsageMetrics {
+	return d.UsageMetrics{
+		ClusterId:         r.Id,
+		From:              from,
+		To:                to,
+		ProcessInstances:  int64(r.ProcessInstances.Total),
+		DecisionInstances: int64(r.DecisionInstances.Total),
+		Assignees:         int64(r.TaskUsers.Total),
+	}
+}
@@ -0,0 +1,102 @@
+package v87
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	adminv87 "github.com/grafvonb/kamunder/internal/clients/camunda/v87/administrationsm"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+)
+
+type Service struct {
+	a   GenAdminClient
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+//nolint:unused
+func WithAdminClient(a GenAdminClient) Option { return func(s *Service) { s.a = a } }
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	s := &Service{cfg: cfg, log: log}
+	// the administration API lives in the Console, not in the orchestration cluster; it is optional
+	if u := cfg.APIs.Administration.BaseURL; u != "" {
+		a, err := adminv87.NewClientWithResponses(u, adminv87.WithHTTPClient(httpClient))
+		if err != nil {
+			return nil, err
+		}
+		s.a = a
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) GetUsageMetrics(ctx context.Context, from, to time.Time, opts ...services.CallOption) (d.UsageMetrics, error) {
+	return d.UsageMetrics{}, fmt.Errorf("%w: usage metrics of the orchestration cluster require camunda 8.8, use the administration API instead", d.ErrNotSupported)
+}
+
+func (s *Service) GetAdminClusters(ctx context.Context, opts ...services.CallOption) ([]d.AdminCluster, error) {
+	_ = services.ApplyCallOptions(opts)
+	if s.a == nil {
+		return nil, errNoAdminAPI()
+	}
+	resp, err := s.a.GetClustersWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	out := make([]d.AdminCluster, 0, len(*resp.JSON200))
+	for _, c := range *resp.JSON200 {
+		out = append(out, fromAdminCluster(c))
+	}
+	return out, nil
+}
+
+func (s *Service) GetAdminUsageMetrics(ctx context.Context, clusterId string, from, to time.Time, opts ...services.CallOption) (d.UsageMetrics, error) {
+	_ = services.ApplyCallOptions(opts)
+	if s.a == nil {
+		return d.UsageMetrics{}, errNoAdminAPI()
+	}
+	params := &adminv87.GetUsageMetricsParams{
+		Id:    clusterId,
+		Start: float64(from.UnixMilli()),
+		End:   float64(to.UnixMilli()),
+	}
+	s.log.Debug(fmt.Sprintf("fetching usage metrics of cluster %s from %s to %s", clusterId, from.Format(time.RFC3339), to.Format(time.RFC3339)))
+	resp, err := s.a.GetUsageMetricsWithResponse(ctx, params)
+	if err != nil {
+		return d.UsageMetrics{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.UsageMetrics{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.UsageMetrics{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	m := fromAdminUsageMetrics(*resp.JSON200, from, to)
+	if m.ClusterId == "" {
+		m.ClusterId = clusterId
+	}
+	return m, nil
+}
+
+func errNoAdminAPI() error {
+	return fmt.Errorf("%w: apis.administration_api.base_url: %w", d.ErrBadRequest, config.ErrNoBaseURL)
+}
@@ -0,0 +1,21 @@
+package v88
+
+import (
+	"context"
+
+	adminv88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/administrationsm"
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+)
+
+type GenUsageClient interface {
+	GetUsageMetricsWithResponse(ctx context.Context, params *camundav88.GetUsageMetricsParams, reqEditors ...camundav88.RequestEditorFn) (*camundav88.GetUsageMetricsResponse, error)
+}
+
+var _ GenUsageClient = (*camundav88.ClientWithResponses)(nil)
+
+type GenAdminClient interface {
+	GetClustersWithResponse(ctx context.Context, reqEditors ...adminv88.RequestEditorFn) (*adminv88.GetClustersResponse, error)
+	GetUsageMetricsWithResponse(ctx context.Context, params *adminv88.GetUsageMetricsParams, reqEditors ...adminv88.RequestEditorFn) (*adminv88.GetUsageMetricsResponse, error)
+}
+
+var _ GenAdminClient = (*adminv88.ClientWithResponses)(nil)
@@ -0,0 +1,42 @@
+package v88
+
+import (
+	"time"
+
+	adminv88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/administrationsm"
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromUsageMetricsResponse(r camundav88.UsageMetricsResponse, from, to time.Time) d.UsageMetrics {
+	return d.UsageMetrics{
+		From:              from,
+		To:                to,
+		ProcessInstances:  toolx.Deref(r.ProcessInstances, 0),
+		DecisionInstances: toolx.Deref(r.DecisionInstances, 0),
+		Assignees:         toolx.Deref(r.Assignees, 0),
+	}
+}
+
+func fromAdminCluster(c adminv88.ConsoleSMAdminApiCluster) d.AdminCluster {
+	return d.AdminCluster{
+		Uuid:       c.Uuid,
+		Name:       c.Name,
+		Namespace:  c.Namespace,
+		Generation: c.Generation,
+		Status:     string(c.Status),
+		Type:       string(c.Type),
+	}
+}
+
+func fromAdminUsageMetrics(r adminv88.ConsoleSMAdminApiUsageMetricsForCluster, from, to time.Time) d.UsageMetrics {
+	return d.UsageMetrics{
+		ClusterId:         r.Id,
+		From:              from,
+		To:                to,
+		ProcessInstances:  int64(r.ProcessInstances.Total),
+		DecisionInstances: int64(r.DecisionInstances.Total),
+		Assignees:         int64(r.TaskUsers.Total),
+	}
+}
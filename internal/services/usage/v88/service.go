@@ -0,0 +1,133 @@
+package v88
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	adminv88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/administrationsm"
+	camundav88 "github.com/grafvonb/kamunder/internal/clients/camunda/v88/camunda"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	"github.com/grafvonb/kamunder/internal/services/httpc"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type Service struct {
+	c   GenUsageClient
+	a   GenAdminClient
+	cfg *config.Config
+	log *slog.Logger
+}
+
+type Option func(*Service)
+
+//nolint:unused
+func WithClient(c GenUsageClient) Option { return func(s *Service) { s.c = c } }
+
+//nolint:unused
+func WithAdminClient(a GenAdminClient) Option { return func(s *Service) { s.a = a } }
+
+func New(cfg *config.Config, httpClient *http.Client, log *slog.Logger, opts ...Option) (*Service, error) {
+	c, err := camundav88.NewClientWithResponses(
+		cfg.APIs.Camunda.BaseURL,
+		camundav88.WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s := &Service{c: c, cfg: cfg, log: log}
+	// the administration API lives in the Console, not in the orchestration cluster; it is optional
+	if u := cfg.APIs.Administration.BaseURL; u != "" {
+		a, err := adminv88.NewClientWithResponses(u, adminv88.WithHTTPClient(httpClient))
+		if err != nil {
+			return nil, err
+		}
+		s.a = a
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+func (s *Service) GetUsageMetrics(ctx context.Context, from, to time.Time, opts ...services.CallOption) (d.UsageMetrics, error) {
+	_ = services.ApplyCallOptions(opts)
+	params := &camundav88.GetUsageMetricsParams{
+		StartTime: from,
+		EndTime:   to,
+		TenantId:  toolx.PtrIf(s.cfg.App.Tenant, ""),
+	}
+	s.log.Debug(fmt.Sprintf("fetching usage metrics from %s to %s", from.Format(time.RFC3339), to.Format(time.RFC3339)))
+	resp, err := s.c.GetUsageMetricsWithResponse(ctx, params)
+	if err != nil {
+		return d.UsageMetrics{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.UsageMetrics{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.UsageMetrics{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	return fromUsageMetricsResponse(*resp.JSON200, from, to), nil
+}
+
+func (s *Service) GetAdminClusters(ctx context.Context, opts ...services.CallOption) ([]d.AdminCluster, error) {
+	_ = services.ApplyCallOptions(opts)
+	if s.a == nil {
+		return nil, errNoAdminAPI()
+	}
+	resp, err := s.a.GetClustersWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	out := make([]d.AdminCluster, 0, len(*resp.JSON200))
+	for _, c := range *resp.JSON200 {
+		out = append(out, fromAdminCluster(c))
+	}
+	return out, nil
+}
+
+func (s *Service) GetAdminUsageMetrics(ctx context.Context, clusterId string, from, to time.Time, opts ...services.CallOption) (d.UsageMetrics, error) {
+	_ = services.ApplyCallOptions(opts)
+	if s.a == nil {
+		return d.UsageMetrics{}, errNoAdminAPI()
+	}
+	params := &adminv88.GetUsageMetricsParams{
+		Id:    clusterId,
+		Start: float64(from.UnixMilli()),
+		End:   float64(to.UnixMilli()),
+	}
+	s.log.Debug(fmt.Sprintf("fetching usage metrics of cluster %s from %s to %s", clusterId, from.Format(time.RFC3339), to.Format(time.RFC3339)))
+	resp, err := s.a.GetUsageMetricsWithResponse(ctx, params)
+	if err != nil {
+		return d.UsageMetrics{}, err
+	}
+	if err = httpc.HttpStatusErr(resp.HTTPResponse, resp.Body); err != nil {
+		return d.UsageMetrics{}, err
+	}
+	if resp.JSON200 == nil {
+		return d.UsageMetrics{}, fmt.Errorf("%w: 200 OK but empty payload; body=%s",
+			d.ErrMalformedResponse, string(resp.Body))
+	}
+	m := fromAdminUsageMetrics(*resp.JSON200, from, to)
+	if m.ClusterId == "" {
+		m.ClusterId = clusterId
+	}
+	return m, nil
+}
+
+func errNoAdminAPI() error {
+	return fmt.Errorf("%w: apis.administration_api.base_url: %w", d.ErrBadRequest, config.ErrNoBaseURL)
+}
@@ -0,0 +1,77 @@
+package v88
+
+import (
+	"testing"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/testx"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Internal_Usage_v88_GetUsageMetrics_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	m, err := svc.GetUsageMetrics(ctx, from, to)
+	require.NoError(t, err)
+	require.Equal(t, int64(1200), m.ProcessInstances)
+	require.Equal(t, int64(340), m.DecisionInstances)
+	require.Equal(t, int64(7), m.Assignees)
+	require.Equal(t, from, m.From)
+	require.Equal(t, to, m.To)
+}
+
+func Test_Internal_Usage_v88_GetAdminUsageMetrics_OK(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	httpClient := fs.FS.Client()
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+	cfg.APIs.Administration.BaseURL = fs.BaseURL
+
+	svc, err := New(cfg, httpClient, log)
+	require.NoError(t, err)
+
+	clusters, err := svc.GetAdminClusters(ctx)
+	require.NoError(t, err)
+	require.Len(t, clusters, 1)
+	require.Equal(t, "prod", clusters[0].Name)
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	m, err := svc.GetAdminUsageMetrics(ctx, clusters[0].Uuid, from, from.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	require.Equal(t, "c-1", m.ClusterId)
+	require.Equal(t, int64(1200), m.ProcessInstances)
+	require.Equal(t, int64(2), m.Assignees)
+}
+
+func Test_Internal_Usage_v88_GetAdminClusters_NotConfigured(t *testing.T) {
+	ctx := testx.ITCtx(t, 20*time.Second)
+	cfg := testx.TestConfig(t)
+	log := testx.Logger(t)
+
+	fs := testx.NewFakeServer(t)
+	cfg.APIs.Camunda.BaseURL = fs.BaseURL + "/v2"
+	cfg.APIs.Administration.BaseURL = ""
+
+	svc, err := New(cfg, fs.FS.Client(), log)
+	require.NoError(t, err)
+
+	_, err = svc.GetAdminClusters(ctx)
+	require.ErrorIs(t, err, d.ErrBadRequest)
+	require.ErrorIs(t, err, config.ErrNoBaseURL)
+}
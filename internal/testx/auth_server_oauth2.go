@@ -0,0 +1,122 @@
+package testx
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/grafvonb/kamunder/internal/services/common"
+)
+
+// AuthServerOAuth2 issues client credentials tokens and serves APIPath, which only accepts
+// the most recently issued token and echoes the request body.
+// TokenURL is the base the generated token client appends "/token" to.
+type AuthServerOAuth2 struct {
+	TS       *httptest.Server
+	TokenURL string
+	APIURL   string
+
+	mu       sync.Mutex
+	issued   int
+	accepted string
+	lastForm url.Values
+	peerCNs  []string
+}
+
+type OAuth2AuthOpts struct {
+	APIPath   string // default "/api"
+	ExpiresIn int    // expires_in of issued tokens in seconds, omitted if 0
+	// RequireClientCert serves TLS and rejects connections without a client certificate (mTLS).
+	RequireClientCert bool
+}
+
+func StartAuthServerOAuth2(t testing.TB, opts OAuth2AuthOpts) *AuthServerOAuth2 {
+	t.Helper()
+	opts.APIPath = common.DefaultVal(opts.APIPath, "/api")
+
+	s := &AuthServerOAuth2{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "bad form", http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		s.lastForm = r.PostForm
+		if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			s.peerCNs = append(s.peerCNs, r.TLS.PeerCertificates[0].Subject.CommonName)
+		}
+		s.issued++
+		s.accepted = fmt.Sprintf("tok-%d", s.issued)
+		tok := s.accepted
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": tok,
+			"token_type":   "Bearer",
+			"expires_in":   opts.ExpiresIn,
+		})
+	})
+	mux.HandleFunc(opts.APIPath, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		ok := s.accepted != "" && r.Header.Get("Authorization") == "Bearer "+s.accepted
+		s.mu.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = io.Copy(w, r.Body)
+	})
+
+	ts := httptest.NewUnstartedServer(mux)
+	if opts.RequireClientCert {
+		ts.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+		ts.StartTLS()
+	} else {
+		ts.Start()
+	}
+	s.TS = ts
+	s.TokenURL = ts.URL
+	s.APIURL = ts.URL + opts.APIPath
+	return s
+}
+
+// Issued returns the number of tokens handed out so far.
+func (s *AuthServerOAuth2) Issued() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.issued
+}
+
+// LastTokenRequest returns the form of the most recent token request.
+func (s *AuthServerOAuth2) LastTokenRequest() url.Values {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastForm
+}
+
+// ClientCertCNs returns the common names of the client certificates token requests were sent with.
+func (s *AuthServerOAuth2) ClientCertCNs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.peerCNs...)
+}
+
+// Revoke makes the API reject all tokens issued so far, as if they expired early.
+func (s *AuthServerOAuth2) Revoke() {
+	s.mu.Lock()
+	s.accepted = "revoked"
+	s.mu.Unlock()
+}
+
+func (s *AuthServerOAuth2) Close() { s.TS.Close() }
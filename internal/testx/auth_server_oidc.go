@@ -0,0 +1,151 @@
+package testx
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+// AuthServerOIDC is an identity provider for interactive user logins: it authorizes immediately
+// (browser flow with PKCE and device flow) and issues rotating refresh tokens.
+// TokenURL is the base the generated token client appends "/token" to.
+type AuthServerOIDC struct {
+	TS                     *httptest.Server
+	TokenURL               string
+	AuthorizationURL       string
+	DeviceAuthorizationURL string
+
+	mu         sync.Mutex
+	issued     int
+	challenges map[string]string // authorization code -> PKCE challenge
+	refresh    string
+	polls      int
+}
+
+type OIDCAuthOpts struct {
+	Username      string // preferred_username claim of issued access tokens, default "demo"
+	ExpiresIn     int    // expires_in of issued access tokens in seconds, omitted if 0
+	DevicePending int    // device token polls answered with authorization_pending before success
+}
+
+func StartAuthServerOIDC(t testing.TB, opts OIDCAuthOpts) *AuthServerOIDC {
+	t.Helper()
+	if opts.Username == "" {
+		opts.Username = "demo"
+	}
+
+	s := &AuthServerOIDC{challenges: make(map[string]string)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+			http.Error(w, "pkce required", http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		code := fmt.Sprintf("code-%d", len(s.challenges)+1)
+		s.challenges[code] = q.Get("code_challenge")
+		s.mu.Unlock()
+		redirect, err := url.Parse(q.Get("redirect_uri"))
+		if err != nil {
+			http.Error(w, "bad redirect_uri", http.StatusBadRequest)
+			return
+		}
+		rq := redirect.Query()
+		rq.Set("code", code)
+		rq.Set("state", q.Get("state"))
+		redirect.RawQuery = rq.Encode()
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+	})
+	mux.HandleFunc("/auth/device", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code":      "device-1",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": s.TS.URL + "/device",
+			"expires_in":       60,
+			"interval":         1,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			oidcError(w, "invalid_request")
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		switch r.PostForm.Get("grant_type") {
+		case "authorization_code":
+			challenge, ok := s.challenges[r.PostForm.Get("code")]
+			sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+			if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+				oidcError(w, "invalid_grant")
+				return
+			}
+			delete(s.challenges, r.PostForm.Get("code"))
+		case "urn:ietf:params:oauth:grant-type:device_code":
+			if r.PostForm.Get("device_code") != "device-1" {
+				oidcError(w, "invalid_grant")
+				return
+			}
+			if s.polls < opts.DevicePending {
+				s.polls++
+				oidcError(w, "authorization_pending")
+				return
+			}
+		case "refresh_token":
+			if s.refresh == "" || r.PostForm.Get("refresh_token") != s.refresh {
+				oidcError(w, "invalid_grant")
+				return
+			}
+		default:
+			oidcError(w, "unsupported_grant_type")
+			return
+		}
+		s.issued++
+		s.refresh = fmt.Sprintf("refresh-%d", s.issued)
+		claims, _ := json.Marshal(map[string]any{"preferred_username": opts.Username, "n": s.issued})
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "h." + base64.RawURLEncoding.EncodeToString(claims) + ".s",
+			"refresh_token": s.refresh,
+			"token_type":    "Bearer",
+			"expires_in":    opts.ExpiresIn,
+		})
+	})
+
+	ts := httptest.NewServer(mux)
+	s.TS = ts
+	s.TokenURL = ts.URL
+	s.AuthorizationURL = ts.URL + "/auth"
+	s.DeviceAuthorizationURL = ts.URL + "/auth/device"
+	return s
+}
+
+func oidcError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+// Issued returns the number of token sets handed out so far.
+func (s *AuthServerOIDC) Issued() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.issued
+}
+
+// RevokeRefresh makes the current refresh token invalid, as if the SSO session ended.
+func (s *AuthServerOIDC) RevokeRefresh() {
+	s.mu.Lock()
+	s.refresh = ""
+	s.mu.Unlock()
+}
+
+func (s *AuthServerOIDC) Close() { s.TS.Close() }
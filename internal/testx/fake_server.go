@@ -9,6 +9,18 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// Endpoints answering POST with 201 Created (and 207 Multi-Status) instead of 200
+var createdStatus = map[string]int{
+	"/v2/documents":             http.StatusCreated,
+	"/v2/documents/batch":       http.StatusMultiStatus,
+	"/v2/documents/doc-1/links": http.StatusCreated,
+}
+
+// Endpoints accepting DELETE with 204 No Content
+var deletePaths = map[string]bool{
+	"/v2/documents/doc-1": true,
+}
+
 // Predefined responses for collection endpoints
 var collectionResponses = map[string]string{
 	"/App/System": `{
@@ -39,9 +51,336 @@ var objectResponses = map[string]string{
 	  "ReplicationFactor": 1,
 	  "LastCompletedChangeId": ""
 	}`,
+	"/v1/flownode-instances/2251799813690200": `{
+	  "key": 2251799813690200,
+	  "flowNodeId": "Event_WaitForRelease",
+	  "processInstanceKey": 2251799813690099,
+	  "processDefinitionKey": 2251799813686749,
+	  "state": "COMPLETED",
+	  "type": "INTERMEDIATE_CATCH_EVENT",
+	  "tenantId": "customer-service"
+	}`,
+	"/v2/batch-operations/2251799813690401": `{
+	  "batchOperationKey": "2251799813690401",
+	  "batchOperationType": "CANCEL_PROCESS_INSTANCE",
+	  "state": "PARTIALLY_COMPLETED",
+	  "startDate": "2025-10-01T12:00:00Z",
+	  "endDate": "2025-10-01T12:00:05Z",
+	  "operationsTotalCount": 3,
+	  "operationsCompletedCount": 2,
+	  "operationsFailedCount": 1
+	}`,
+	"/v2/batch-operations/2251799813690501": `{
+	  "batchOperationKey": "2251799813690501",
+	  "batchOperationType": "RESOLVE_INCIDENT",
+	  "state": "COMPLETED",
+	  "startDate": "2025-10-01T12:00:00Z",
+	  "endDate": "2025-10-01T12:00:02Z",
+	  "operationsTotalCount": 1,
+	  "operationsCompletedCount": 1,
+	  "operationsFailedCount": 0
+	}`,
+	"/v2/process-instances/2251799813690099": `{
+	  "processInstanceKey": "2251799813690099",
+	  "processDefinitionId": "order-process",
+	  "processDefinitionKey": "2251799813686749",
+	  "processDefinitionName": "Order Process",
+	  "processDefinitionVersion": 2,
+	  "startDate": "2025-10-01T11:00:00Z",
+	  "state": "ACTIVE",
+	  "hasIncident": false,
+	  "tenantId": "customer-service"
+	}`,
+	"/v2/users/alice": `{
+		"username": "alice",
+		"name": "Alice",
+		"email": "alice@example.com"
+	}`,
+	"/v2/status": ``,
+	"/v2/license": `{
+		"validLicense": true,
+		"licenseType": "production",
+		"isCommercial": true,
+		"expiresAt": "2030-01-01T00:00:00Z"
+	}`,
+	"/v2/system/usage-metrics": `{
+		"processInstances": 1200,
+		"decisionInstances": 340,
+		"assignees": 7
+	}`,
+	"/admin-api/clusters": `[
+		{
+			"uuid": "c-1",
+			"name": "prod",
+			"namespace": "camunda-prod",
+			"generation": "8.8.0",
+			"status": "healthy",
+			"type": "automation",
+			"apps": []
+		}
+	]`,
+	"/admin-api/usage-metrics": `{
+		"id": "c-1",
+		"processInstances": {"total": 1200},
+		"decisionInstances": {"total": 340},
+		"taskUsers": {"total": 2, "assignees": ["alice", "bob"]}
+	}`,
+	"/v2/resources/2251799813686749": `{
+	  "resourceId": "new-account-onboarding-workflow",
+	  "resourceKey": "2251799813686749",
+	  "resourceName": "new-account-onboarding-workflow.bpmn",
+	  "tenantId": "customer-service",
+	  "version": 1,
+	  "versionTag": "v1.0.0"
+	}`,
+}
+
+// Predefined raw (non-JSON) responses
+var rawResponses = map[string]string{
+	"/v2/resources/2251799813686749/content": `<bpmn:definitions id="new-account-onboarding-workflow"/>`,
+	"/v2/documents/doc-1":                    `%PDF-1.7 invoice`,
+	"/v1/process-definitions/2251799813686749/xml": `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL" id="order-process">
+  <bpmn:process id="order-process" isExecutable="true">
+    <bpmn:intermediateCatchEvent id="Event_WaitForRelease">
+      <bpmn:signalEventDefinition id="SignalDef_1" signalRef="Signal_Release" />
+    </bpmn:intermediateCatchEvent>
+    <bpmn:intermediateCatchEvent id="Event_WaitForPayment">
+      <bpmn:messageEventDefinition id="MessageDef_1" messageRef="Message_Payment" />
+    </bpmn:intermediateCatchEvent>
+  </bpmn:process>
+  <bpmn:signal id="Signal_Release" name="release-orders" />
+  <bpmn:message id="Message_Payment" name="payment-received" />
+</bpmn:definitions>`,
 }
 
 var createResponses = map[string]string{
+	"/v2/signals/broadcast": `{
+	  "signalKey": "2251799813690300",
+	  "tenantId": "customer-service"
+	}`,
+	"/v1/flownode-instances/search": `{
+	  "items": [
+		{
+		  "key": 2251799813690200,
+		  "flowNodeId": "Event_WaitForRelease",
+		  "processInstanceKey": 2251799813690099,
+		  "processDefinitionKey": 2251799813686749,
+		  "state": "ACTIVE",
+		  "type": "INTERMEDIATE_CATCH_EVENT",
+		  "tenantId": "customer-service"
+		},
+		{
+		  "key": 2251799813690201,
+		  "flowNodeId": "Event_WaitForPayment",
+		  "processInstanceKey": 2251799813690098,
+		  "processDefinitionKey": 2251799813686749,
+		  "state": "ACTIVE",
+		  "type": "INTERMEDIATE_CATCH_EVENT",
+		  "tenantId": "customer-service"
+		}
+	  ],
+	  "total": 2
+	}`,
+	"/v2/messages/publication": `{
+	  "messageKey": "2251799813690001",
+	  "tenantId": "customer-service"
+	}`,
+	"/v2/message-subscriptions/search": `{
+	  "items": [
+		{
+		  "messageSubscriptionKey": "2251799813690101",
+		  "messageName": "payment-received",
+		  "correlationKey": "order-4711",
+		  "elementId": "Activity_WaitForPayment",
+		  "elementInstanceKey": "2251799813690100",
+		  "processDefinitionId": "order-process",
+		  "processDefinitionKey": "2251799813686749",
+		  "processInstanceKey": "2251799813690099",
+		  "messageSubscriptionState": "CREATED",
+		  "lastUpdatedDate": "2025-10-01T12:00:00Z",
+		  "tenantId": "customer-service"
+		}
+	  ],
+	  "page": {"totalItems": 1}
+	}`,
+	"/v2/process-instances/cancellation": `{
+	  "batchOperationKey": "2251799813690401",
+	  "batchOperationType": "CANCEL_PROCESS_INSTANCE"
+	}`,
+	"/v2/batch-operation-items/search": `{
+	  "items": [
+		{
+		  "batchOperationKey": "2251799813690401",
+		  "itemKey": "2251799813690099",
+		  "processInstanceKey": "2251799813690099",
+		  "operationType": "CANCEL_PROCESS_INSTANCE",
+		  "state": "FAILED",
+		  "processedDate": "2025-10-01T12:00:04Z",
+		  "errorMessage": "process instance is not active"
+		}
+	  ],
+	  "page": {"totalItems": 1}
+	}`,
+	"/v2/incidents/search": `{
+	  "items": [
+		{
+		  "incidentKey": "2251799813690601",
+		  "processDefinitionId": "order-process",
+		  "processDefinitionKey": "2251799813686749",
+		  "processInstanceKey": "2251799813690099",
+		  "errorType": "JOB_NO_RETRIES",
+		  "errorMessage": "connection refused",
+		  "elementId": "Activity_ChargeCard",
+		  "elementInstanceKey": "2251799813690200",
+		  "creationTime": "2025-10-01T11:30:00Z",
+		  "state": "ACTIVE",
+		  "jobKey": "2251799813690201",
+		  "tenantId": "customer-service"
+		}
+	  ],
+	  "page": {"totalItems": 1}
+	}`,
+	"/v2/process-instances/incident-resolution": `{
+	  "batchOperationKey": "2251799813690501",
+	  "batchOperationType": "RESOLVE_INCIDENT"
+	}`,
+	"/v2/groups": `{
+		"groupId": "ops",
+		"name": "Operations",
+		"description": "Operations team"
+	}`,
+	"/v2/groups/ops/users/search": `{
+		"items": [
+			{"username": "alice"},
+			{"username": "bob"}
+		],
+		"page": {"totalItems": 2}
+	}`,
+	"/v2/authorizations/search": `{
+		"items": [
+			{
+				"authorizationKey": "2251799813690501",
+				"ownerId": "ops",
+				"ownerType": "GROUP",
+				"resourceType": "PROCESS_DEFINITION",
+				"resourceId": "*",
+				"permissionTypes": ["READ_PROCESS_INSTANCE", "UPDATE_PROCESS_INSTANCE"]
+			}
+		],
+		"page": {"totalItems": 1}
+	}`,
+	"/v2/clock":       ``,
+	"/v2/clock/reset": ``,
+	"/v2/documents": `{
+		"camunda.document.type": "camunda",
+		"storeId": "in-memory",
+		"documentId": "doc-1",
+		"contentHash": "c0ffee",
+		"metadata": {"fileName": "invoice.pdf", "contentType": "application/pdf", "size": 16}
+	}`,
+	"/v2/documents/batch": `{
+		"createdDocuments": [
+			{"camunda.document.type": "camunda", "storeId": "in-memory", "documentId": "doc-1", "contentHash": "c0ffee", "metadata": {"fileName": "invoice.pdf"}}
+		],
+		"failedDocuments": [
+			{"fileName": "huge.bin", "detail": "file too large"}
+		]
+	}`,
+	"/v2/documents/doc-1/links": `{
+		"url": "http://localhost:8080/v2/documents/doc-1?token=abc",
+		"expiresAt": "2030-01-01T00:00:00Z"
+	}`,
+	"/v2/process-instances": `{
+		"processDefinitionId": "invoice-process",
+		"processDefinitionKey": "2251799813686749",
+		"processDefinitionVersion": 3,
+		"processInstanceKey": "2251799813699999",
+		"tenantId": "<default>",
+		"variables": {}
+	}`,
+	"/v2/jobs/activation": `{
+	  "jobs": [
+		{
+		  "jobKey": "2251799813690301",
+		  "type": "shipping",
+		  "kind": "BPMN_ELEMENT",
+		  "listenerEventType": "UNSPECIFIED",
+		  "worker": "kamunder-worker",
+		  "retries": 3,
+		  "deadline": 1759320030000,
+		  "elementId": "Activity_Ship",
+		  "elementInstanceKey": "2251799813690300",
+		  "processDefinitionId": "order-process",
+		  "processDefinitionKey": "2251799813686749",
+		  "processDefinitionVersion": 2,
+		  "processInstanceKey": "2251799813690099",
+		  "customHeaders": {},
+		  "variables": {"orderId": "order-4711"},
+		  "tenantId": "customer-service"
+		}
+	  ]
+	}`,
+	"/v2/jobs/search": `{
+	  "items": [
+		{
+		  "jobKey": "2251799813690201",
+		  "type": "payment-service",
+		  "kind": "BPMN_ELEMENT",
+		  "listenerEventType": "UNSPECIFIED",
+		  "state": "FAILED",
+		  "worker": "payment-worker-1",
+		  "retries": 0,
+		  "hasFailedWithRetriesLeft": false,
+		  "errorMessage": "connection refused",
+		  "elementId": "Activity_ChargeCard",
+		  "elementInstanceKey": "2251799813690200",
+		  "processDefinitionId": "order-process",
+		  "processDefinitionKey": "2251799813686749",
+		  "processInstanceKey": "2251799813690099",
+		  "customHeaders": {},
+		  "tenantId": "customer-service"
+		}
+	  ],
+	  "page": {"totalItems": 1}
+	}`,
+	"/v2/decision-definitions/evaluation": `{
+	  "decisionDefinitionId": "invoiceClassification",
+	  "decisionDefinitionKey": "2251799813326547",
+	  "decisionDefinitionName": "Invoice Classification",
+	  "decisionDefinitionVersion": 1,
+	  "decisionEvaluationKey": "2251799813700001",
+	  "decisionRequirementsId": "invoiceBusinessDecisions",
+	  "decisionRequirementsKey": "2251799813683346",
+	  "failedDecisionDefinitionId": "",
+	  "failureMessage": "",
+	  "output": "\"day-to-day expense\"",
+	  "tenantId": "customer-service",
+	  "evaluatedDecisions": [
+		{
+		  "decisionDefinitionId": "invoiceClassification",
+		  "decisionDefinitionKey": "2251799813326547",
+		  "decisionDefinitionName": "Invoice Classification",
+		  "decisionDefinitionType": "DECISION_TABLE",
+		  "decisionDefinitionVersion": 1,
+		  "decisionEvaluationInstanceKey": "2251799813700001-1",
+		  "output": "\"day-to-day expense\"",
+		  "tenantId": "customer-service",
+		  "evaluatedInputs": [
+			{"inputId": "Input_1", "inputName": "Invoice Amount", "inputValue": "250"}
+		  ],
+		  "matchedRules": [
+			{
+			  "ruleId": "Rule_1",
+			  "ruleIndex": 1,
+			  "evaluatedOutputs": [
+				{"outputId": "Output_1", "outputName": "Classification", "outputValue": "\"day-to-day expense\""}
+			  ]
+			}
+		  ]
+		}
+	  ]
+	}`,
 	"/v2/deployments": `{
 	  "tenantId": "customer-service",
 	  "deploymentKey": "key-2251799813686749",
@@ -116,16 +455,31 @@ func NewFakeServer(t *testing.T) *FakeServer {
 					_, _ = w.Write([]byte(resp))
 					return
 				}
+				if resp, ok := rawResponses[r.URL.Path]; ok {
+					w.Header().Set("Content-Type", "application/octet-stream")
+					_, _ = w.Write([]byte(resp))
+					return
+				}
 				http.NotFound(w, r)
-			case http.MethodPost:
+			case http.MethodPost, http.MethodPut:
 				// accept multipart or json; no parsing needed for tests
 				if resp, ok := createResponses[r.URL.Path]; ok {
+					status := http.StatusOK // Camunda returns 200 for deployments
+					if st, ok := createdStatus[r.URL.Path]; ok {
+						status = st
+					}
 					w.Header().Set("Content-Type", "application/json")
-					w.WriteHeader(http.StatusOK) // Camunda returns 200 for deployments
+					w.WriteHeader(status)
 					_, _ = w.Write([]byte(resp))
 					return
 				}
 				http.NotFound(w, r)
+			case http.MethodDelete:
+				if deletePaths[r.URL.Path] {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				http.NotFound(w, r)
 			default:
 				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
 			}
@@ -42,7 +42,7 @@ func ITHttpClient(t *testing.T, ctx context.Context, cfg *config.Config, log *sl
 		if sharedErr != nil {
 			return
 		}
-		httpSvc.InstallAuthEditor(ator.Editor())
+		httpSvc.InstallAuthenticator(ator)
 		sharedClient = httpSvc.Client()
 	})
 	require.NoError(t, sharedErr)
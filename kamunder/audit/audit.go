@@ -0,0 +1,177 @@
+// Package audit writes an append-only log of mutating operations as JSON lines, to a file or syslog.
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+var ErrNoFile = errors.New("audit log is written to syslog, no file to read")
+
+// Entry is one line of the audit log.
+type Entry struct {
+	Time      time.Time `json:"time"`
+	User      string    `json:"user,omitempty"`
+	Profile   string    `json:"profile,omitempty"`
+	Cluster   string    `json:"cluster,omitempty"`
+	Command   string    `json:"command,omitempty"`
+	Operation string    `json:"operation"`
+	Keys      []string  `json:"keys,omitempty"`
+	Params    any       `json:"params,omitempty"`
+	Before    any       `json:"before,omitempty"`
+	After     any       `json:"after,omitempty"`
+	Outcome   string    `json:"outcome"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Log appends entries to a writer, filling in who ran what against which cluster.
+type Log struct {
+	mu   sync.Mutex
+	w    io.Writer
+	base Entry
+}
+
+// New returns a log writing one JSON line per Write call to w, entries get User, Profile, Cluster and Command of base.
+func New(w io.Writer, base Entry) *Log {
+	return &Log{w: w, base: base}
+}
+
+// Open returns the log configured in cfg.Audit for the current process, or nil if auditing is disabled.
+func Open(cfg *config.Config) (*Log, error) {
+	if !cfg.Audit.Enabled {
+		return nil, nil
+	}
+	base := Entry{
+		User:    CurrentUser(),
+		Profile: cfg.ActiveProfile,
+		Cluster: cfg.APIs.Camunda.BaseURL,
+		Command: CommandLine(os.Args),
+	}
+	if cfg.Audit.Syslog {
+		w, err := newSyslogWriter()
+		if err != nil {
+			return nil, fmt.Errorf("audit syslog: %w", err)
+		}
+		return New(w, base), nil
+	}
+	path, err := cfg.Audit.Path()
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("create audit log dir: %w", err)
+	}
+	// fail before any operation if the log cannot be written
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+	_ = f.Close()
+	return New(fileWriter(path), base), nil
+}
+
+// Record appends e, the time is set if zero.
+func (l *Log) Record(e Entry) error {
+	if e.Time.IsZero() {
+		e.Time = time.Now().UTC()
+	}
+	e.User, e.Profile, e.Cluster, e.Command = l.base.User, l.base.Profile, l.base.Cluster, l.base.Command
+	if e.Outcome == "" {
+		e.Outcome = OutcomeSuccess
+	}
+	b, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("encode audit entry: %w", err)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err = l.w.Write(append(b, '\n'))
+	return err
+}
+
+// fileWriter appends to the file with O_APPEND so concurrent invocations do not interleave lines.
+type fileWriter string
+
+func (p fileWriter) Write(b []byte) (int, error) {
+	f, err := os.OpenFile(string(p), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, fmt.Errorf("open audit log: %w", err)
+	}
+	n, err := f.Write(b)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return n, err
+}
+
+// CurrentUser returns the OS user name, or $USER if it cannot be looked up.
+func CurrentUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+// sensitiveWords mark flags holding secrets, e.g. --password or --auth-oauth2-client-secret,
+// their values are masked in the recorded command line.
+var sensitiveWords = []string{"secret", "password", "token"}
+
+// plainSuffixes name where a secret comes from or how it is handled, not the secret itself,
+// e.g. --auth-oauth2-token-url or --auth-bearer-token-file.
+var plainSuffixes = []string{"-url", "-file", "-env", "-cache"}
+
+func isSensitiveFlag(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range plainSuffixes {
+		if strings.HasSuffix(name, s) {
+			return false
+		}
+	}
+	for _, w := range sensitiveWords {
+		if strings.Contains(name, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// CommandLine joins args like a shell command, masking the values of flags holding secrets.
+func CommandLine(args []string) string {
+	out := make([]string, 0, len(args))
+	mask := false
+	for _, a := range args {
+		switch {
+		case mask:
+			a, mask = "*****", false
+		case strings.HasPrefix(a, "-"):
+			flag, _, hasValue := strings.Cut(a, "=")
+			if isSensitiveFlag(strings.TrimLeft(flag, "-")) {
+				if hasValue {
+					a = flag + "=*****"
+				} else {
+					mask = true
+				}
+			}
+		}
+		if strings.ContainsAny(a, " \t\"'") {
+			a = fmt.Sprintf("%q", a)
+		}
+		out = append(out, a)
+	}
+	return strings.Join(out, " ")
+}
@@ -0,0 +1,85 @@
+package audit_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/grafvonb/kamunder/config"
+	"github.com/grafvonb/kamunder/kamunder/audit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen_RecordAndRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "audit.jsonl")
+	cfg := &config.Config{ActiveProfile: "prod", Audit: config.Audit{Enabled: true, File: path}}
+	cfg.APIs.Camunda.BaseURL = "https://camunda.example.com/v2"
+
+	l, err := audit.Open(cfg)
+	require.NoError(t, err)
+	require.NoError(t, l.Record(audit.Entry{Operation: "CancelProcessInstance", Keys: []string{"1"}, Before: map[string]string{"state": "ACTIVE"}}))
+	require.NoError(t, l.Record(audit.Entry{Operation: "DeleteProcessInstance", Keys: []string{"2"}, Outcome: audit.OutcomeFailure, Error: "not found"}))
+
+	all, err := audit.Read(path, audit.Filter{})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, "prod", all[0].Profile)
+	require.Equal(t, "https://camunda.example.com/v2", all[0].Cluster)
+	require.Equal(t, audit.OutcomeSuccess, all[0].Outcome)
+	require.NotEmpty(t, all[0].Command)
+	require.False(t, all[0].Time.IsZero())
+
+	failed, err := audit.Read(path, audit.Filter{Failed: true})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.Equal(t, []string{"2"}, failed[0].Keys)
+
+	byOp, err := audit.Read(path, audit.Filter{Operation: "cancel", Since: time.Now().Add(-time.Minute)})
+	require.NoError(t, err)
+	require.Len(t, byOp, 1)
+
+	last, err := audit.Read(path, audit.Filter{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, "DeleteProcessInstance", last[0].Operation)
+
+	none, err := audit.Read(filepath.Join(t.TempDir(), "missing.jsonl"), audit.Filter{})
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
+
+func TestOpen_Disabled(t *testing.T) {
+	l, err := audit.Open(&config.Config{})
+	require.NoError(t, err)
+	require.Nil(t, l)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestRecord_WriteError(t *testing.T) {
+	l := audit.New(failingWriter{}, audit.Entry{})
+	require.ErrorContains(t, l.Record(audit.Entry{Operation: "PinClock"}), "disk full")
+}
+
+func TestCommandLine_MasksSecrets(t *testing.T) {
+	got := audit.CommandLine([]string{"kamunder", "--auth-oauth2-client-secret", "s3cr3t", "--auth-cookie-password=pw", "create", "pi", "--vars", `{"a": 1}`})
+	require.Equal(t, `kamunder --auth-oauth2-client-secret ***** --auth-cookie-password=***** create pi --vars "{\"a\": 1}"`, got)
+}
+
+func TestCommandLine_MasksFlagsNamedLikeSecrets(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"kamunder", "identity", "create", "user", "--password", "pw", "--id", "alice"}, "kamunder identity create user --password ***** --id alice"},
+		{[]string{"kamunder", "identity", "create", "user", "--password=pw"}, "kamunder identity create user --password=*****"},
+		{[]string{"kamunder", "--API-TOKEN", "t0k3n", "get", "pi"}, "kamunder --API-TOKEN ***** get pi"},
+		{[]string{"kamunder", "--auth-oauth2-token-url", "https://idp/token", "--auth-oauth2-token-cache", "get", "pi"}, "kamunder --auth-oauth2-token-url https://idp/token --auth-oauth2-token-cache get pi"},
+		{[]string{"kamunder", "--auth-bearer-token-file=/run/token", "get", "pi"}, "kamunder --auth-bearer-token-file=/run/token get pi"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, audit.CommandLine(tt.args))
+	}
+}
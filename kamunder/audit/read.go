@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Filter selects entries in Read, zero values match everything.
+type Filter struct {
+	Since     time.Time
+	User      string
+	Profile   string
+	Operation string // case-insensitive substring, e.g. "cancel"
+	Key       string
+	Failed    bool
+	Limit     int // the newest entries are kept
+}
+
+func (f Filter) match(e Entry) bool {
+	switch {
+	case !f.Since.IsZero() && e.Time.Before(f.Since):
+		return false
+	case f.User != "" && e.User != f.User:
+		return false
+	case f.Profile != "" && !strings.EqualFold(e.Profile, f.Profile):
+		return false
+	case f.Operation != "" && !strings.Contains(strings.ToLower(e.Operation), strings.ToLower(f.Operation)):
+		return false
+	case f.Key != "" && !slices.Contains(e.Keys, f.Key):
+		return false
+	case f.Failed && e.Outcome != OutcomeFailure:
+		return false
+	}
+	return true
+}
+
+// Read returns the entries of the audit log file at path matching f, oldest first.
+// A missing file has no entries, lines that are no valid entries are skipped.
+func Read(path string, f Filter) ([]Entry, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var out []Entry
+	sc := bufio.NewScanner(file)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		var e Entry
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil || e.Operation == "" {
+			continue
+		}
+		if f.match(e) {
+			out = append(out, e)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+	if f.Limit > 0 && len(out) > f.Limit {
+		out = out[len(out)-f.Limit:]
+	}
+	return out, nil
+}
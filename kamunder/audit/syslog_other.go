@@ -0,0 +1,12 @@
+//go:build !unix
+
+package audit
+
+import (
+	"errors"
+	"io"
+)
+
+func newSyslogWriter() (io.Writer, error) {
+	return nil, errors.New("syslog is not supported on this platform")
+}
@@ -0,0 +1,283 @@
+package kamunder
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/audit"
+	"github.com/grafvonb/kamunder/kamunder/batch"
+	"github.com/grafvonb/kamunder/kamunder/decision"
+	"github.com/grafvonb/kamunder/kamunder/document"
+	"github.com/grafvonb/kamunder/kamunder/identity"
+	"github.com/grafvonb/kamunder/kamunder/incident"
+	"github.com/grafvonb/kamunder/kamunder/job"
+	"github.com/grafvonb/kamunder/kamunder/message"
+	"github.com/grafvonb/kamunder/kamunder/options"
+	"github.com/grafvonb/kamunder/kamunder/process"
+	"github.com/grafvonb/kamunder/kamunder/resource"
+	"github.com/grafvonb/kamunder/kamunder/signal"
+)
+
+var _ API = (*auditedClient)(nil)
+
+// auditedClient records every mutating call of the embedded API in the audit log,
+// with the state before and after where it can be fetched by key.
+type auditedClient struct {
+	API
+	audit *audit.Log
+	log   *slog.Logger
+}
+
+func (c *auditedClient) record(op string, keys []string, params, before, after any, err error) {
+	e := audit.Entry{Operation: op, Keys: keys, Params: params, Before: before, After: after}
+	if err != nil {
+		e.Outcome, e.Error, e.After = audit.OutcomeFailure, err.Error(), nil
+	}
+	if werr := c.audit.Record(e); werr != nil {
+		c.log.Error("writing audit log: " + werr.Error())
+	}
+}
+
+// state returns v, or nil if it could not be fetched.
+func state[T any](v T, err error) any {
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+// keys drops empty keys, e.g. of a failed call.
+func keys(ks ...string) []string {
+	out := make([]string, 0, len(ks))
+	for _, k := range ks {
+		if k != "" {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+func (c *auditedClient) CreateProcessInstance(ctx context.Context, data process.ProcessInstanceData, opts ...options.FacadeOption) (process.ProcessInstance, error) {
+	pi, err := c.API.CreateProcessInstance(ctx, data, opts...)
+	c.record("CreateProcessInstance", keys(pi.Key), data, nil, pi, err)
+	return pi, err
+}
+
+func (c *auditedClient) CancelProcessInstance(ctx context.Context, key string, opts ...options.FacadeOption) (process.CancelResponse, error) {
+	before := state(c.API.GetProcessInstanceByKey(ctx, key))
+	resp, err := c.API.CancelProcessInstance(ctx, key, opts...)
+	c.record("CancelProcessInstance", keys(key), nil, before, state(c.API.GetProcessInstanceByKey(ctx, key)), err)
+	return resp, err
+}
+
+func (c *auditedClient) DeleteProcessInstance(ctx context.Context, key string, opts ...options.FacadeOption) (process.ChangeStatus, error) {
+	before := state(c.API.GetProcessInstanceByKey(ctx, key))
+	cs, err := c.API.DeleteProcessInstance(ctx, key, opts...)
+	c.record("DeleteProcessInstance", keys(key), nil, before, cs, err)
+	return cs, err
+}
+
+func (c *auditedClient) CancelProcessInstancesBatch(ctx context.Context, filter process.ProcessInstanceSearchFilterOpts, opts ...options.FacadeOption) (batch.BatchOperation, error) {
+	bo, err := c.API.CancelProcessInstancesBatch(ctx, filter, opts...)
+	c.record("CancelProcessInstancesBatch", keys(bo.Key), filter, nil, bo, err)
+	return bo, err
+}
+
+func (c *auditedClient) CancelBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) error {
+	return c.changeBatchOperation(ctx, "CancelBatchOperation", key, func() error { return c.API.CancelBatchOperation(ctx, key, opts...) })
+}
+
+func (c *auditedClient) SuspendBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) error {
+	return c.changeBatchOperation(ctx, "SuspendBatchOperation", key, func() error { return c.API.SuspendBatchOperation(ctx, key, opts...) })
+}
+
+func (c *auditedClient) ResumeBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) error {
+	return c.changeBatchOperation(ctx, "ResumeBatchOperation", key, func() error { return c.API.ResumeBatchOperation(ctx, key, opts...) })
+}
+
+func (c *auditedClient) changeBatchOperation(ctx context.Context, op, key string, change func() error) error {
+	before := state(c.API.GetBatchOperation(ctx, key))
+	err := change()
+	c.record(op, keys(key), nil, before, state(c.API.GetBatchOperation(ctx, key)), err)
+	return err
+}
+
+// PinClock and ResetClock record no state before, the gateway time is the wall clock and not a previously pinned one,
+// which camunda does not expose.
+func (c *auditedClient) PinClock(ctx context.Context, t time.Time, opts ...options.FacadeOption) error {
+	err := c.API.PinClock(ctx, t, opts...)
+	c.record("PinClock", nil, t, nil, t, err)
+	return err
+}
+
+func (c *auditedClient) ResetClock(ctx context.Context, opts ...options.FacadeOption) error {
+	err := c.API.ResetClock(ctx, opts...)
+	c.record("ResetClock", nil, nil, nil, state(c.API.GetGatewayTime(ctx)), err)
+	return err
+}
+
+// EvaluateDecision is recorded as it creates a decision instance on the cluster.
+func (c *auditedClient) EvaluateDecision(ctx context.Context, ev decision.DecisionEvaluation, opts ...options.FacadeOption) (decision.DecisionEvaluationResult, error) {
+	res, err := c.API.EvaluateDecision(ctx, ev, opts...)
+	c.record("EvaluateDecision", keys(res.DecisionInstanceKey), ev, nil, res, err)
+	return res, err
+}
+
+func (c *auditedClient) CreateDocument(ctx context.Context, u document.DocumentUpload, opts ...options.FacadeOption) (document.DocumentReference, error) {
+	ref, err := c.API.CreateDocument(ctx, u, opts...)
+	c.record("CreateDocument", keys(ref.DocumentId), u.Metadata, nil, ref, err)
+	return ref, err
+}
+
+func (c *auditedClient) CreateDocuments(ctx context.Context, storeId string, us []document.DocumentUpload, opts ...options.FacadeOption) (document.DocumentBatch, error) {
+	b, err := c.API.CreateDocuments(ctx, storeId, us, opts...)
+	ids := make([]string, 0, len(b.Created))
+	for _, ref := range b.Created {
+		ids = append(ids, ref.DocumentId)
+	}
+	c.record("CreateDocuments", keys(ids...), map[string]any{"storeId": storeId, "documents": len(us)}, nil, b, err)
+	return b, err
+}
+
+func (c *auditedClient) DeleteDocument(ctx context.Context, documentId, storeId string, opts ...options.FacadeOption) error {
+	err := c.API.DeleteDocument(ctx, documentId, storeId, opts...)
+	c.record("DeleteDocument", keys(documentId), map[string]any{"storeId": storeId}, nil, nil, err)
+	return err
+}
+
+func (c *auditedClient) CreateDocumentLink(ctx context.Context, documentId string, o document.DocumentLinkOpts, opts ...options.FacadeOption) (document.DocumentLink, error) {
+	l, err := c.API.CreateDocumentLink(ctx, documentId, o, opts...)
+	// the link itself grants access to the document and is not recorded
+	c.record("CreateDocumentLink", keys(documentId), o, nil, map[string]any{"expiresAt": l.ExpiresAt}, err)
+	return l, err
+}
+
+func (c *auditedClient) CreateIdentity(ctx context.Context, x identity.Identity, opts ...options.FacadeOption) (identity.Identity, error) {
+	out, err := c.API.CreateIdentity(ctx, x, opts...)
+	c.record("CreateIdentity", keys(x.Id), nil, nil, out, err)
+	return out, err
+}
+
+func (c *auditedClient) UpdateIdentity(ctx context.Context, x identity.Identity, opts ...options.FacadeOption) (identity.Identity, error) {
+	before := state(c.API.GetIdentity(ctx, x.Kind, x.Id))
+	out, err := c.API.UpdateIdentity(ctx, x, opts...)
+	c.record("UpdateIdentity", keys(x.Id), nil, before, out, err)
+	return out, err
+}
+
+func (c *auditedClient) DeleteIdentity(ctx context.Context, kind identity.Kind, id string, opts ...options.FacadeOption) error {
+	before := state(c.API.GetIdentity(ctx, kind, id))
+	err := c.API.DeleteIdentity(ctx, kind, id, opts...)
+	c.record("DeleteIdentity", keys(id), map[string]any{"kind": kind}, before, nil, err)
+	return err
+}
+
+func (c *auditedClient) AssignIdentity(ctx context.Context, owner identity.Identity, member identity.Identity, opts ...options.FacadeOption) error {
+	err := c.API.AssignIdentity(ctx, owner, member, opts...)
+	c.record("AssignIdentity", keys(owner.Id, member.Id), map[string]any{"owner": owner, "member": member}, nil, nil, err)
+	return err
+}
+
+func (c *auditedClient) UnassignIdentity(ctx context.Context, owner identity.Identity, member identity.Identity, opts ...options.FacadeOption) error {
+	err := c.API.UnassignIdentity(ctx, owner, member, opts...)
+	c.record("UnassignIdentity", keys(owner.Id, member.Id), map[string]any{"owner": owner, "member": member}, nil, nil, err)
+	return err
+}
+
+func (c *auditedClient) CreateAuthorization(ctx context.Context, a identity.Authorization, opts ...options.FacadeOption) (identity.Authorization, error) {
+	out, err := c.API.CreateAuthorization(ctx, a, opts...)
+	c.record("CreateAuthorization", keys(out.Key), a, nil, out, err)
+	return out, err
+}
+
+func (c *auditedClient) UpdateAuthorization(ctx context.Context, a identity.Authorization, opts ...options.FacadeOption) error {
+	before := state(c.API.GetAuthorization(ctx, a.Key))
+	err := c.API.UpdateAuthorization(ctx, a, opts...)
+	c.record("UpdateAuthorization", keys(a.Key), nil, before, a, err)
+	return err
+}
+
+func (c *auditedClient) DeleteAuthorization(ctx context.Context, key string, opts ...options.FacadeOption) error {
+	before := state(c.API.GetAuthorization(ctx, key))
+	err := c.API.DeleteAuthorization(ctx, key, opts...)
+	c.record("DeleteAuthorization", keys(key), nil, before, nil, err)
+	return err
+}
+
+func (c *auditedClient) ResolveIncidents(ctx context.Context, filter incident.IncidentSearchFilterOpts, size int32, opts ...options.FacadeOption) (incident.IncidentResolution, error) {
+	res, err := c.API.ResolveIncidents(ctx, filter, size, opts...)
+	c.record("ResolveIncidents", keys(res.ProcessInstanceKeys...), filter, nil, res, err)
+	return res, err
+}
+
+func (c *auditedClient) UpdateJob(ctx context.Context, key string, u job.JobUpdate, opts ...options.FacadeOption) error {
+	err := c.API.UpdateJob(ctx, key, u, opts...)
+	c.record("UpdateJob", keys(key), u, nil, nil, err)
+	return err
+}
+
+func (c *auditedClient) UpdateJobs(ctx context.Context, ks []string, u job.JobUpdate, opts ...options.FacadeOption) ([]job.JobActionResult, error) {
+	res, err := c.API.UpdateJobs(ctx, ks, u, opts...)
+	c.record("UpdateJobs", keys(ks...), u, nil, res, err)
+	return res, err
+}
+
+func (c *auditedClient) CompleteJob(ctx context.Context, key string, vars map[string]any, opts ...options.FacadeOption) error {
+	err := c.API.CompleteJob(ctx, key, vars, opts...)
+	c.record("CompleteJob", keys(key), vars, nil, nil, err)
+	return err
+}
+
+func (c *auditedClient) FailJob(ctx context.Context, key string, f job.JobFailure, opts ...options.FacadeOption) error {
+	err := c.API.FailJob(ctx, key, f, opts...)
+	c.record("FailJob", keys(key), f, nil, nil, err)
+	return err
+}
+
+func (c *auditedClient) ThrowJobError(ctx context.Context, key string, e job.JobError, opts ...options.FacadeOption) error {
+	err := c.API.ThrowJobError(ctx, key, e, opts...)
+	c.record("ThrowJobError", keys(key), e, nil, nil, err)
+	return err
+}
+
+// ActivateJobs is recorded only if jobs were activated, a polling worker would flood the log otherwise.
+func (c *auditedClient) ActivateJobs(ctx context.Context, a job.JobActivation, opts ...options.FacadeOption) ([]job.ActivatedJob, error) {
+	jobs, err := c.API.ActivateJobs(ctx, a, opts...)
+	if len(jobs) > 0 || (err != nil && ctx.Err() == nil) {
+		ks := make([]string, 0, len(jobs))
+		for _, j := range jobs {
+			ks = append(ks, j.Key)
+		}
+		c.record("ActivateJobs", keys(ks...), a, nil, nil, err)
+	}
+	return jobs, err
+}
+
+func (c *auditedClient) PublishMessage(ctx context.Context, m message.MessagePublication, opts ...options.FacadeOption) (message.MessagePublicationResult, error) {
+	res, err := c.API.PublishMessage(ctx, m, opts...)
+	c.record("PublishMessage", keys(res.MessageKey), m, nil, res, err)
+	return res, err
+}
+
+func (c *auditedClient) CorrelateMessage(ctx context.Context, m message.MessageCorrelation, opts ...options.FacadeOption) (message.MessageCorrelationResult, error) {
+	res, err := c.API.CorrelateMessage(ctx, m, opts...)
+	c.record("CorrelateMessage", keys(res.MessageKey, res.ProcessInstanceKey), m, nil, res, err)
+	return res, err
+}
+
+func (c *auditedClient) DeployProcessDefinition(ctx context.Context, tenantId string, units []resource.DeploymentUnitData, opts ...options.FacadeOption) (resource.ProcessDefinitionDeployment, error) {
+	d, err := c.API.DeployProcessDefinition(ctx, tenantId, units, opts...)
+	names := make([]string, 0, len(units))
+	for _, u := range units {
+		names = append(names, u.Name)
+	}
+	c.record("DeployProcessDefinition", keys(d.Key, d.DefinitionKey), map[string]any{"tenantId": tenantId, "resources": names}, nil, d, err)
+	return d, err
+}
+
+func (c *auditedClient) BroadcastSignal(ctx context.Context, sig signal.SignalBroadcast, opts ...options.FacadeOption) (signal.SignalBroadcastResult, error) {
+	res, err := c.API.BroadcastSignal(ctx, sig, opts...)
+	c.record("BroadcastSignal", keys(res.SignalKey), sig, nil, res, err)
+	return res, err
+}
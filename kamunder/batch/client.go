@@ -0,0 +1,78 @@
+package batch
+
+import (
+	"context"
+
+	bsvc "github.com/grafvonb/kamunder/internal/services/batch"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+	"github.com/grafvonb/kamunder/kamunder/process"
+)
+
+type API interface {
+	SearchBatchOperations(ctx context.Context, filter BatchOperationSearchFilterOpts, size int32, opts ...options.FacadeOption) (BatchOperations, error)
+	GetBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) (BatchOperation, error)
+	SearchBatchOperationItems(ctx context.Context, filter BatchOperationItemSearchFilterOpts, size int32, opts ...options.FacadeOption) (BatchOperationItems, error)
+	CancelBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) error
+	SuspendBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) error
+	ResumeBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) error
+	CancelProcessInstancesBatch(ctx context.Context, filter process.ProcessInstanceSearchFilterOpts, opts ...options.FacadeOption) (BatchOperation, error)
+	WaitForBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) (BatchOperation, error)
+}
+
+type client struct{ api bsvc.API }
+
+func New(api bsvc.API) API { return &client{api: api} }
+
+func (c *client) SearchBatchOperations(ctx context.Context, filter BatchOperationSearchFilterOpts, size int32, opts ...options.FacadeOption) (BatchOperations, error) {
+	bs, err := c.api.SearchBatchOperations(ctx, toDomainBatchOperationFilter(filter), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return BatchOperations{}, ferrors.FromDomain(err)
+	}
+	return fromDomainBatchOperations(bs), nil
+}
+
+func (c *client) GetBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) (BatchOperation, error) {
+	b, err := c.api.GetBatchOperation(ctx, key, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return BatchOperation{}, ferrors.FromDomain(err)
+	}
+	return fromDomainBatchOperation(b), nil
+}
+
+func (c *client) SearchBatchOperationItems(ctx context.Context, filter BatchOperationItemSearchFilterOpts, size int32, opts ...options.FacadeOption) (BatchOperationItems, error) {
+	is, err := c.api.SearchBatchOperationItems(ctx, toDomainBatchOperationItemFilter(filter), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return BatchOperationItems{}, ferrors.FromDomain(err)
+	}
+	return fromDomainBatchOperationItems(is), nil
+}
+
+func (c *client) CancelBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.CancelBatchOperation(ctx, key, options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+func (c *client) SuspendBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.SuspendBatchOperation(ctx, key, options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+func (c *client) ResumeBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.ResumeBatchOperation(ctx, key, options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+// CancelProcessInstancesBatch submits a server-side batch cancellation; with options.WithWait() it returns once the batch finished.
+func (c *client) CancelProcessInstancesBatch(ctx context.Context, filter process.ProcessInstanceSearchFilterOpts, opts ...options.FacadeOption) (BatchOperation, error) {
+	b, err := c.api.CancelProcessInstancesBatch(ctx, toDomainProcessInstanceFilter(filter), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return fromDomainBatchOperation(b), ferrors.FromDomain(err)
+	}
+	return fromDomainBatchOperation(b), nil
+}
+
+func (c *client) WaitForBatchOperation(ctx context.Context, key string, opts ...options.FacadeOption) (BatchOperation, error) {
+	b, err := c.api.WaitForBatchOperation(ctx, key, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return fromDomainBatchOperation(b), ferrors.FromDomain(err)
+	}
+	return fromDomainBatchOperation(b), nil
+}
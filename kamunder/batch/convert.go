@@ -0,0 +1,76 @@
+package batch
+
+import (
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/kamunder/process"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromDomainBatchOperation(x d.BatchOperation) BatchOperation {
+	return BatchOperation{
+		Key:                      x.Key,
+		Type:                     x.Type,
+		State:                    x.State,
+		StartDate:                x.StartDate,
+		EndDate:                  x.EndDate,
+		OperationsTotalCount:     x.OperationsTotalCount,
+		OperationsCompletedCount: x.OperationsCompletedCount,
+		OperationsFailedCount:    x.OperationsFailedCount,
+		Errors:                   x.Errors,
+	}
+}
+
+func fromDomainBatchOperations(xs []d.BatchOperation) BatchOperations {
+	items := toolx.MapSlice(xs, fromDomainBatchOperation)
+	return BatchOperations{
+		Total: int32(len(items)),
+		Items: items,
+	}
+}
+
+func fromDomainBatchOperationItem(x d.BatchOperationItem) BatchOperationItem {
+	return BatchOperationItem{
+		BatchOperationKey:  x.BatchOperationKey,
+		ItemKey:            x.ItemKey,
+		ProcessInstanceKey: x.ProcessInstanceKey,
+		OperationType:      x.OperationType,
+		State:              x.State,
+		ProcessedDate:      x.ProcessedDate,
+		ErrorMessage:       x.ErrorMessage,
+	}
+}
+
+func fromDomainBatchOperationItems(xs []d.BatchOperationItem) BatchOperationItems {
+	items := toolx.MapSlice(xs, fromDomainBatchOperationItem)
+	return BatchOperationItems{
+		Total: int32(len(items)),
+		Items: items,
+	}
+}
+
+func toDomainBatchOperationFilter(x BatchOperationSearchFilterOpts) d.BatchOperationSearchFilterOpts {
+	return d.BatchOperationSearchFilterOpts{
+		Key:   x.Key,
+		Type:  x.Type,
+		State: x.State,
+	}
+}
+
+func toDomainBatchOperationItemFilter(x BatchOperationItemSearchFilterOpts) d.BatchOperationItemSearchFilterOpts {
+	return d.BatchOperationItemSearchFilterOpts{
+		BatchOperationKey:  x.BatchOperationKey,
+		ProcessInstanceKey: x.ProcessInstanceKey,
+		State:              x.State,
+	}
+}
+
+func toDomainProcessInstanceFilter(x process.ProcessInstanceSearchFilterOpts) d.ProcessInstanceSearchFilterOpts {
+	return d.ProcessInstanceSearchFilterOpts{
+		Key:               x.Key,
+		BpmnProcessId:     x.BpmnProcessId,
+		ProcessVersion:    x.ProcessVersion,
+		ProcessVersionTag: x.ProcessVersionTag,
+		State:             d.State(x.State),
+		ParentKey:         x.ParentKey,
+	}
+}
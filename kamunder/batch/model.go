@@ -0,0 +1,54 @@
+package batch
+
+type BatchOperation struct {
+	Key                      string   `json:"key,omitempty"`
+	Type                     string   `json:"type,omitempty"`
+	State                    string   `json:"state,omitempty"`
+	StartDate                string   `json:"startDate,omitempty"`
+	EndDate                  string   `json:"endDate,omitempty"`
+	OperationsTotalCount     int32    `json:"operationsTotalCount,omitempty"`
+	OperationsCompletedCount int32    `json:"operationsCompletedCount,omitempty"`
+	OperationsFailedCount    int32    `json:"operationsFailedCount,omitempty"`
+	Errors                   []string `json:"errors,omitempty"`
+}
+
+// IsFinished reports whether the batch operation reached a terminal state.
+func (b BatchOperation) IsFinished() bool {
+	switch b.State {
+	case "COMPLETED", "PARTIALLY_COMPLETED", "CANCELED", "FAILED":
+		return true
+	}
+	return false
+}
+
+type BatchOperationSearchFilterOpts struct {
+	Key   string `json:"key,omitempty"`
+	Type  string `json:"type,omitempty"`
+	State string `json:"state,omitempty"`
+}
+
+type BatchOperationItem struct {
+	BatchOperationKey  string `json:"batchOperationKey,omitempty"`
+	ItemKey            string `json:"itemKey,omitempty"`
+	ProcessInstanceKey string `json:"processInstanceKey,omitempty"`
+	OperationType      string `json:"operationType,omitempty"`
+	State              string `json:"state,omitempty"`
+	ProcessedDate      string `json:"processedDate,omitempty"`
+	ErrorMessage       string `json:"errorMessage,omitempty"`
+}
+
+type BatchOperationItemSearchFilterOpts struct {
+	BatchOperationKey  string `json:"batchOperationKey,omitempty"`
+	ProcessInstanceKey string `json:"processInstanceKey,omitempty"`
+	State              string `json:"state,omitempty"`
+}
+
+type BatchOperations struct {
+	Total int32            `json:"total,omitempty"`
+	Items []BatchOperation `json:"items,omitempty"`
+}
+
+type BatchOperationItems struct {
+	Total int32                `json:"total,omitempty"`
+	Items []BatchOperationItem `json:"items,omitempty"`
+}
@@ -7,15 +7,36 @@ import (
 	"time"
 
 	"github.com/grafvonb/kamunder/config"
+	bsvc "github.com/grafvonb/kamunder/internal/services/batch"
+	clsvc "github.com/grafvonb/kamunder/internal/services/clock"
 	csvc "github.com/grafvonb/kamunder/internal/services/cluster"
+	dsvc "github.com/grafvonb/kamunder/internal/services/decision"
+	docsvc "github.com/grafvonb/kamunder/internal/services/document"
+	idsvc "github.com/grafvonb/kamunder/internal/services/identity"
+	isvc "github.com/grafvonb/kamunder/internal/services/incident"
+	jsvc "github.com/grafvonb/kamunder/internal/services/job"
+	msvc "github.com/grafvonb/kamunder/internal/services/message"
 	pdsvc "github.com/grafvonb/kamunder/internal/services/processdefinition"
 	pisvc "github.com/grafvonb/kamunder/internal/services/processinstance"
 	rsvc "github.com/grafvonb/kamunder/internal/services/resource"
+	ssvc "github.com/grafvonb/kamunder/internal/services/signal"
+	usvc "github.com/grafvonb/kamunder/internal/services/usage"
+	"github.com/grafvonb/kamunder/kamunder/audit"
 	"github.com/grafvonb/kamunder/kamunder/resource"
 
+	"github.com/grafvonb/kamunder/kamunder/batch"
+	"github.com/grafvonb/kamunder/kamunder/clock"
 	"github.com/grafvonb/kamunder/kamunder/cluster"
+	"github.com/grafvonb/kamunder/kamunder/decision"
+	"github.com/grafvonb/kamunder/kamunder/document"
+	"github.com/grafvonb/kamunder/kamunder/identity"
+	"github.com/grafvonb/kamunder/kamunder/incident"
+	"github.com/grafvonb/kamunder/kamunder/job"
+	"github.com/grafvonb/kamunder/kamunder/message"
 	"github.com/grafvonb/kamunder/kamunder/process"
+	"github.com/grafvonb/kamunder/kamunder/signal"
 	"github.com/grafvonb/kamunder/kamunder/task"
+	"github.com/grafvonb/kamunder/kamunder/usage"
 )
 
 type Option func(*cfg)
@@ -24,6 +45,9 @@ func WithConfig(c *config.Config) Option   { return func(x *cfg) { x.cfg = c } }
 func WithHTTPClient(h *http.Client) Option { return func(x *cfg) { x.http = h } }
 func WithLogger(l *slog.Logger) Option     { return func(x *cfg) { x.log = l } }
 
+// WithAuditLog records mutating calls in l instead of the audit log configured in the config.
+func WithAuditLog(l *audit.Log) Option { return func(x *cfg) { x.audit = l } }
+
 func New(opts ...Option) (API, error) {
 	c := cfg{
 		http: &http.Client{Timeout: 30 * time.Second},
@@ -59,31 +83,101 @@ func New(opts ...Option) (API, error) {
 	if err != nil {
 		return nil, err
 	}
+	dAPI, err := dsvc.New(c.cfg, c.http, c.log)
+	if err != nil {
+		return nil, err
+	}
+	mAPI, err := msvc.New(c.cfg, c.http, c.log)
+	if err != nil {
+		return nil, err
+	}
+	sAPI, err := ssvc.New(c.cfg, c.http, c.log)
+	if err != nil {
+		return nil, err
+	}
+	jAPI, err := jsvc.New(c.cfg, c.http, c.log)
+	if err != nil {
+		return nil, err
+	}
+	bAPI, err := bsvc.New(c.cfg, c.http, c.log)
+	if err != nil {
+		return nil, err
+	}
+	iAPI, err := isvc.New(c.cfg, c.http, c.log)
+	if err != nil {
+		return nil, err
+	}
+	idAPI, err := idsvc.New(c.cfg, c.http, c.log)
+	if err != nil {
+		return nil, err
+	}
+	clAPI, err := clsvc.New(c.cfg, c.http, c.log)
+	if err != nil {
+		return nil, err
+	}
+	uAPI, err := usvc.New(c.cfg, c.http, c.log)
+	if err != nil {
+		return nil, err
+	}
+	docAPI, err := docsvc.New(c.cfg, c.http, c.log)
+	if err != nil {
+		return nil, err
+	}
 
-	return &client{
+	cl := &client{
 		ClusterAPI:  cluster.New(cAPI),
 		ProcessAPI:  process.New(pdAPI, piAPI),
 		TaskAPI:     task.New(pdAPI, piAPI),
 		ResourceAPI: resource.New(rAPI),
+		DecisionAPI: decision.New(dAPI),
+		MessageAPI:  message.New(mAPI),
+		SignalAPI:   signal.New(sAPI),
+		JobAPI:      job.New(jAPI),
+		BatchAPI:    batch.New(bAPI),
+		IncidentAPI: incident.New(iAPI),
+		IdentityAPI: identity.New(idAPI),
+		ClockAPI:    clock.New(clAPI),
+		UsageAPI:    usage.New(uAPI),
+		DocumentAPI: document.New(docAPI),
 		capsFunc: func(context.Context) (Capabilities, error) {
 			return Capabilities{
 				APIVersion: string(c.cfg.APIs.Version),
 				Features:   map[Feature]bool{},
 			}, nil
 		},
-	}, nil
+	}
+	if c.audit == nil {
+		if c.audit, err = audit.Open(c.cfg); err != nil {
+			return nil, err
+		}
+	}
+	if c.audit != nil {
+		return &auditedClient{API: cl, audit: c.audit, log: c.log}, nil
+	}
+	return cl, nil
 }
 
 type cfg struct {
-	cfg  *config.Config
-	http *http.Client
-	log  *slog.Logger
+	cfg   *config.Config
+	http  *http.Client
+	log   *slog.Logger
+	audit *audit.Log
 }
 
 type ClusterAPI = cluster.API
 type ProcessAPI = process.API
 type TaskAPI = task.API
 type ResourceAPI = resource.API
+type DecisionAPI = decision.API
+type MessageAPI = message.API
+type SignalAPI = signal.API
+type JobAPI = job.API
+type BatchAPI = batch.API
+type IncidentAPI = incident.API
+type IdentityAPI = identity.API
+type ClockAPI = clock.API
+type UsageAPI = usage.API
+type DocumentAPI = document.API
 
 var _ API = (*client)(nil)
 
@@ -92,6 +186,16 @@ type client struct {
 	ProcessAPI
 	TaskAPI
 	ResourceAPI
+	DecisionAPI
+	MessageAPI
+	SignalAPI
+	JobAPI
+	BatchAPI
+	IncidentAPI
+	IdentityAPI
+	ClockAPI
+	UsageAPI
+	DocumentAPI
 
 	capsFunc func(context.Context) (Capabilities, error)
 }
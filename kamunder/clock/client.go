@@ -0,0 +1,37 @@
+package clock
+
+import (
+	"context"
+	"time"
+
+	clsvc "github.com/grafvonb/kamunder/internal/services/clock"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+)
+
+type API interface {
+	PinClock(ctx context.Context, t time.Time, opts ...options.FacadeOption) error
+	ResetClock(ctx context.Context, opts ...options.FacadeOption) error
+	GetGatewayTime(ctx context.Context, opts ...options.FacadeOption) (time.Time, error)
+}
+
+type client struct{ api clsvc.API }
+
+func New(api clsvc.API) API { return &client{api: api} }
+
+// PinClock freezes the engine clock at t, e.g. to let timers fire in tests; ResetClock returns to the system time.
+func (c *client) PinClock(ctx context.Context, t time.Time, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.PinClock(ctx, t, options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+func (c *client) ResetClock(ctx context.Context, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.ResetClock(ctx, options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+func (c *client) GetGatewayTime(ctx context.Context, opts ...options.FacadeOption) (time.Time, error) {
+	t, err := c.api.GetGatewayTime(ctx, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return time.Time{}, ferrors.FromDomain(err)
+	}
+	return t, nil
+}
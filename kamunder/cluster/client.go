@@ -2,14 +2,22 @@ package cluster
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
 
+	d "github.com/grafvonb/kamunder/internal/domain"
 	csvc "github.com/grafvonb/kamunder/internal/services/cluster"
 	"github.com/grafvonb/kamunder/kamunder/ferrors"
 	"github.com/grafvonb/kamunder/kamunder/options"
+	"github.com/grafvonb/kamunder/toolx"
 )
 
 type API interface {
 	GetClusterTopology(ctx context.Context, opts ...options.FacadeOption) (Topology, error)
+	GetLicense(ctx context.Context, opts ...options.FacadeOption) (License, error)
+	CheckClusterHealth(ctx context.Context, expect HealthExpectations, opts ...options.FacadeOption) (HealthReport, error)
 }
 
 type client struct{ api csvc.API }
@@ -23,3 +31,47 @@ func (c *client) GetClusterTopology(ctx context.Context, opts ...options.FacadeO
 	}
 	return fromDomainTopology(t), nil
 }
+
+func (c *client) GetLicense(ctx context.Context, opts ...options.FacadeOption) (License, error) {
+	l, err := c.api.GetLicense(ctx, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return License{}, ferrors.FromDomain(err)
+	}
+	return fromDomainLicense(l), nil
+}
+
+// CheckClusterHealth collects status, topology, license and endpoint reachability and evaluates them
+// against expect. Failing checks are reported in the HealthReport, the error is only set if the
+// cluster could not be reached at all.
+func (c *client) CheckClusterHealth(ctx context.Context, expect HealthExpectations, opts ...options.FacadeOption) (HealthReport, error) {
+	callOpts := options.MapFacadeOptionsToCallOptions(opts)
+	var in healthInput
+	status, err := c.api.GetClusterStatus(ctx, callOpts...)
+	in.status, in.statusErr = status, ferrors.FromDomain(err)
+	t, err := c.api.GetClusterTopology(ctx, callOpts...)
+	switch {
+	case unreachable(err):
+		return HealthReport{}, fmt.Errorf("%w: %s", ferrors.ErrUnavailable, err)
+	case err != nil:
+		return HealthReport{}, ferrors.FromDomain(err)
+	}
+	in.topology = fromDomainTopology(t)
+	l, lErr := c.api.GetLicense(ctx, callOpts...)
+	in.license, in.licenseErr = fromDomainLicense(l), ferrors.FromDomain(lErr)
+	if !expect.SkipEndpoints {
+		in.endpoints = toolx.MapSlice(c.api.PingEndpoints(ctx, callOpts...), fromDomainEndpointStatus)
+	}
+	return evaluateHealth(in, expect), nil
+}
+
+// unreachable reports whether err is a transport failure or a 5xx answer, other errors like rejected
+// credentials keep their class so that they do not look like an outage.
+func unreachable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var ue *url.Error
+	var ne net.Error
+	return errors.As(err, &ue) || errors.As(err, &ne) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, d.ErrUnavailable) || errors.Is(err, d.ErrGatewayTimeout) || errors.Is(err, d.ErrUpstream) || errors.Is(err, d.ErrInternal)
+}
@@ -0,0 +1,54 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/internal/services"
+	csvc "github.com/grafvonb/kamunder/internal/services/cluster"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCluster fails the topology request with topologyErr.
+type fakeCluster struct {
+	csvc.API
+	topologyErr error
+}
+
+func (f fakeCluster) GetClusterStatus(context.Context, ...services.CallOption) (bool, error) {
+	return true, nil
+}
+
+func (f fakeCluster) GetClusterTopology(context.Context, ...services.CallOption) (d.Topology, error) {
+	return d.Topology{}, f.topologyErr
+}
+
+func TestCheckClusterHealth_ErrorClass(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		unavailable bool
+	}{
+		{name: "connection refused", err: &url.Error{Op: "Get", URL: "http://zeebe:8080/v2/topology", Err: errors.New("connection refused")}, unavailable: true},
+		{name: "service unavailable", err: fmt.Errorf("%w: status: 503", d.ErrUnavailable), unavailable: true},
+		{name: "bad gateway", err: fmt.Errorf("%w: status: 502", d.ErrUpstream), unavailable: true},
+		{name: "unauthorized", err: fmt.Errorf("%w: status: 401", d.ErrUnauthorized)},
+		{name: "forbidden", err: fmt.Errorf("%w: status: 403", d.ErrForbidden)},
+		{name: "bad request", err: fmt.Errorf("%w: status: 400", d.ErrBadRequest)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(fakeCluster{topologyErr: tt.err})
+
+			_, err := c.CheckClusterHealth(context.Background(), HealthExpectations{SkipEndpoints: true})
+
+			require.Error(t, err)
+			require.Equal(t, tt.unavailable, errors.Is(err, ferrors.ErrUnavailable), "%v", err)
+		})
+	}
+}
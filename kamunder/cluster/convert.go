@@ -33,3 +33,21 @@ func fromDomainPartition(p domain.Partition) Partition {
 		Role:        PartitionRole(p.Role),
 	}
 }
+
+func fromDomainLicense(l domain.License) License {
+	return License{
+		ValidLicense: l.ValidLicense,
+		LicenseType:  l.LicenseType,
+		IsCommercial: l.IsCommercial,
+		ExpiresAt:    l.ExpiresAt,
+	}
+}
+
+func fromDomainEndpointStatus(e domain.EndpointStatus) EndpointStatus {
+	return EndpointStatus{
+		Name:       e.Name,
+		URL:        e.URL,
+		StatusCode: e.StatusCode,
+		Error:      e.Error,
+	}
+}
@@ -0,0 +1,137 @@
+package cluster
+
+import (
+	"fmt"
+	"time"
+)
+
+type TopologyEventType string
+
+const (
+	EventSnapshot       TopologyEventType = "snapshot"
+	EventUnreachable    TopologyEventType = "unreachable"
+	EventReachable      TopologyEventType = "reachable"
+	EventBrokerJoined   TopologyEventType = "broker-joined"
+	EventBrokerLeft     TopologyEventType = "broker-left"
+	EventLeaderChanged  TopologyEventType = "leader-changed"
+	EventHealthChanged  TopologyEventType = "health-changed"
+	EventVersionChanged TopologyEventType = "version-changed"
+	EventClusterChange  TopologyEventType = "cluster-changed"
+)
+
+// TopologyEvent is a single observed difference between two topologies.
+// NodeId and PartitionId are -1 and 0 when the event is not about a broker or partition.
+type TopologyEvent struct {
+	Time        time.Time         `json:"time"`
+	Type        TopologyEventType `json:"type"`
+	NodeId      int32             `json:"nodeId"`
+	PartitionId int32             `json:"partitionId,omitempty"`
+	From        string            `json:"from,omitempty"`
+	To          string            `json:"to,omitempty"`
+	Detail      string            `json:"detail,omitempty"`
+}
+
+func (e TopologyEvent) String() string {
+	s := fmt.Sprintf("%s %-16s", e.Time.Format(time.RFC3339), e.Type)
+	if e.NodeId >= 0 {
+		s += fmt.Sprintf(" broker:%d", e.NodeId)
+	}
+	if e.PartitionId > 0 {
+		s += fmt.Sprintf(" p:%d", e.PartitionId)
+	}
+	if e.From != "" || e.To != "" {
+		s += fmt.Sprintf(" %s -> %s", e.From, e.To)
+	}
+	if e.Detail != "" {
+		s += " " + e.Detail
+	}
+	return s
+}
+
+// DiffTopology returns the events leading from prev to cur: brokers joining or leaving, partition
+// leaders moving, replica health transitions and broker version changes.
+func DiffTopology(prev, cur Topology, at time.Time) []TopologyEvent {
+	var evs []TopologyEvent
+	ev := func(t TopologyEventType, node, partition int32, from, to, detail string) {
+		evs = append(evs, TopologyEvent{Time: at, Type: t, NodeId: node, PartitionId: partition, From: from, To: to, Detail: detail})
+	}
+
+	if prev.ClusterSize != cur.ClusterSize || prev.PartitionsCount != cur.PartitionsCount || prev.ReplicationFactor != cur.ReplicationFactor {
+		ev(EventClusterChange, -1, 0, clusterShape(prev), clusterShape(cur), "")
+	}
+	if prev.GatewayVersion != cur.GatewayVersion {
+		ev(EventVersionChanged, -1, 0, prev.GatewayVersion, cur.GatewayVersion, "gateway")
+	}
+
+	before, after := brokersById(prev), brokersById(cur)
+	for _, b := range cur.Brokers {
+		p, ok := before[b.NodeId]
+		if !ok {
+			ev(EventBrokerJoined, b.NodeId, 0, "", "", fmt.Sprintf("%s:%d version %s", b.Host, b.Port, b.Version))
+			continue
+		}
+		if p.Version != b.Version {
+			ev(EventVersionChanged, b.NodeId, 0, p.Version, b.Version, "")
+		}
+		health := partitionsById(p)
+		for _, part := range b.Partitions {
+			if old, ok := health[part.PartitionId]; ok && old.Health != part.Health {
+				ev(EventHealthChanged, b.NodeId, part.PartitionId, string(old.Health), string(part.Health), "")
+			}
+		}
+	}
+	for _, b := range prev.Brokers {
+		if _, ok := after[b.NodeId]; !ok {
+			ev(EventBrokerLeft, b.NodeId, 0, "", "", fmt.Sprintf("%s:%d", b.Host, b.Port))
+		}
+	}
+
+	oldLeaders, newLeaders := leaders(prev), leaders(cur)
+	for id := int32(1); id <= max(prev.PartitionsCount, cur.PartitionsCount); id++ {
+		from, to := oldLeaders[id], newLeaders[id]
+		if from != to {
+			ev(EventLeaderChanged, -1, id, leaderName(from), leaderName(to), "")
+		}
+	}
+	return evs
+}
+
+func clusterShape(t Topology) string {
+	return fmt.Sprintf("size:%d partitions:%d rf:%d", t.ClusterSize, t.PartitionsCount, t.ReplicationFactor)
+}
+
+func brokersById(t Topology) map[int32]Broker {
+	out := map[int32]Broker{}
+	for _, b := range t.Brokers {
+		out[b.NodeId] = b
+	}
+	return out
+}
+
+func partitionsById(b Broker) map[int32]Partition {
+	out := map[int32]Partition{}
+	for _, p := range b.Partitions {
+		out[p.PartitionId] = p
+	}
+	return out
+}
+
+// leaders maps partition ids to the node id of their leader plus one, so 0 means no leader.
+func leaders(t Topology) map[int32]int32 {
+	out := map[int32]int32{}
+	for _, b := range t.Brokers {
+		for _, p := range b.Partitions {
+			if p.Role == "leader" {
+				out[p.PartitionId] = b.NodeId + 1
+			}
+		}
+	}
+	return out
+}
+
+func leaderName(n int32) string {
+	if n == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("broker:%d", n-1)
+}
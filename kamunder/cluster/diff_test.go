@@ -0,0 +1,110 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffTopology(t *testing.T) {
+	at := time.Date(2025, 10, 1, 8, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		modify func(*Topology)
+		want   []TopologyEvent
+	}{
+		{
+			name:   "unchanged",
+			modify: func(*Topology) {},
+		},
+		{
+			name: "broker joined",
+			modify: func(t *Topology) {
+				t.Brokers = append(t.Brokers, Broker{NodeId: 3, Host: "zeebe-3", Port: 26501, Version: "8.8.0"})
+			},
+			want: []TopologyEvent{{Type: EventBrokerJoined, NodeId: 3, Detail: "zeebe-3:26501 version 8.8.0"}},
+		},
+		{
+			name: "follower left",
+			modify: func(t *Topology) {
+				// broker 2 leads partition 3, move that first so only the leave is reported
+				t.Brokers[0].Partitions[2].Role = "leader"
+				t.Brokers = t.Brokers[:2]
+			},
+			want: []TopologyEvent{
+				{Type: EventBrokerLeft, NodeId: 2, Detail: ":0"},
+				{Type: EventLeaderChanged, NodeId: -1, PartitionId: 3, From: "broker:2", To: "broker:0"},
+			},
+		},
+		{
+			name: "leader moved",
+			modify: func(t *Topology) {
+				t.Brokers[0].Partitions[0].Role = "follower"
+				t.Brokers[1].Partitions[0].Role = "leader"
+			},
+			want: []TopologyEvent{{Type: EventLeaderChanged, NodeId: -1, PartitionId: 1, From: "broker:0", To: "broker:1"}},
+		},
+		{
+			name: "leader lost",
+			modify: func(t *Topology) {
+				t.Brokers[1].Partitions[1].Role = "follower"
+			},
+			want: []TopologyEvent{{Type: EventLeaderChanged, NodeId: -1, PartitionId: 2, From: "broker:1", To: "none"}},
+		},
+		{
+			name: "health transition",
+			modify: func(t *Topology) {
+				t.Brokers[2].Partitions[0].Health = "unhealthy"
+			},
+			want: []TopologyEvent{{Type: EventHealthChanged, NodeId: 2, PartitionId: 1, From: "healthy", To: "unhealthy"}},
+		},
+		{
+			name: "broker version drift",
+			modify: func(t *Topology) {
+				t.Brokers[1].Version = "8.8.1"
+			},
+			want: []TopologyEvent{{Type: EventVersionChanged, NodeId: 1, From: "8.8.0", To: "8.8.1"}},
+		},
+		{
+			name: "gateway version drift",
+			modify: func(t *Topology) {
+				t.GatewayVersion = "8.8.1"
+			},
+			want: []TopologyEvent{{Type: EventVersionChanged, NodeId: -1, From: "8.8.0", To: "8.8.1", Detail: "gateway"}},
+		},
+		{
+			name: "cluster resized",
+			modify: func(t *Topology) {
+				t.PartitionsCount = 4
+			},
+			want: []TopologyEvent{
+				{Type: EventClusterChange, NodeId: -1, From: "size:3 partitions:3 rf:3", To: "size:3 partitions:4 rf:3"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev := healthyInput().topology
+			cur := healthyInput().topology
+			tt.modify(&cur)
+			for i := range tt.want {
+				tt.want[i].Time = at
+			}
+
+			got := DiffTopology(prev, cur, at)
+
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDiffTopology_LeaderRecovered(t *testing.T) {
+	prev := healthyInput().topology
+	prev.Brokers[2].Partitions[2].Role = "follower"
+	cur := healthyInput().topology
+
+	got := DiffTopology(prev, cur, time.Time{})
+
+	require.Equal(t, []TopologyEvent{{Type: EventLeaderChanged, NodeId: -1, PartitionId: 3, From: "none", To: "broker:2"}}, got)
+}
@@ -0,0 +1,225 @@
+package cluster
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+)
+
+type HealthStatus string
+
+const (
+	HealthOK   HealthStatus = "ok"
+	HealthWarn HealthStatus = "warn"
+	HealthFail HealthStatus = "fail"
+	HealthSkip HealthStatus = "skip"
+)
+
+const defaultLicenseExpiryWarning = 30 * 24 * time.Hour
+
+// HealthExpectations tunes CheckClusterHealth. Zero values take the expectation from the topology itself,
+// e.g. Brokers 0 expects as many brokers as the reported cluster size.
+type HealthExpectations struct {
+	Brokers              int32
+	Partitions           int32
+	ReplicationFactor    int32
+	RequireLicense       bool
+	LicenseExpiryWarning time.Duration
+	SkipEndpoints        bool
+}
+
+type HealthCheck struct {
+	Name   string
+	Status HealthStatus
+	Detail string
+}
+
+type HealthReport struct {
+	Status    HealthStatus
+	Checks    []HealthCheck
+	Topology  Topology
+	License   License
+	Endpoints []EndpointStatus
+}
+
+// Err returns ferrors.ErrUnhealthy if a check failed and, with strict, ferrors.ErrDegraded if a check warned.
+func (r HealthReport) Err(strict bool) error {
+	switch {
+	case r.Status == HealthFail:
+		return fmt.Errorf("%w: at least one check failed", ferrors.ErrUnhealthy)
+	case r.Status == HealthWarn && strict:
+		return fmt.Errorf("%w: at least one check reported a warning", ferrors.ErrDegraded)
+	}
+	return nil
+}
+
+type healthInput struct {
+	status     bool
+	statusErr  error
+	topology   Topology
+	license    License
+	licenseErr error
+	endpoints  []EndpointStatus
+}
+
+func evaluateHealth(in healthInput, expect HealthExpectations) HealthReport {
+	t := in.topology
+	r := HealthReport{Topology: t, License: in.license, Endpoints: in.endpoints}
+	add := func(name string, st HealthStatus, format string, args ...any) {
+		r.Checks = append(r.Checks, HealthCheck{Name: name, Status: st, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	switch {
+	case errors.Is(in.statusErr, ferrors.ErrUnsupported):
+		add("gateway-status", HealthSkip, "requires camunda 8.8")
+	case in.statusErr != nil:
+		add("gateway-status", HealthFail, "%v", in.statusErr)
+	case !in.status:
+		add("gateway-status", HealthFail, "gateway reports the cluster as unhealthy")
+	default:
+		add("gateway-status", HealthOK, "gateway reports the cluster as healthy")
+	}
+
+	wantBrokers := expect.Brokers
+	if wantBrokers == 0 {
+		wantBrokers = t.ClusterSize
+	}
+	if n := int32(len(t.Brokers)); n < wantBrokers {
+		add("brokers", HealthFail, "%d of %d brokers in the topology", n, wantBrokers)
+	} else {
+		add("brokers", HealthOK, "%d of %d brokers in the topology", n, wantBrokers)
+	}
+
+	if expect.Partitions != 0 && expect.Partitions != t.PartitionsCount {
+		add("partitions", HealthFail, "%d partitions, expected %d", t.PartitionsCount, expect.Partitions)
+	} else {
+		add("partitions", HealthOK, "%d partitions", t.PartitionsCount)
+	}
+	if expect.ReplicationFactor != 0 && expect.ReplicationFactor != t.ReplicationFactor {
+		add("replication-factor", HealthFail, "replication factor %d, expected %d", t.ReplicationFactor, expect.ReplicationFactor)
+	} else {
+		add("replication-factor", HealthOK, "replication factor %d", t.ReplicationFactor)
+	}
+
+	leaders := map[int32]int{}
+	replicas := map[int32]int{}
+	var unhealthy []string
+	for _, b := range t.Brokers {
+		for _, p := range b.Partitions {
+			if p.Role == "leader" {
+				leaders[p.PartitionId]++
+			}
+			if p.Role != "inactive" {
+				replicas[p.PartitionId]++
+			}
+			if p.Health != "healthy" {
+				unhealthy = append(unhealthy, fmt.Sprintf("p%d@broker%d:%s", p.PartitionId, b.NodeId, p.Health))
+			}
+		}
+	}
+	if len(unhealthy) > 0 {
+		add("partition-health", HealthFail, "unhealthy replicas: %s", strings.Join(unhealthy, ", "))
+	} else {
+		add("partition-health", HealthOK, "all partition replicas healthy")
+	}
+	var leaderless, multiLeader, underReplicated []string
+	for id := int32(1); id <= t.PartitionsCount; id++ {
+		switch leaders[id] {
+		case 0:
+			leaderless = append(leaderless, fmt.Sprintf("p%d", id))
+		case 1:
+		default:
+			multiLeader = append(multiLeader, fmt.Sprintf("p%d", id))
+		}
+		if replicas[id] < int(t.ReplicationFactor) {
+			underReplicated = append(underReplicated, fmt.Sprintf("p%d(%d/%d)", id, replicas[id], t.ReplicationFactor))
+		}
+	}
+	switch {
+	case len(leaderless) > 0:
+		add("partition-leaders", HealthFail, "no leader for %s", strings.Join(leaderless, ", "))
+	case len(multiLeader) > 0:
+		add("partition-leaders", HealthWarn, "more than one leader for %s", strings.Join(multiLeader, ", "))
+	default:
+		add("partition-leaders", HealthOK, "a leader for each of %d partitions", t.PartitionsCount)
+	}
+	if len(underReplicated) > 0 {
+		add("replicas", HealthFail, "under-replicated: %s", strings.Join(underReplicated, ", "))
+	} else {
+		add("replicas", HealthOK, "all partitions fully replicated")
+	}
+
+	versions := map[string]int{}
+	for _, b := range t.Brokers {
+		versions[b.Version]++
+	}
+	switch {
+	case len(versions) > 1:
+		add("versions", HealthWarn, "mixed broker versions: %s", formatVersions(versions))
+	case len(versions) == 1 && t.GatewayVersion != "" && versions[t.GatewayVersion] == 0:
+		add("versions", HealthWarn, "gateway %s, brokers %s", t.GatewayVersion, formatVersions(versions))
+	default:
+		add("versions", HealthOK, "all on %s", t.GatewayVersion)
+	}
+
+	licenseIssue := HealthWarn
+	if expect.RequireLicense {
+		licenseIssue = HealthFail
+	}
+	expiryWarning := expect.LicenseExpiryWarning
+	if expiryWarning == 0 {
+		expiryWarning = defaultLicenseExpiryWarning
+	}
+	l := in.license
+	switch {
+	case in.licenseErr != nil:
+		add("license", licenseIssue, "%v", in.licenseErr)
+	case !l.ValidLicense:
+		add("license", licenseIssue, "no valid license (%s)", l.LicenseType)
+	case l.ExpiresAt != "":
+		exp, err := time.Parse(time.RFC3339, l.ExpiresAt)
+		if err == nil && time.Until(exp) < expiryWarning {
+			add("license", HealthWarn, "%s license expires at %s", l.LicenseType, l.ExpiresAt)
+		} else {
+			add("license", HealthOK, "%s license valid until %s", l.LicenseType, l.ExpiresAt)
+		}
+	default:
+		add("license", HealthOK, "%s license valid", l.LicenseType)
+	}
+
+	for _, e := range in.endpoints {
+		name := "endpoint:" + e.Name
+		switch {
+		case e.Error != "":
+			add(name, HealthFail, "%s unreachable: %s", e.URL, e.Error)
+		case e.StatusCode >= http.StatusInternalServerError:
+			add(name, HealthFail, "%s answered %d", e.URL, e.StatusCode)
+		case e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden:
+			add(name, HealthWarn, "%s reachable, but credentials rejected (%d)", e.URL, e.StatusCode)
+		default:
+			add(name, HealthOK, "%s reachable (%d)", e.URL, e.StatusCode)
+		}
+	}
+
+	r.Status = HealthOK
+	for _, c := range r.Checks {
+		if c.Status == HealthFail || (c.Status == HealthWarn && r.Status == HealthOK) {
+			r.Status = c.Status
+		}
+	}
+	return r
+}
+
+func formatVersions(versions map[string]int) string {
+	var out []string
+	for _, v := range slices.Sorted(maps.Keys(versions)) {
+		out = append(out, fmt.Sprintf("%s (%d)", v, versions[v]))
+	}
+	return strings.Join(out, ", ")
+}
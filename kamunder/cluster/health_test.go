@@ -0,0 +1,211 @@
+package cluster
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/stretchr/testify/require"
+)
+
+// healthyInput is a 3 broker, 3 partition cluster with replication factor 3 where broker i leads partition i+1.
+func healthyInput() healthInput {
+	t := Topology{ClusterSize: 3, PartitionsCount: 3, ReplicationFactor: 3, GatewayVersion: "8.8.0"}
+	for n := int32(0); n < 3; n++ {
+		b := Broker{NodeId: n, Version: "8.8.0"}
+		for id := int32(1); id <= 3; id++ {
+			role := PartitionRole("follower")
+			if id == n+1 {
+				role = "leader"
+			}
+			b.Partitions = append(b.Partitions, Partition{PartitionId: id, Role: role, Health: "healthy"})
+		}
+		t.Brokers = append(t.Brokers, b)
+	}
+	return healthInput{
+		status:   true,
+		topology: t,
+		license:  License{ValidLicense: true, LicenseType: "production", ExpiresAt: time.Now().AddDate(1, 0, 0).Format(time.RFC3339)},
+	}
+}
+
+func checkStatus(r HealthReport, name string) HealthStatus {
+	for _, c := range r.Checks {
+		if c.Name == name {
+			return c.Status
+		}
+	}
+	return ""
+}
+
+func TestEvaluateHealth(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*healthInput)
+		expect HealthExpectations
+		check  string
+		want   HealthStatus
+		status HealthStatus
+	}{
+		{
+			name:   "healthy",
+			modify: func(*healthInput) {},
+			check:  "partition-leaders",
+			want:   HealthOK,
+			status: HealthOK,
+		},
+		{
+			name: "leaderless partition",
+			modify: func(in *healthInput) {
+				in.topology.Brokers[1].Partitions[1].Role = "follower"
+			},
+			check:  "partition-leaders",
+			want:   HealthFail,
+			status: HealthFail,
+		},
+		{
+			name: "two leaders",
+			modify: func(in *healthInput) {
+				in.topology.Brokers[0].Partitions[1].Role = "leader"
+			},
+			check:  "partition-leaders",
+			want:   HealthWarn,
+			status: HealthWarn,
+		},
+		{
+			name: "under-replicated partition",
+			modify: func(in *healthInput) {
+				in.topology.Brokers[2].Partitions[0].Role = "inactive"
+			},
+			check:  "replicas",
+			want:   HealthFail,
+			status: HealthFail,
+		},
+		{
+			name: "missing broker",
+			modify: func(in *healthInput) {
+				in.topology.Brokers = in.topology.Brokers[:2]
+			},
+			check:  "brokers",
+			want:   HealthFail,
+			status: HealthFail,
+		},
+		{
+			name: "unhealthy replica",
+			modify: func(in *healthInput) {
+				in.topology.Brokers[0].Partitions[2].Health = "unhealthy"
+			},
+			check:  "partition-health",
+			want:   HealthFail,
+			status: HealthFail,
+		},
+		{
+			name: "mixed broker versions",
+			modify: func(in *healthInput) {
+				in.topology.Brokers[2].Version = "8.8.1"
+			},
+			check:  "versions",
+			want:   HealthWarn,
+			status: HealthWarn,
+		},
+		{
+			name: "gateway version differs",
+			modify: func(in *healthInput) {
+				in.topology.GatewayVersion = "8.8.1"
+			},
+			check:  "versions",
+			want:   HealthWarn,
+			status: HealthWarn,
+		},
+		{
+			name: "license expires soon",
+			modify: func(in *healthInput) {
+				in.license.ExpiresAt = time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+			},
+			check:  "license",
+			want:   HealthWarn,
+			status: HealthWarn,
+		},
+		{
+			name: "license expiry outside custom warning window",
+			modify: func(in *healthInput) {
+				in.license.ExpiresAt = time.Now().Add(48 * time.Hour).Format(time.RFC3339)
+			},
+			expect: HealthExpectations{LicenseExpiryWarning: 24 * time.Hour},
+			check:  "license",
+			want:   HealthOK,
+			status: HealthOK,
+		},
+		{
+			name: "no license",
+			modify: func(in *healthInput) {
+				in.license = License{LicenseType: "unknown"}
+			},
+			check:  "license",
+			want:   HealthWarn,
+			status: HealthWarn,
+		},
+		{
+			name: "no license required",
+			modify: func(in *healthInput) {
+				in.license = License{LicenseType: "unknown"}
+			},
+			expect: HealthExpectations{RequireLicense: true},
+			check:  "license",
+			want:   HealthFail,
+			status: HealthFail,
+		},
+		{
+			name: "gateway status unsupported on 8.7",
+			modify: func(in *healthInput) {
+				in.statusErr = ferrors.ErrUnsupported
+			},
+			check:  "gateway-status",
+			want:   HealthSkip,
+			status: HealthOK,
+		},
+		{
+			name:   "unexpected replication factor",
+			modify: func(*healthInput) {},
+			expect: HealthExpectations{ReplicationFactor: 1},
+			check:  "replication-factor",
+			want:   HealthFail,
+			status: HealthFail,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := healthyInput()
+			tt.modify(&in)
+
+			r := evaluateHealth(in, tt.expect)
+
+			require.Equal(t, tt.want, checkStatus(r, tt.check), "%+v", r.Checks)
+			require.Equal(t, tt.status, r.Status)
+		})
+	}
+}
+
+func TestHealthReport_Err(t *testing.T) {
+	tests := []struct {
+		status HealthStatus
+		strict bool
+		want   error
+	}{
+		{status: HealthOK},
+		{status: HealthOK, strict: true},
+		{status: HealthWarn},
+		{status: HealthWarn, strict: true, want: ferrors.ErrDegraded},
+		{status: HealthFail, want: ferrors.ErrUnhealthy},
+		{status: HealthFail, strict: true, want: ferrors.ErrUnhealthy},
+	}
+	for _, tt := range tests {
+		err := HealthReport{Status: tt.status}.Err(tt.strict)
+		if tt.want == nil {
+			require.NoError(t, err, "%s strict=%v", tt.status, tt.strict)
+			continue
+		}
+		require.True(t, errors.Is(err, tt.want), "%s strict=%v: %v", tt.status, tt.strict, err)
+	}
+}
@@ -25,3 +25,17 @@ type Partition struct {
 
 type PartitionHealth string
 type PartitionRole string
+
+type License struct {
+	ValidLicense bool
+	LicenseType  string
+	IsCommercial bool
+	ExpiresAt    string
+}
+
+type EndpointStatus struct {
+	Name       string
+	URL        string
+	StatusCode int
+	Error      string
+}
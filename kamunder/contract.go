@@ -3,10 +3,20 @@ package kamunder
 import (
 	"context"
 
+	"github.com/grafvonb/kamunder/kamunder/batch"
+	"github.com/grafvonb/kamunder/kamunder/clock"
 	"github.com/grafvonb/kamunder/kamunder/cluster"
+	"github.com/grafvonb/kamunder/kamunder/decision"
+	"github.com/grafvonb/kamunder/kamunder/document"
+	"github.com/grafvonb/kamunder/kamunder/identity"
+	"github.com/grafvonb/kamunder/kamunder/incident"
+	"github.com/grafvonb/kamunder/kamunder/job"
+	"github.com/grafvonb/kamunder/kamunder/message"
 	"github.com/grafvonb/kamunder/kamunder/process"
 	"github.com/grafvonb/kamunder/kamunder/resource"
+	"github.com/grafvonb/kamunder/kamunder/signal"
 	"github.com/grafvonb/kamunder/kamunder/task"
+	"github.com/grafvonb/kamunder/kamunder/usage"
 )
 
 type API interface {
@@ -15,6 +25,16 @@ type API interface {
 	task.API
 	cluster.API
 	resource.API
+	decision.API
+	message.API
+	signal.API
+	job.API
+	batch.API
+	incident.API
+	identity.API
+	clock.API
+	usage.API
+	document.API
 }
 
 type Capabilities struct {
@@ -0,0 +1,61 @@
+package decision
+
+import (
+	"context"
+
+	dsvc "github.com/grafvonb/kamunder/internal/services/decision"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+)
+
+type API interface {
+	GetDecisionDefinitionByKey(ctx context.Context, key string, opts ...options.FacadeOption) (DecisionDefinition, error)
+	SearchDecisionDefinitions(ctx context.Context, filter DecisionDefinitionSearchFilterOpts, size int32, opts ...options.FacadeOption) (DecisionDefinitions, error)
+	GetDecisionInstanceById(ctx context.Context, id string, opts ...options.FacadeOption) (DecisionInstance, error)
+	SearchDecisionInstances(ctx context.Context, filter DecisionInstanceSearchFilterOpts, size int32, opts ...options.FacadeOption) (DecisionInstances, error)
+	EvaluateDecision(ctx context.Context, ev DecisionEvaluation, opts ...options.FacadeOption) (DecisionEvaluationResult, error)
+}
+
+type client struct{ api dsvc.API }
+
+func New(api dsvc.API) API { return &client{api: api} }
+
+func (c *client) GetDecisionDefinitionByKey(ctx context.Context, key string, opts ...options.FacadeOption) (DecisionDefinition, error) {
+	dd, err := c.api.GetDecisionDefinitionByKey(ctx, key, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return DecisionDefinition{}, ferrors.FromDomain(err)
+	}
+	return fromDomainDecisionDefinition(dd), nil
+}
+
+func (c *client) SearchDecisionDefinitions(ctx context.Context, filter DecisionDefinitionSearchFilterOpts, size int32, opts ...options.FacadeOption) (DecisionDefinitions, error) {
+	dds, err := c.api.SearchDecisionDefinitions(ctx, toDomainDecisionDefinitionFilter(filter), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return DecisionDefinitions{}, ferrors.FromDomain(err)
+	}
+	return fromDomainDecisionDefinitions(dds), nil
+}
+
+func (c *client) GetDecisionInstanceById(ctx context.Context, id string, opts ...options.FacadeOption) (DecisionInstance, error) {
+	di, err := c.api.GetDecisionInstanceById(ctx, id, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return DecisionInstance{}, ferrors.FromDomain(err)
+	}
+	return fromDomainDecisionInstance(di), nil
+}
+
+func (c *client) SearchDecisionInstances(ctx context.Context, filter DecisionInstanceSearchFilterOpts, size int32, opts ...options.FacadeOption) (DecisionInstances, error) {
+	dis, err := c.api.SearchDecisionInstances(ctx, toDomainDecisionInstanceFilter(filter), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return DecisionInstances{}, ferrors.FromDomain(err)
+	}
+	return fromDomainDecisionInstances(dis), nil
+}
+
+func (c *client) EvaluateDecision(ctx context.Context, ev DecisionEvaluation, opts ...options.FacadeOption) (DecisionEvaluationResult, error) {
+	r, err := c.api.EvaluateDecision(ctx, toDomainDecisionEvaluation(ev), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return DecisionEvaluationResult{}, ferrors.FromDomain(err)
+	}
+	return fromDomainDecisionEvaluationResult(r), nil
+}
@@ -0,0 +1,133 @@
+package decision
+
+import (
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromDomainDecisionDefinition(x d.DecisionDefinition) DecisionDefinition {
+	return DecisionDefinition{
+		DecisionId:                  x.DecisionId,
+		DecisionRequirementsId:      x.DecisionRequirementsId,
+		DecisionRequirementsKey:     x.DecisionRequirementsKey,
+		DecisionRequirementsName:    x.DecisionRequirementsName,
+		DecisionRequirementsVersion: x.DecisionRequirementsVersion,
+		Key:                         x.Key,
+		Name:                        x.Name,
+		TenantId:                    x.TenantId,
+		Version:                     x.Version,
+	}
+}
+
+func fromDomainDecisionDefinitions(xs []d.DecisionDefinition) DecisionDefinitions {
+	items := toolx.MapSlice(xs, fromDomainDecisionDefinition)
+	return DecisionDefinitions{
+		Total: int32(len(items)),
+		Items: items,
+	}
+}
+
+func fromDomainDecisionInstance(x d.DecisionInstance) DecisionInstance {
+	return DecisionInstance{
+		DecisionDefinitionId: x.DecisionDefinitionId,
+		DecisionId:           x.DecisionId,
+		DecisionName:         x.DecisionName,
+		DecisionType:         x.DecisionType,
+		DecisionVersion:      x.DecisionVersion,
+		EvaluatedInputs:      toolx.MapSlice(x.EvaluatedInputs, fromDomainDecisionInput),
+		EvaluatedOutputs:     toolx.MapSlice(x.EvaluatedOutputs, fromDomainDecisionOutput),
+		EvaluationDate:       x.EvaluationDate,
+		EvaluationFailure:    x.EvaluationFailure,
+		Id:                   x.Id,
+		Key:                  x.Key,
+		ProcessDefinitionKey: x.ProcessDefinitionKey,
+		ProcessInstanceKey:   x.ProcessInstanceKey,
+		Result:               x.Result,
+		State:                x.State,
+		TenantId:             x.TenantId,
+	}
+}
+
+func fromDomainDecisionInstances(xs []d.DecisionInstance) DecisionInstances {
+	items := toolx.MapSlice(xs, fromDomainDecisionInstance)
+	return DecisionInstances{
+		Total: int32(len(items)),
+		Items: items,
+	}
+}
+
+func fromDomainDecisionInput(x d.DecisionInput) DecisionInput {
+	return DecisionInput{
+		Id:    x.Id,
+		Name:  x.Name,
+		Value: x.Value,
+	}
+}
+
+func fromDomainDecisionOutput(x d.DecisionOutput) DecisionOutput {
+	return DecisionOutput{
+		Id:        x.Id,
+		Name:      x.Name,
+		RuleId:    x.RuleId,
+		RuleIndex: x.RuleIndex,
+		Value:     x.Value,
+	}
+}
+
+func fromDomainDecisionEvaluationResult(x d.DecisionEvaluationResult) DecisionEvaluationResult {
+	return DecisionEvaluationResult{
+		DecisionId:             x.DecisionId,
+		DecisionKey:            x.DecisionKey,
+		DecisionName:           x.DecisionName,
+		DecisionVersion:        x.DecisionVersion,
+		DecisionInstanceKey:    x.DecisionInstanceKey,
+		DecisionRequirementsId: x.DecisionRequirementsId,
+		EvaluatedDecisions:     toolx.MapSlice(x.EvaluatedDecisions, fromDomainEvaluatedDecision),
+		FailedDecisionId:       x.FailedDecisionId,
+		FailureMessage:         x.FailureMessage,
+		Output:                 x.Output,
+		TenantId:               x.TenantId,
+	}
+}
+
+func fromDomainEvaluatedDecision(x d.EvaluatedDecision) EvaluatedDecision {
+	return EvaluatedDecision{
+		DecisionId:       x.DecisionId,
+		DecisionKey:      x.DecisionKey,
+		DecisionName:     x.DecisionName,
+		DecisionType:     x.DecisionType,
+		DecisionVersion:  x.DecisionVersion,
+		EvaluatedInputs:  toolx.MapSlice(x.EvaluatedInputs, fromDomainDecisionInput),
+		EvaluatedOutputs: toolx.MapSlice(x.EvaluatedOutputs, fromDomainDecisionOutput),
+		Output:           x.Output,
+	}
+}
+
+func toDomainDecisionDefinitionFilter(x DecisionDefinitionSearchFilterOpts) d.DecisionDefinitionSearchFilterOpts {
+	return d.DecisionDefinitionSearchFilterOpts{
+		Key:                     x.Key,
+		DecisionId:              x.DecisionId,
+		Name:                    x.Name,
+		Version:                 x.Version,
+		DecisionRequirementsKey: x.DecisionRequirementsKey,
+	}
+}
+
+func toDomainDecisionInstanceFilter(x DecisionInstanceSearchFilterOpts) d.DecisionInstanceSearchFilterOpts {
+	return d.DecisionInstanceSearchFilterOpts{
+		DecisionId:           x.DecisionId,
+		DecisionDefinitionId: x.DecisionDefinitionId,
+		DecisionVersion:      x.DecisionVersion,
+		ProcessInstanceKey:   x.ProcessInstanceKey,
+		State:                x.State,
+	}
+}
+
+func toDomainDecisionEvaluation(x DecisionEvaluation) d.DecisionEvaluation {
+	return d.DecisionEvaluation{
+		DecisionId:  x.DecisionId,
+		DecisionKey: x.DecisionKey,
+		TenantId:    x.TenantId,
+		Variables:   x.Variables,
+	}
+}
@@ -0,0 +1,104 @@
+package decision
+
+type DecisionDefinition struct {
+	DecisionId                  string `json:"decisionId,omitempty"`
+	DecisionRequirementsId      string `json:"decisionRequirementsId,omitempty"`
+	DecisionRequirementsKey     string `json:"decisionRequirementsKey,omitempty"`
+	DecisionRequirementsName    string `json:"decisionRequirementsName,omitempty"`
+	DecisionRequirementsVersion int32  `json:"decisionRequirementsVersion,omitempty"`
+	Key                         string `json:"key,omitempty"`
+	Name                        string `json:"name,omitempty"`
+	TenantId                    string `json:"tenantId,omitempty"`
+	Version                     int32  `json:"version,omitempty"`
+}
+
+type DecisionDefinitionSearchFilterOpts struct {
+	Key                     string `json:"key,omitempty"`
+	DecisionId              string `json:"decisionId,omitempty"`
+	Name                    string `json:"name,omitempty"`
+	Version                 int32  `json:"version,omitempty"`
+	DecisionRequirementsKey string `json:"decisionRequirementsKey,omitempty"`
+}
+
+type DecisionInstance struct {
+	DecisionDefinitionId string           `json:"decisionDefinitionId,omitempty"`
+	DecisionId           string           `json:"decisionId,omitempty"`
+	DecisionName         string           `json:"decisionName,omitempty"`
+	DecisionType         string           `json:"decisionType,omitempty"`
+	DecisionVersion      int32            `json:"decisionVersion,omitempty"`
+	EvaluatedInputs      []DecisionInput  `json:"evaluatedInputs,omitempty"`
+	EvaluatedOutputs     []DecisionOutput `json:"evaluatedOutputs,omitempty"`
+	EvaluationDate       string           `json:"evaluationDate,omitempty"`
+	EvaluationFailure    string           `json:"evaluationFailure,omitempty"`
+	Id                   string           `json:"id,omitempty"`
+	Key                  string           `json:"key,omitempty"`
+	ProcessDefinitionKey string           `json:"processDefinitionKey,omitempty"`
+	ProcessInstanceKey   string           `json:"processInstanceKey,omitempty"`
+	Result               string           `json:"result,omitempty"`
+	State                string           `json:"state,omitempty"`
+	TenantId             string           `json:"tenantId,omitempty"`
+}
+
+type DecisionDefinitions struct {
+	Total int32                `json:"total,omitempty"`
+	Items []DecisionDefinition `json:"items,omitempty"`
+}
+
+type DecisionInstances struct {
+	Total int32              `json:"total,omitempty"`
+	Items []DecisionInstance `json:"items,omitempty"`
+}
+
+type DecisionInstanceSearchFilterOpts struct {
+	DecisionId           string `json:"decisionId,omitempty"`
+	DecisionDefinitionId string `json:"decisionDefinitionId,omitempty"`
+	DecisionVersion      int32  `json:"decisionVersion,omitempty"`
+	ProcessInstanceKey   string `json:"processInstanceKey,omitempty"`
+	State                string `json:"state,omitempty"`
+}
+
+type DecisionInput struct {
+	Id    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type DecisionOutput struct {
+	Id        string `json:"id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	RuleId    string `json:"ruleId,omitempty"`
+	RuleIndex int32  `json:"ruleIndex,omitempty"`
+	Value     string `json:"value,omitempty"`
+}
+
+type DecisionEvaluation struct {
+	DecisionId  string         `json:"decisionId,omitempty"`
+	DecisionKey string         `json:"decisionKey,omitempty"`
+	TenantId    string         `json:"tenantId,omitempty"`
+	Variables   map[string]any `json:"variables,omitempty"`
+}
+
+type DecisionEvaluationResult struct {
+	DecisionId             string              `json:"decisionId,omitempty"`
+	DecisionKey            string              `json:"decisionKey,omitempty"`
+	DecisionName           string              `json:"decisionName,omitempty"`
+	DecisionVersion        int32               `json:"decisionVersion,omitempty"`
+	DecisionInstanceKey    string              `json:"decisionInstanceKey,omitempty"`
+	DecisionRequirementsId string              `json:"decisionRequirementsId,omitempty"`
+	EvaluatedDecisions     []EvaluatedDecision `json:"evaluatedDecisions,omitempty"`
+	FailedDecisionId       string              `json:"failedDecisionId,omitempty"`
+	FailureMessage         string              `json:"failureMessage,omitempty"`
+	Output                 string              `json:"output,omitempty"`
+	TenantId               string              `json:"tenantId,omitempty"`
+}
+
+type EvaluatedDecision struct {
+	DecisionId       string           `json:"decisionId,omitempty"`
+	DecisionKey      string           `json:"decisionKey,omitempty"`
+	DecisionName     string           `json:"decisionName,omitempty"`
+	DecisionType     string           `json:"decisionType,omitempty"`
+	DecisionVersion  int32            `json:"decisionVersion,omitempty"`
+	EvaluatedInputs  []DecisionInput  `json:"evaluatedInputs,omitempty"`
+	EvaluatedOutputs []DecisionOutput `json:"evaluatedOutputs,omitempty"`
+	Output           string           `json:"output,omitempty"`
+}
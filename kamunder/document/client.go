@@ -0,0 +1,61 @@
+package document
+
+import (
+	"context"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	docsvc "github.com/grafvonb/kamunder/internal/services/document"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type API interface {
+	CreateDocument(ctx context.Context, u DocumentUpload, opts ...options.FacadeOption) (DocumentReference, error)
+	CreateDocuments(ctx context.Context, storeId string, us []DocumentUpload, opts ...options.FacadeOption) (DocumentBatch, error)
+	GetDocument(ctx context.Context, documentId, storeId, contentHash string, opts ...options.FacadeOption) ([]byte, error)
+	DeleteDocument(ctx context.Context, documentId, storeId string, opts ...options.FacadeOption) error
+	CreateDocumentLink(ctx context.Context, documentId string, o DocumentLinkOpts, opts ...options.FacadeOption) (DocumentLink, error)
+}
+
+type client struct{ api docsvc.API }
+
+func New(api docsvc.API) API { return &client{api: api} }
+
+func (c *client) CreateDocument(ctx context.Context, u DocumentUpload, opts ...options.FacadeOption) (DocumentReference, error) {
+	ref, err := c.api.CreateDocument(ctx, toDomainDocumentUpload(u), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return DocumentReference{}, ferrors.FromDomain(err)
+	}
+	return fromDomainDocumentReference(ref), nil
+}
+
+// CreateDocuments uploads all documents in one request; the error is only set if the request failed as a whole.
+func (c *client) CreateDocuments(ctx context.Context, storeId string, us []DocumentUpload, opts ...options.FacadeOption) (DocumentBatch, error) {
+	b, err := c.api.CreateDocuments(ctx, storeId, toolx.MapSlice(us, toDomainDocumentUpload), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return DocumentBatch{}, ferrors.FromDomain(err)
+	}
+	return fromDomainDocumentBatch(b), nil
+}
+
+func (c *client) GetDocument(ctx context.Context, documentId, storeId, contentHash string, opts ...options.FacadeOption) ([]byte, error) {
+	content, err := c.api.GetDocument(ctx, documentId, storeId, contentHash, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return nil, ferrors.FromDomain(err)
+	}
+	return content, nil
+}
+
+func (c *client) DeleteDocument(ctx context.Context, documentId, storeId string, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.DeleteDocument(ctx, documentId, storeId, options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+func (c *client) CreateDocumentLink(ctx context.Context, documentId string, o DocumentLinkOpts, opts ...options.FacadeOption) (DocumentLink, error) {
+	r := d.DocumentLinkRequest{StoreId: o.StoreId, ContentHash: o.ContentHash, TimeToLive: o.TimeToLive}
+	l, err := c.api.CreateDocumentLink(ctx, documentId, r, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return DocumentLink{}, ferrors.FromDomain(err)
+	}
+	return DocumentLink{Url: l.Url, ExpiresAt: l.ExpiresAt}, nil
+}
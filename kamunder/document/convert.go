@@ -0,0 +1,58 @@
+package document
+
+import (
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromDomainDocumentReference(x d.DocumentReference) DocumentReference {
+	return DocumentReference{
+		DocumentType: DocumentTypeCamunda,
+		StoreId:      x.StoreId,
+		DocumentId:   x.DocumentId,
+		ContentHash:  x.ContentHash,
+		Metadata:     fromDomainDocumentMetadata(x.Metadata),
+	}
+}
+
+func fromDomainDocumentMetadata(x d.DocumentMetadata) DocumentMetadata {
+	return DocumentMetadata{
+		ContentType:         x.ContentType,
+		FileName:            x.FileName,
+		Size:                x.Size,
+		ExpiresAt:           x.ExpiresAt,
+		ProcessDefinitionId: x.ProcessDefinitionId,
+		ProcessInstanceKey:  x.ProcessInstanceKey,
+		CustomProperties:    x.CustomProperties,
+	}
+}
+
+func toDomainDocumentMetadata(x DocumentMetadata) d.DocumentMetadata {
+	return d.DocumentMetadata{
+		ContentType:         x.ContentType,
+		FileName:            x.FileName,
+		Size:                x.Size,
+		ExpiresAt:           x.ExpiresAt,
+		ProcessDefinitionId: x.ProcessDefinitionId,
+		ProcessInstanceKey:  x.ProcessInstanceKey,
+		CustomProperties:    x.CustomProperties,
+	}
+}
+
+func toDomainDocumentUpload(x DocumentUpload) d.DocumentUpload {
+	return d.DocumentUpload{
+		StoreId:    x.StoreId,
+		DocumentId: x.DocumentId,
+		Data:       x.Data,
+		Metadata:   toDomainDocumentMetadata(x.Metadata),
+	}
+}
+
+func fromDomainDocumentBatch(x d.DocumentBatch) DocumentBatch {
+	return DocumentBatch{
+		Created: toolx.MapSlice(x.Created, fromDomainDocumentReference),
+		Failed: toolx.MapSlice(x.Failed, func(f d.DocumentCreationFailure) DocumentCreationFailure {
+			return DocumentCreationFailure{FileName: f.FileName, Detail: f.Detail}
+		}),
+	}
+}
@@ -0,0 +1,56 @@
+package document
+
+import "time"
+
+// DocumentTypeCamunda marks a variable value as reference to a document of the cluster's document store.
+const DocumentTypeCamunda = "camunda"
+
+// DocumentReference serializes to the reference format the engine and connectors expect,
+// so it can be passed as process variable as is, e.g. when creating a process instance.
+type DocumentReference struct {
+	DocumentType string           `json:"camunda.document.type"`
+	StoreId      string           `json:"storeId,omitempty"`
+	DocumentId   string           `json:"documentId"`
+	ContentHash  string           `json:"contentHash,omitempty"`
+	Metadata     DocumentMetadata `json:"metadata"`
+}
+
+type DocumentMetadata struct {
+	ContentType         string         `json:"contentType,omitempty"`
+	FileName            string         `json:"fileName,omitempty"`
+	Size                int64          `json:"size,omitempty"`
+	ExpiresAt           string         `json:"expiresAt,omitempty"`
+	ProcessDefinitionId string         `json:"processDefinitionId,omitempty"`
+	ProcessInstanceKey  string         `json:"processInstanceKey,omitempty"`
+	CustomProperties    map[string]any `json:"customProperties,omitempty"`
+}
+
+// DocumentUpload is a file to store. StoreId and DocumentId are optional, the cluster picks defaults.
+type DocumentUpload struct {
+	StoreId    string
+	DocumentId string
+	Data       []byte
+	Metadata   DocumentMetadata
+}
+
+type DocumentCreationFailure struct {
+	FileName string `json:"fileName,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+}
+
+type DocumentBatch struct {
+	Created []DocumentReference       `json:"created,omitempty"`
+	Failed  []DocumentCreationFailure `json:"failed,omitempty"`
+}
+
+type DocumentLink struct {
+	Url       string `json:"url,omitempty"`
+	ExpiresAt string `json:"expiresAt,omitempty"`
+}
+
+type DocumentLinkOpts struct {
+	StoreId     string
+	ContentHash string
+	// TimeToLive of the link, the store default if 0.
+	TimeToLive time.Duration
+}
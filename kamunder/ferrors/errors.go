@@ -19,6 +19,9 @@ var (
 	ErrConflict     = errors.New("conflict")
 	ErrUnavailable  = errors.New("service unavailable")
 	ErrInternal     = errors.New("internal error")
+	ErrUnsupported  = errors.New("operation not supported")
+	ErrUnhealthy    = errors.New("cluster unhealthy")
+	ErrDegraded     = errors.New("cluster degraded")
 )
 
 func FromDomain(err error) error {
@@ -33,6 +36,8 @@ func FromDomain(err error) error {
 		return fmt.Errorf("%w: %s", ErrNotFound, err)
 	case errors.Is(err, domain.ErrConflict):
 		return fmt.Errorf("%w: %s", ErrConflict, err)
+	case errors.Is(err, domain.ErrNotSupported):
+		return fmt.Errorf("%w: %s", ErrUnsupported, err)
 	case errors.Is(err, domain.ErrGatewayTimeout) || errors.Is(err, context.DeadlineExceeded):
 		return fmt.Errorf("%w: %s", ErrTimeout, err)
 	case errors.Is(err, domain.ErrUnavailable) || errors.Is(err, context.Canceled):
@@ -53,7 +58,7 @@ func HandleAndExit(log *slog.Logger, err error) {
 	}
 	log.Error(err.Error())
 	switch {
-	case errors.Is(err, ErrBadRequest):
+	case errors.Is(err, ErrBadRequest), errors.Is(err, ErrUnsupported):
 		os.Exit(exitcode.InvalidArgs)
 	case errors.Is(err, ErrNotFound):
 		os.Exit(exitcode.NotFound)
@@ -63,6 +68,10 @@ func HandleAndExit(log *slog.Logger, err error) {
 		os.Exit(exitcode.Unavailable)
 	case errors.Is(err, ErrConflict):
 		os.Exit(exitcode.Conflict)
+	case errors.Is(err, ErrUnhealthy):
+		os.Exit(exitcode.Unhealthy)
+	case errors.Is(err, ErrDegraded):
+		os.Exit(exitcode.Degraded)
 	default:
 		os.Exit(exitcode.Error)
 	}
@@ -0,0 +1,116 @@
+package identity
+
+import (
+	"context"
+
+	d "github.com/grafvonb/kamunder/internal/domain"
+	idsvc "github.com/grafvonb/kamunder/internal/services/identity"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+)
+
+type API interface {
+	SearchIdentities(ctx context.Context, kind Kind, size int32, opts ...options.FacadeOption) (Identities, error)
+	GetIdentity(ctx context.Context, kind Kind, id string, opts ...options.FacadeOption) (Identity, error)
+	CreateIdentity(ctx context.Context, x Identity, opts ...options.FacadeOption) (Identity, error)
+	UpdateIdentity(ctx context.Context, x Identity, opts ...options.FacadeOption) (Identity, error)
+	DeleteIdentity(ctx context.Context, kind Kind, id string, opts ...options.FacadeOption) error
+	SearchIdentityMembers(ctx context.Context, owner Identity, kind Kind, size int32, opts ...options.FacadeOption) (Identities, error)
+	AssignIdentity(ctx context.Context, owner Identity, member Identity, opts ...options.FacadeOption) error
+	UnassignIdentity(ctx context.Context, owner Identity, member Identity, opts ...options.FacadeOption) error
+	SearchAuthorizations(ctx context.Context, filter AuthorizationSearchFilterOpts, size int32, opts ...options.FacadeOption) (Authorizations, error)
+	GetAuthorization(ctx context.Context, key string, opts ...options.FacadeOption) (Authorization, error)
+	CreateAuthorization(ctx context.Context, a Authorization, opts ...options.FacadeOption) (Authorization, error)
+	UpdateAuthorization(ctx context.Context, a Authorization, opts ...options.FacadeOption) error
+	DeleteAuthorization(ctx context.Context, key string, opts ...options.FacadeOption) error
+}
+
+type client struct{ api idsvc.API }
+
+func New(api idsvc.API) API { return &client{api: api} }
+
+func (c *client) SearchIdentities(ctx context.Context, kind Kind, size int32, opts ...options.FacadeOption) (Identities, error) {
+	xs, err := c.api.SearchIdentities(ctx, d.IdentityKind(kind), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return Identities{}, ferrors.FromDomain(err)
+	}
+	return fromDomainIdentities(xs), nil
+}
+
+func (c *client) GetIdentity(ctx context.Context, kind Kind, id string, opts ...options.FacadeOption) (Identity, error) {
+	x, err := c.api.GetIdentity(ctx, d.IdentityKind(kind), id, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return Identity{}, ferrors.FromDomain(err)
+	}
+	return fromDomainIdentity(x), nil
+}
+
+func (c *client) CreateIdentity(ctx context.Context, x Identity, opts ...options.FacadeOption) (Identity, error) {
+	r, err := c.api.CreateIdentity(ctx, toDomainIdentity(x), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return Identity{}, ferrors.FromDomain(err)
+	}
+	return fromDomainIdentity(r), nil
+}
+
+func (c *client) UpdateIdentity(ctx context.Context, x Identity, opts ...options.FacadeOption) (Identity, error) {
+	r, err := c.api.UpdateIdentity(ctx, toDomainIdentity(x), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return Identity{}, ferrors.FromDomain(err)
+	}
+	return fromDomainIdentity(r), nil
+}
+
+func (c *client) DeleteIdentity(ctx context.Context, kind Kind, id string, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.DeleteIdentity(ctx, d.IdentityKind(kind), id, options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+// SearchIdentityMembers lists the identities of the given kind assigned to owner, e.g. the users of a group.
+func (c *client) SearchIdentityMembers(ctx context.Context, owner Identity, kind Kind, size int32, opts ...options.FacadeOption) (Identities, error) {
+	xs, err := c.api.SearchIdentityMembers(ctx, toDomainIdentity(owner), d.IdentityKind(kind), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return Identities{}, ferrors.FromDomain(err)
+	}
+	return fromDomainIdentities(xs), nil
+}
+
+// AssignIdentity makes member part of owner, e.g. a user of a group or a role of a tenant.
+func (c *client) AssignIdentity(ctx context.Context, owner Identity, member Identity, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.AssignIdentity(ctx, toDomainIdentity(owner), toDomainIdentity(member), options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+func (c *client) UnassignIdentity(ctx context.Context, owner Identity, member Identity, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.UnassignIdentity(ctx, toDomainIdentity(owner), toDomainIdentity(member), options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+func (c *client) SearchAuthorizations(ctx context.Context, filter AuthorizationSearchFilterOpts, size int32, opts ...options.FacadeOption) (Authorizations, error) {
+	as, err := c.api.SearchAuthorizations(ctx, toDomainAuthorizationFilter(filter), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return Authorizations{}, ferrors.FromDomain(err)
+	}
+	return fromDomainAuthorizations(as), nil
+}
+
+func (c *client) GetAuthorization(ctx context.Context, key string, opts ...options.FacadeOption) (Authorization, error) {
+	a, err := c.api.GetAuthorization(ctx, key, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return Authorization{}, ferrors.FromDomain(err)
+	}
+	return fromDomainAuthorization(a), nil
+}
+
+func (c *client) CreateAuthorization(ctx context.Context, a Authorization, opts ...options.FacadeOption) (Authorization, error) {
+	r, err := c.api.CreateAuthorization(ctx, toDomainAuthorization(a), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return Authorization{}, ferrors.FromDomain(err)
+	}
+	return fromDomainAuthorization(r), nil
+}
+
+func (c *client) UpdateAuthorization(ctx context.Context, a Authorization, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.UpdateAuthorization(ctx, toDomainAuthorization(a), options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+func (c *client) DeleteAuthorization(ctx context.Context, key string, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.DeleteAuthorization(ctx, key, options.MapFacadeOptionsToCallOptions(opts)...))
+}
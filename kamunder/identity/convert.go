@@ -0,0 +1,78 @@
+package identity
+
+import (
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromDomainIdentity(x d.Identity) Identity {
+	return Identity{
+		Kind:        Kind(x.Kind),
+		Id:          x.Id,
+		Name:        x.Name,
+		Email:       x.Email,
+		Description: x.Description,
+		ClaimName:   x.ClaimName,
+		ClaimValue:  x.ClaimValue,
+	}
+}
+
+func fromDomainIdentities(xs []d.Identity) Identities {
+	items := toolx.MapSlice(xs, fromDomainIdentity)
+	return Identities{
+		Total: int32(len(items)),
+		Items: items,
+	}
+}
+
+func toDomainIdentity(x Identity) d.Identity {
+	return d.Identity{
+		Kind:        d.IdentityKind(x.Kind),
+		Id:          x.Id,
+		Name:        x.Name,
+		Email:       x.Email,
+		Password:    x.Password,
+		Description: x.Description,
+		ClaimName:   x.ClaimName,
+		ClaimValue:  x.ClaimValue,
+	}
+}
+
+func fromDomainAuthorization(x d.Authorization) Authorization {
+	return Authorization{
+		Key:          x.Key,
+		OwnerId:      x.OwnerId,
+		OwnerType:    x.OwnerType,
+		ResourceType: x.ResourceType,
+		ResourceId:   x.ResourceId,
+		Permissions:  x.Permissions,
+	}
+}
+
+func fromDomainAuthorizations(xs []d.Authorization) Authorizations {
+	items := toolx.MapSlice(xs, fromDomainAuthorization)
+	return Authorizations{
+		Total: int32(len(items)),
+		Items: items,
+	}
+}
+
+func toDomainAuthorization(x Authorization) d.Authorization {
+	return d.Authorization{
+		Key:          x.Key,
+		OwnerId:      x.OwnerId,
+		OwnerType:    x.OwnerType,
+		ResourceType: x.ResourceType,
+		ResourceId:   x.ResourceId,
+		Permissions:  x.Permissions,
+	}
+}
+
+func toDomainAuthorizationFilter(x AuthorizationSearchFilterOpts) d.AuthorizationSearchFilterOpts {
+	return d.AuthorizationSearchFilterOpts{
+		OwnerId:      x.OwnerId,
+		OwnerType:    x.OwnerType,
+		ResourceType: x.ResourceType,
+		ResourceId:   x.ResourceId,
+	}
+}
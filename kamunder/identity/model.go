@@ -0,0 +1,52 @@
+package identity
+
+// Kind names the type of an identity entity.
+type Kind string
+
+const (
+	KindUser        Kind = "user"
+	KindGroup       Kind = "group"
+	KindRole        Kind = "role"
+	KindTenant      Kind = "tenant"
+	KindMappingRule Kind = "mapping-rule"
+	KindClient      Kind = "client"
+)
+
+// Identity is a user, group, role, tenant, mapping rule or client.
+// Id holds the username, group, role, tenant, mapping rule or client id depending on Kind.
+type Identity struct {
+	Kind        Kind   `json:"kind,omitempty"`
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Email       string `json:"email,omitempty"`
+	Password    string `json:"-"`
+	Description string `json:"description,omitempty"`
+	ClaimName   string `json:"claimName,omitempty"`
+	ClaimValue  string `json:"claimValue,omitempty"`
+}
+
+type Identities struct {
+	Total int32      `json:"total,omitempty"`
+	Items []Identity `json:"items,omitempty"`
+}
+
+type Authorization struct {
+	Key          string   `json:"key,omitempty"`
+	OwnerId      string   `json:"ownerId,omitempty"`
+	OwnerType    string   `json:"ownerType,omitempty"`
+	ResourceType string   `json:"resourceType,omitempty"`
+	ResourceId   string   `json:"resourceId,omitempty"`
+	Permissions  []string `json:"permissions,omitempty"`
+}
+
+type Authorizations struct {
+	Total int32           `json:"total,omitempty"`
+	Items []Authorization `json:"items,omitempty"`
+}
+
+type AuthorizationSearchFilterOpts struct {
+	OwnerId      string `json:"ownerId,omitempty"`
+	OwnerType    string `json:"ownerType,omitempty"`
+	ResourceType string `json:"resourceType,omitempty"`
+	ResourceId   string `json:"resourceId,omitempty"`
+}
@@ -0,0 +1,324 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+// maxPlanSearchSize limits each search of the current state, a plan fails rather than work on a cut-off list.
+const maxPlanSearchSize int32 = 10000
+
+// builtins are the tenants and roles every cluster is set up with, pruning never deletes them
+// as that would lock out the admin user and the connectors.
+var builtins = map[Kind][]string{
+	KindTenant: {"<default>"},
+	KindRole:   {"admin", "readonly-admin", "connectors", "rpa", "app-integrations"},
+}
+
+func isBuiltin(kind Kind, id string) bool { return slices.Contains(builtins[kind], id) }
+
+// KindAuthorization marks authorization changes in a Plan, authorizations are no identities otherwise.
+const KindAuthorization Kind = "authorization"
+
+type Action string
+
+const (
+	ActionCreate   Action = "create"
+	ActionUpdate   Action = "update"
+	ActionDelete   Action = "delete"
+	ActionAssign   Action = "assign"
+	ActionUnassign Action = "unassign"
+)
+
+// Change is a single step of a Plan. Identity is set for tenants, groups and roles (and the member of
+// an assignment, whose Owner is set too), Authorization for authorizations.
+type Change struct {
+	Action        Action         `json:"action"`
+	Kind          Kind           `json:"kind"`
+	Id            string         `json:"id"`
+	Owner         *Identity      `json:"owner,omitempty"`
+	Identity      *Identity      `json:"identity,omitempty"`
+	Authorization *Authorization `json:"authorization,omitempty"`
+	Detail        string         `json:"detail,omitempty"`
+}
+
+// Plan is the ordered list of changes turning the current identity state of a cluster into the desired one.
+type Plan struct {
+	Changes []Change `json:"changes"`
+}
+
+func (p Plan) IsEmpty() bool { return len(p.Changes) == 0 }
+
+// Removals returns the number of deletions and unassignments in the plan, only planned with prune.
+func (p Plan) Removals() int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Action == ActionDelete || c.Action == ActionUnassign {
+			n++
+		}
+	}
+	return n
+}
+
+// NewPlan compares the desired state with the cluster and returns the changes needed to reach it.
+// Only the sections and member lists present in desired are considered. Without prune nothing is
+// deleted or unassigned; with prune tenants, groups and roles missing from a present section are
+// deleted unless built-in, members missing from a present member list are unassigned and
+// authorizations of the non-built-in owners used in desired that are not declared are deleted.
+func NewPlan(ctx context.Context, api API, desired State, prune bool) (Plan, error) {
+	pl := &planner{api: api, prune: prune}
+	for _, s := range []struct {
+		kind Kind
+		es   []StateEntity
+	}{{KindTenant, desired.Tenants}, {KindGroup, desired.Groups}, {KindRole, desired.Roles}} {
+		if s.es == nil {
+			continue
+		}
+		if err := pl.entities(ctx, s.kind, s.es); err != nil {
+			return Plan{}, err
+		}
+	}
+	if desired.Authorizations != nil {
+		if err := pl.authorizations(ctx, desired); err != nil {
+			return Plan{}, err
+		}
+	}
+	// creations and assignments first, removals last so nothing is lost half-way through
+	var cs []Change
+	cs = append(cs, pl.upserts...)
+	cs = append(cs, pl.assigns...)
+	cs = append(cs, pl.authUpserts...)
+	cs = append(cs, pl.unassigns...)
+	cs = append(cs, pl.authDeletes...)
+	slices.Reverse(pl.deletes)
+	cs = append(cs, pl.deletes...)
+	return Plan{Changes: cs}, nil
+}
+
+// Apply executes the changes in order and stops at the first failure.
+// It returns the number of changes applied.
+func (p Plan) Apply(ctx context.Context, api API) (int, error) {
+	for i, c := range p.Changes {
+		if err := c.apply(ctx, api); err != nil {
+			return i, fmt.Errorf("%s: %w", c, err)
+		}
+	}
+	return len(p.Changes), nil
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s %s", c.Action, c.Kind, c.Id)
+	if c.Owner != nil {
+		prep := "to"
+		if c.Action == ActionUnassign {
+			prep = "from"
+		}
+		s += fmt.Sprintf(" %s %s %s", prep, c.Owner.Kind, c.Owner.Id)
+	}
+	if c.Detail != "" {
+		s += " (" + c.Detail + ")"
+	}
+	return s
+}
+
+func (c Change) apply(ctx context.Context, api API) error {
+	var err error
+	switch {
+	case c.Kind == KindAuthorization && c.Action == ActionCreate:
+		_, err = api.CreateAuthorization(ctx, *c.Authorization)
+	case c.Kind == KindAuthorization && c.Action == ActionUpdate:
+		err = api.UpdateAuthorization(ctx, *c.Authorization)
+	case c.Kind == KindAuthorization && c.Action == ActionDelete:
+		err = api.DeleteAuthorization(ctx, c.Authorization.Key)
+	case c.Action == ActionCreate:
+		_, err = api.CreateIdentity(ctx, *c.Identity)
+	case c.Action == ActionUpdate:
+		_, err = api.UpdateIdentity(ctx, *c.Identity)
+	case c.Action == ActionDelete:
+		err = api.DeleteIdentity(ctx, c.Kind, c.Id)
+	case c.Action == ActionAssign:
+		err = api.AssignIdentity(ctx, *c.Owner, *c.Identity)
+	case c.Action == ActionUnassign:
+		err = api.UnassignIdentity(ctx, *c.Owner, *c.Identity)
+	default:
+		err = fmt.Errorf("unknown change %q", c.Action)
+	}
+	return err
+}
+
+type planner struct {
+	api   API
+	prune bool
+
+	upserts, assigns, unassigns, deletes []Change
+	authUpserts, authDeletes             []Change
+}
+
+func (pl *planner) entities(ctx context.Context, kind Kind, desired []StateEntity) error {
+	current, err := pl.api.SearchIdentities(ctx, kind, maxPlanSearchSize+1)
+	if err != nil {
+		return fmt.Errorf("fetching %ss: %w", kind, err)
+	}
+	if err = checkComplete(len(current.Items), string(kind)+"s"); err != nil {
+		return err
+	}
+	byId := map[string]Identity{}
+	for _, x := range current.Items {
+		byId[x.Id] = x
+	}
+	for _, e := range desired {
+		want := Identity{Kind: kind, Id: e.Id, Name: e.Name, Description: e.Description}
+		have, exists := byId[e.Id]
+		switch {
+		case !exists:
+			pl.upserts = append(pl.upserts, Change{Action: ActionCreate, Kind: kind, Id: e.Id, Identity: &want})
+		case have.Name != want.Name || have.Description != want.Description:
+			pl.upserts = append(pl.upserts, Change{Action: ActionUpdate, Kind: kind, Id: e.Id, Identity: &want, Detail: diffEntity(have, want)})
+		}
+		if err = pl.members(ctx, want, exists, e.Members); err != nil {
+			return err
+		}
+		delete(byId, e.Id)
+	}
+	if pl.prune {
+		for _, x := range current.Items {
+			if _, undeclared := byId[x.Id]; undeclared && !isBuiltin(kind, x.Id) {
+				pl.deletes = append(pl.deletes, Change{Action: ActionDelete, Kind: kind, Id: x.Id})
+			}
+		}
+	}
+	return nil
+}
+
+func (pl *planner) members(ctx context.Context, owner Identity, exists bool, desired StateMembers) error {
+	ref := &Identity{Kind: owner.Kind, Id: owner.Id}
+	lists := desired.byKind()
+	for _, kind := range []Kind{KindUser, KindGroup, KindRole, KindClient, KindMappingRule} {
+		want, managed := lists[kind]
+		if !managed {
+			continue
+		}
+		var have []string
+		if exists {
+			xs, err := pl.api.SearchIdentityMembers(ctx, *ref, kind, maxPlanSearchSize+1)
+			if err != nil {
+				return fmt.Errorf("fetching %ss of %s %s: %w", kind, owner.Kind, owner.Id, err)
+			}
+			if err = checkComplete(len(xs.Items), fmt.Sprintf("%ss of %s %s", kind, owner.Kind, owner.Id)); err != nil {
+				return err
+			}
+			have = toolx.MapSlice(xs.Items, func(x Identity) string { return x.Id })
+		}
+		for _, id := range want {
+			if !slices.Contains(have, id) {
+				pl.assigns = append(pl.assigns, Change{Action: ActionAssign, Kind: kind, Id: id, Owner: ref, Identity: &Identity{Kind: kind, Id: id}})
+			}
+		}
+		if !pl.prune {
+			continue
+		}
+		for _, id := range have {
+			if !slices.Contains(want, id) {
+				pl.unassigns = append(pl.unassigns, Change{Action: ActionUnassign, Kind: kind, Id: id, Owner: ref, Identity: &Identity{Kind: kind, Id: id}})
+			}
+		}
+	}
+	return nil
+}
+
+func (pl *planner) authorizations(ctx context.Context, desired State) error {
+	current, err := pl.api.SearchAuthorizations(ctx, AuthorizationSearchFilterOpts{}, maxPlanSearchSize+1)
+	if err != nil {
+		return fmt.Errorf("fetching authorizations: %w", err)
+	}
+	if err = checkComplete(len(current.Items), "authorizations"); err != nil {
+		return err
+	}
+	byId := map[string][]Authorization{}
+	for _, a := range current.Items {
+		id := authorizationId(a.OwnerType, a.OwnerId, a.ResourceType, a.ResourceId)
+		byId[id] = append(byId[id], a)
+	}
+	// pruning is limited to the owners used in the desired state, so authorizations of others survive,
+	// and never touches built-in owners like the admin role, which would lock administrators out
+	owners := map[string]bool{}
+	for _, e := range desired.Groups {
+		owners[ownerId(string(KindGroup), e.Id)] = true
+	}
+	for _, e := range desired.Roles {
+		owners[ownerId(string(KindRole), e.Id)] = true
+	}
+	declared := map[string]bool{}
+	for _, s := range desired.Authorizations {
+		want := Authorization{
+			OwnerType:    strings.ToUpper(s.OwnerType),
+			OwnerId:      s.OwnerId,
+			ResourceType: strings.ToUpper(s.ResourceType),
+			ResourceId:   s.ResourceId,
+			Permissions:  sortedUpper(s.Permissions),
+		}
+		id := authorizationId(want.OwnerType, want.OwnerId, want.ResourceType, want.ResourceId)
+		owners[ownerId(want.OwnerType, want.OwnerId)] = true
+		declared[id] = true
+		have := byId[id]
+		switch {
+		case len(have) == 0:
+			pl.authUpserts = append(pl.authUpserts, Change{Action: ActionCreate, Kind: KindAuthorization, Id: id, Authorization: &want})
+		case !slices.Equal(sortedUpper(have[0].Permissions), want.Permissions):
+			want.Key = have[0].Key
+			pl.authUpserts = append(pl.authUpserts, Change{Action: ActionUpdate, Kind: KindAuthorization, Id: id, Authorization: &want,
+				Detail: fmt.Sprintf("permissions: %s -> %s", strings.Join(sortedUpper(have[0].Permissions), ","), strings.Join(want.Permissions, ","))})
+		}
+		if pl.prune {
+			// duplicates of a declared authorization are removed, the first one is kept
+			for i := 1; i < len(have); i++ {
+				pl.authDeletes = append(pl.authDeletes, Change{Action: ActionDelete, Kind: KindAuthorization, Id: id, Authorization: &have[i], Detail: "duplicate " + have[i].Key})
+			}
+		}
+	}
+	if !pl.prune {
+		return nil
+	}
+	for _, a := range current.Items {
+		id := authorizationId(a.OwnerType, a.OwnerId, a.ResourceType, a.ResourceId)
+		if declared[id] || !owners[ownerId(a.OwnerType, a.OwnerId)] || isBuiltin(Kind(strings.ToLower(a.OwnerType)), a.OwnerId) {
+			continue
+		}
+		pl.authDeletes = append(pl.authDeletes, Change{Action: ActionDelete, Kind: KindAuthorization, Id: id, Authorization: &a, Detail: a.Key})
+	}
+	return nil
+}
+
+// checkComplete fails if a search returned more than maxPlanSearchSize items, as the plan would create
+// what exists beyond the limit and, with prune, miss deletions.
+func checkComplete(n int, what string) error {
+	if n > int(maxPlanSearchSize) {
+		return fmt.Errorf("more than %d %s in the cluster, too many to plan against", maxPlanSearchSize, what)
+	}
+	return nil
+}
+
+func diffEntity(have, want Identity) string {
+	var ds []string
+	if have.Name != want.Name {
+		ds = append(ds, fmt.Sprintf("name: %q -> %q", have.Name, want.Name))
+	}
+	if have.Description != want.Description {
+		ds = append(ds, fmt.Sprintf("description: %q -> %q", have.Description, want.Description))
+	}
+	return strings.Join(ds, ", ")
+}
+
+func ownerId(ownerType, id string) string {
+	return strings.ToUpper(ownerType) + ":" + id
+}
+
+func sortedUpper(xs []string) []string {
+	out := toolx.MapSlice(xs, strings.ToUpper)
+	slices.Sort(out)
+	return slices.Compact(out)
+}
@@ -0,0 +1,286 @@
+package identity_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/grafvonb/kamunder/kamunder/identity"
+	"github.com/grafvonb/kamunder/kamunder/options"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeIdentities serves the current identity state of a cluster and records the mutating calls in order.
+type fakeIdentities struct {
+	identity.API
+	entities map[identity.Kind][]identity.Identity
+	members  map[string][]identity.Identity // keyed by owner kind:id:member kind
+	auths    []identity.Authorization
+	calls    []string
+	failOn   string
+}
+
+func membersKey(owner identity.Identity, kind identity.Kind) string {
+	return string(owner.Kind) + ":" + owner.Id + ":" + string(kind)
+}
+
+func (f *fakeIdentities) record(call string) error {
+	f.calls = append(f.calls, call)
+	if call == f.failOn {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+func (f *fakeIdentities) SearchIdentities(_ context.Context, kind identity.Kind, _ int32, _ ...options.FacadeOption) (identity.Identities, error) {
+	xs := f.entities[kind]
+	return identity.Identities{Total: int32(len(xs)), Items: xs}, nil
+}
+
+func (f *fakeIdentities) SearchIdentityMembers(_ context.Context, owner identity.Identity, kind identity.Kind, _ int32, _ ...options.FacadeOption) (identity.Identities, error) {
+	xs := f.members[membersKey(owner, kind)]
+	return identity.Identities{Total: int32(len(xs)), Items: xs}, nil
+}
+
+func (f *fakeIdentities) SearchAuthorizations(_ context.Context, _ identity.AuthorizationSearchFilterOpts, _ int32, _ ...options.FacadeOption) (identity.Authorizations, error) {
+	return identity.Authorizations{Total: int32(len(f.auths)), Items: f.auths}, nil
+}
+
+func (f *fakeIdentities) CreateIdentity(_ context.Context, x identity.Identity, _ ...options.FacadeOption) (identity.Identity, error) {
+	return x, f.record("create " + string(x.Kind) + " " + x.Id)
+}
+
+func (f *fakeIdentities) UpdateIdentity(_ context.Context, x identity.Identity, _ ...options.FacadeOption) (identity.Identity, error) {
+	return x, f.record("update " + string(x.Kind) + " " + x.Id)
+}
+
+func (f *fakeIdentities) DeleteIdentity(_ context.Context, kind identity.Kind, id string, _ ...options.FacadeOption) error {
+	return f.record("delete " + string(kind) + " " + id)
+}
+
+func (f *fakeIdentities) AssignIdentity(_ context.Context, owner identity.Identity, member identity.Identity, _ ...options.FacadeOption) error {
+	return f.record("assign " + member.Id + " to " + owner.Id)
+}
+
+func (f *fakeIdentities) UnassignIdentity(_ context.Context, owner identity.Identity, member identity.Identity, _ ...options.FacadeOption) error {
+	return f.record("unassign " + member.Id + " from " + owner.Id)
+}
+
+func (f *fakeIdentities) CreateAuthorization(_ context.Context, a identity.Authorization, _ ...options.FacadeOption) (identity.Authorization, error) {
+	return a, f.record("create authorization " + a.OwnerId + " " + a.ResourceId)
+}
+
+func (f *fakeIdentities) UpdateAuthorization(_ context.Context, a identity.Authorization, _ ...options.FacadeOption) error {
+	return f.record("update authorization " + a.Key)
+}
+
+func (f *fakeIdentities) DeleteAuthorization(_ context.Context, key string, _ ...options.FacadeOption) error {
+	return f.record("delete authorization " + key)
+}
+
+func group(id, name string) identity.Identity {
+	return identity.Identity{Kind: identity.KindGroup, Id: id, Name: name}
+}
+
+func changes(p identity.Plan) []string {
+	out := make([]string, 0, len(p.Changes))
+	for _, c := range p.Changes {
+		out = append(out, c.String())
+	}
+	return out
+}
+
+func TestNewPlan_CreateAndUpdate(t *testing.T) {
+	api := &fakeIdentities{entities: map[identity.Kind][]identity.Identity{
+		identity.KindGroup: {group("ops", "Ops"), group("legacy", "Legacy")},
+	}}
+	desired := identity.State{Groups: []identity.StateEntity{
+		{Id: "ops", Name: "Operations"},
+		{Id: "dev", Name: "Development"},
+	}}
+
+	p, err := identity.NewPlan(context.Background(), api, desired, false)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`update group ops (name: "Ops" -> "Operations")`,
+		"create group dev",
+	}, changes(p))
+}
+
+func TestNewPlan_Members(t *testing.T) {
+	tests := []struct {
+		name  string
+		prune bool
+		want  []string
+	}{
+		{name: "assign only", want: []string{"assign user carol to role operator"}},
+		{name: "prune", prune: true, want: []string{"assign user carol to role operator", "unassign user bob from role operator"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operator := identity.Identity{Kind: identity.KindRole, Id: "operator", Name: "Operator"}
+			api := &fakeIdentities{
+				entities: map[identity.Kind][]identity.Identity{identity.KindRole: {operator}},
+				members: map[string][]identity.Identity{
+					membersKey(operator, identity.KindUser): {{Kind: identity.KindUser, Id: "alice"}, {Kind: identity.KindUser, Id: "bob"}},
+				},
+			}
+			desired := identity.State{Roles: []identity.StateEntity{
+				{Id: "operator", Name: "Operator", Members: identity.StateMembers{Users: []string{"alice", "carol"}}},
+			}}
+
+			p, err := identity.NewPlan(context.Background(), api, desired, tt.prune)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, changes(p))
+		})
+	}
+}
+
+func TestNewPlan_DuplicateAuthorizations(t *testing.T) {
+	api := &fakeIdentities{auths: []identity.Authorization{
+		{Key: "1", OwnerType: "GROUP", OwnerId: "ops", ResourceType: "PROCESS_DEFINITION", ResourceId: "*", Permissions: []string{"READ_PROCESS_INSTANCE"}},
+		{Key: "2", OwnerType: "GROUP", OwnerId: "ops", ResourceType: "PROCESS_DEFINITION", ResourceId: "*", Permissions: []string{"READ_PROCESS_INSTANCE"}},
+	}}
+	desired := identity.State{Authorizations: []identity.StateAuthorization{
+		{OwnerType: "group", OwnerId: "ops", ResourceType: "process_definition", ResourceId: "*", Permissions: []string{"read_process_instance"}},
+	}}
+
+	p, err := identity.NewPlan(context.Background(), api, desired, true)
+
+	require.NoError(t, err)
+	require.Len(t, p.Changes, 1)
+	require.Equal(t, identity.ActionDelete, p.Changes[0].Action)
+	require.Equal(t, "2", p.Changes[0].Authorization.Key)
+}
+
+func TestNewPlan_PruneLimitedToDeclaredOwners(t *testing.T) {
+	api := &fakeIdentities{
+		entities: map[identity.Kind][]identity.Identity{identity.KindGroup: {group("ops", "Ops")}},
+		auths: []identity.Authorization{
+			{Key: "1", OwnerType: "GROUP", OwnerId: "ops", ResourceType: "PROCESS_DEFINITION", ResourceId: "*", Permissions: []string{"READ_PROCESS_INSTANCE"}},
+			{Key: "2", OwnerType: "GROUP", OwnerId: "ops", ResourceType: "DECISION_DEFINITION", ResourceId: "*", Permissions: []string{"READ_DECISION_INSTANCE"}},
+			{Key: "3", OwnerType: "ROLE", OwnerId: "admin", ResourceType: "PROCESS_DEFINITION", ResourceId: "*", Permissions: []string{"READ_PROCESS_INSTANCE"}},
+		},
+	}
+	desired := identity.State{
+		Groups: []identity.StateEntity{{Id: "ops", Name: "Ops"}},
+		Authorizations: []identity.StateAuthorization{
+			{OwnerType: "group", OwnerId: "ops", ResourceType: "process_definition", ResourceId: "*", Permissions: []string{"read_process_instance"}},
+		},
+	}
+
+	p, err := identity.NewPlan(context.Background(), api, desired, true)
+
+	require.NoError(t, err)
+	require.Len(t, p.Changes, 1)
+	require.Equal(t, "2", p.Changes[0].Authorization.Key, "only the undeclared authorization of ops is pruned, admin's is kept")
+}
+
+func TestNewPlan_PruneKeepsBuiltins(t *testing.T) {
+	api := &fakeIdentities{entities: map[identity.Kind][]identity.Identity{
+		identity.KindTenant: {{Kind: identity.KindTenant, Id: "<default>", Name: "Default"}, {Kind: identity.KindTenant, Id: "old", Name: "Old"}},
+		identity.KindRole:   {{Kind: identity.KindRole, Id: "admin", Name: "Admin"}, {Kind: identity.KindRole, Id: "connectors", Name: "Connectors"}},
+	}}
+	desired := identity.State{
+		Tenants: []identity.StateEntity{{Id: "emea", Name: "EMEA"}},
+		Roles:   []identity.StateEntity{},
+	}
+
+	p, err := identity.NewPlan(context.Background(), api, desired, true)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"create tenant emea", "delete tenant old"}, changes(p))
+}
+
+func TestNewPlan_PruneKeepsAuthorizationsOfBuiltinRoles(t *testing.T) {
+	admin := identity.Identity{Kind: identity.KindRole, Id: "admin", Name: "Admin"}
+	api := &fakeIdentities{
+		entities: map[identity.Kind][]identity.Identity{identity.KindRole: {admin}},
+		members: map[string][]identity.Identity{
+			membersKey(admin, identity.KindUser): {{Kind: identity.KindUser, Id: "demo"}},
+		},
+		auths: []identity.Authorization{
+			{Key: "1", OwnerType: "ROLE", OwnerId: "admin", ResourceType: "AUTHORIZATION", ResourceId: "*", Permissions: []string{"READ", "UPDATE"}},
+			{Key: "2", OwnerType: "ROLE", OwnerId: "admin", ResourceType: "PROCESS_DEFINITION", ResourceId: "*", Permissions: []string{"READ_PROCESS_INSTANCE"}},
+		},
+	}
+	desired := identity.State{
+		Roles:          []identity.StateEntity{{Id: "admin", Name: "Admin", Members: identity.StateMembers{Users: []string{"demo", "alice"}}}},
+		Authorizations: []identity.StateAuthorization{},
+	}
+
+	p, err := identity.NewPlan(context.Background(), api, desired, true)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"assign user alice to role admin"}, changes(p), "authorizations of the admin role are never pruned")
+}
+
+func TestNewPlan_FailsOnTooManyToPlanAgainst(t *testing.T) {
+	groups := make([]identity.Identity, 10001)
+	for i := range groups {
+		groups[i] = group(fmt.Sprintf("g%d", i), "")
+	}
+	api := &fakeIdentities{entities: map[identity.Kind][]identity.Identity{identity.KindGroup: groups}}
+
+	_, err := identity.NewPlan(context.Background(), api, identity.State{Groups: []identity.StateEntity{{Id: "ops"}}}, true)
+
+	require.ErrorContains(t, err, "more than 10000 groups")
+}
+
+func TestPlan_ApplyOrder(t *testing.T) {
+	operator := identity.Identity{Kind: identity.KindRole, Id: "operator", Name: "Operator"}
+	api := &fakeIdentities{
+		entities: map[identity.Kind][]identity.Identity{
+			identity.KindGroup: {group("legacy", "Legacy")},
+			identity.KindRole:  {operator},
+		},
+		members: map[string][]identity.Identity{
+			membersKey(operator, identity.KindGroup): {group("legacy", "")},
+		},
+		auths: []identity.Authorization{
+			{Key: "9", OwnerType: "GROUP", OwnerId: "legacy", ResourceType: "PROCESS_DEFINITION", ResourceId: "*", Permissions: []string{"READ_PROCESS_INSTANCE"}},
+		},
+	}
+	desired := identity.State{
+		Groups: []identity.StateEntity{{Id: "ops", Name: "Ops"}},
+		Roles:  []identity.StateEntity{{Id: "operator", Name: "Operator", Members: identity.StateMembers{Groups: []string{"ops"}}}},
+		Authorizations: []identity.StateAuthorization{
+			{OwnerType: "group", OwnerId: "ops", ResourceType: "process_definition", ResourceId: "*", Permissions: []string{"read_process_instance"}},
+			{OwnerType: "group", OwnerId: "legacy", ResourceType: "decision_definition", ResourceId: "*", Permissions: []string{"read_decision_instance"}},
+		},
+	}
+	p, err := identity.NewPlan(context.Background(), api, desired, true)
+	require.NoError(t, err)
+
+	n, err := p.Apply(context.Background(), api)
+
+	require.NoError(t, err)
+	require.Equal(t, len(p.Changes), n)
+	require.Equal(t, []string{
+		"create group ops",
+		"assign ops to operator",
+		"create authorization ops *",
+		"create authorization legacy *",
+		"unassign legacy from operator",
+		"delete authorization 9",
+		"delete group legacy",
+	}, api.calls, "creations and assignments come before removals")
+}
+
+func TestPlan_ApplyStopsAtFirstFailure(t *testing.T) {
+	api := &fakeIdentities{failOn: "create group dev"}
+	p := identity.Plan{Changes: []identity.Change{
+		{Action: identity.ActionCreate, Kind: identity.KindGroup, Id: "ops", Identity: &identity.Identity{Kind: identity.KindGroup, Id: "ops"}},
+		{Action: identity.ActionCreate, Kind: identity.KindGroup, Id: "dev", Identity: &identity.Identity{Kind: identity.KindGroup, Id: "dev"}},
+		{Action: identity.ActionDelete, Kind: identity.KindGroup, Id: "legacy"},
+	}}
+
+	n, err := p.Apply(context.Background(), api)
+
+	require.ErrorContains(t, err, "create group dev: boom")
+	require.Equal(t, 1, n)
+	require.Equal(t, []string{"create group ops", "create group dev"}, api.calls)
+}
@@ -0,0 +1,135 @@
+package identity
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// State is the desired identity configuration, usually kept in git as YAML:
+//
+//	tenants:
+//	  - id: emea
+//	    name: EMEA
+//	    members:
+//	      groups: [ops]
+//	groups:
+//	  - id: ops
+//	    name: Operations
+//	    members:
+//	      users: [alice, bob]
+//	roles:
+//	  - id: operator
+//	    name: Operator
+//	    members:
+//	      groups: [ops]
+//	authorizations:
+//	  - ownerType: group
+//	    ownerId: ops
+//	    resourceType: process_definition
+//	    resourceId: "*"
+//	    permissions: [read_process_instance, update_process_instance]
+//
+// A section that is left out is not managed at all, a member list that is left out is not managed either.
+type State struct {
+	Tenants        []StateEntity        `yaml:"tenants,omitempty" json:"tenants,omitempty"`
+	Groups         []StateEntity        `yaml:"groups,omitempty" json:"groups,omitempty"`
+	Roles          []StateEntity        `yaml:"roles,omitempty" json:"roles,omitempty"`
+	Authorizations []StateAuthorization `yaml:"authorizations,omitempty" json:"authorizations,omitempty"`
+}
+
+// StateEntity is a desired tenant, group or role with its members.
+type StateEntity struct {
+	Id          string       `yaml:"id" json:"id"`
+	Name        string       `yaml:"name" json:"name"`
+	Description string       `yaml:"description,omitempty" json:"description,omitempty"`
+	Members     StateMembers `yaml:"members,omitempty" json:"members,omitempty"`
+}
+
+// StateMembers lists the ids of the members by kind. A nil list is not managed, an empty list means no members.
+type StateMembers struct {
+	Users        []string `yaml:"users,omitempty" json:"users,omitempty"`
+	Groups       []string `yaml:"groups,omitempty" json:"groups,omitempty"`
+	Roles        []string `yaml:"roles,omitempty" json:"roles,omitempty"`
+	Clients      []string `yaml:"clients,omitempty" json:"clients,omitempty"`
+	MappingRules []string `yaml:"mappingRules,omitempty" json:"mappingRules,omitempty"`
+}
+
+type StateAuthorization struct {
+	OwnerType    string   `yaml:"ownerType" json:"ownerType"`
+	OwnerId      string   `yaml:"ownerId" json:"ownerId"`
+	ResourceType string   `yaml:"resourceType" json:"resourceType"`
+	ResourceId   string   `yaml:"resourceId" json:"resourceId"`
+	Permissions  []string `yaml:"permissions" json:"permissions"`
+}
+
+// byKind returns the member lists keyed by member kind; kinds without a list are left out.
+func (m StateMembers) byKind() map[Kind][]string {
+	out := map[Kind][]string{}
+	for k, ids := range map[Kind][]string{
+		KindUser:        m.Users,
+		KindGroup:       m.Groups,
+		KindRole:        m.Roles,
+		KindClient:      m.Clients,
+		KindMappingRule: m.MappingRules,
+	} {
+		if ids != nil {
+			out[k] = ids
+		}
+	}
+	return out
+}
+
+// LoadState reads a desired state from YAML (or JSON) and validates it.
+func LoadState(r io.Reader) (State, error) {
+	var s State
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&s); err != nil && err != io.EOF {
+		return State{}, fmt.Errorf("decoding identity state: %w", err)
+	}
+	return s, s.validate()
+}
+
+func (s State) validate() error {
+	for kind, es := range map[Kind][]StateEntity{KindTenant: s.Tenants, KindGroup: s.Groups, KindRole: s.Roles} {
+		seen := map[string]bool{}
+		for _, e := range es {
+			if e.Id == "" || e.Name == "" {
+				return fmt.Errorf("%s %q: id and name are required", kind, e.Id)
+			}
+			if seen[e.Id] {
+				return fmt.Errorf("%s %q is declared more than once", kind, e.Id)
+			}
+			seen[e.Id] = true
+			if kind == KindGroup && e.Members.Groups != nil {
+				return fmt.Errorf("group %q: groups cannot be members of groups", e.Id)
+			}
+			if kind == KindGroup && e.Members.Roles != nil {
+				return fmt.Errorf("group %q: assign the group in the members of the role instead", e.Id)
+			}
+			if kind == KindRole && e.Members.Roles != nil {
+				return fmt.Errorf("role %q: roles cannot be members of roles", e.Id)
+			}
+		}
+	}
+	seen := map[string]bool{}
+	for _, a := range s.Authorizations {
+		if a.OwnerType == "" || a.OwnerId == "" || a.ResourceType == "" || a.ResourceId == "" {
+			return fmt.Errorf("authorization %s: ownerType, ownerId, resourceType and resourceId are required", authorizationId(a.OwnerType, a.OwnerId, a.ResourceType, a.ResourceId))
+		}
+		id := authorizationId(a.OwnerType, a.OwnerId, a.ResourceType, a.ResourceId)
+		if seen[id] {
+			return fmt.Errorf("authorization %s is declared more than once", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// authorizationId identifies an authorization by owner and resource, as there is at most one per pair.
+func authorizationId(ownerType, ownerId, resourceType, resourceId string) string {
+	return strings.ToUpper(ownerType) + ":" + ownerId + "/" + strings.ToUpper(resourceType) + ":" + resourceId
+}
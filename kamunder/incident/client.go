@@ -0,0 +1,34 @@
+package incident
+
+import (
+	"context"
+
+	isvc "github.com/grafvonb/kamunder/internal/services/incident"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+)
+
+type API interface {
+	SearchIncidents(ctx context.Context, filter IncidentSearchFilterOpts, size int32, opts ...options.FacadeOption) (Incidents, error)
+	ResolveIncidents(ctx context.Context, filter IncidentSearchFilterOpts, size int32, opts ...options.FacadeOption) (IncidentResolution, error)
+}
+
+type client struct{ api isvc.API }
+
+func New(api isvc.API) API { return &client{api: api} }
+
+func (c *client) SearchIncidents(ctx context.Context, filter IncidentSearchFilterOpts, size int32, opts ...options.FacadeOption) (Incidents, error) {
+	is, err := c.api.SearchIncidents(ctx, toDomainIncidentFilter(filter), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return Incidents{}, ferrors.FromDomain(err)
+	}
+	return fromDomainIncidents(is), nil
+}
+
+// ResolveIncidents resolves all active incidents matching the filter, server-side on 8.8 and client-side on 8.7.
+// With options.WithWait() it returns once the affected process instances no longer report an incident;
+// the resolution summary is returned also on error.
+func (c *client) ResolveIncidents(ctx context.Context, filter IncidentSearchFilterOpts, size int32, opts ...options.FacadeOption) (IncidentResolution, error) {
+	r, err := c.api.ResolveIncidents(ctx, toDomainIncidentFilter(filter), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	return fromDomainIncidentResolution(r), ferrors.FromDomain(err)
+}
@@ -0,0 +1,54 @@
+package incident
+
+import (
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromDomainIncident(x d.Incident) Incident {
+	return Incident{
+		Key:                  x.Key,
+		ProcessInstanceKey:   x.ProcessInstanceKey,
+		ProcessDefinitionKey: x.ProcessDefinitionKey,
+		ProcessDefinitionId:  x.ProcessDefinitionId,
+		ErrorType:            x.ErrorType,
+		ErrorMessage:         x.ErrorMessage,
+		ElementId:            x.ElementId,
+		ElementInstanceKey:   x.ElementInstanceKey,
+		JobKey:               x.JobKey,
+		State:                x.State,
+		CreationTime:         x.CreationTime,
+		TenantId:             x.TenantId,
+	}
+}
+
+func fromDomainIncidents(xs []d.Incident) Incidents {
+	items := toolx.MapSlice(xs, fromDomainIncident)
+	return Incidents{
+		Total: int32(len(items)),
+		Items: items,
+	}
+}
+
+func toDomainIncidentFilter(x IncidentSearchFilterOpts) d.IncidentSearchFilterOpts {
+	return d.IncidentSearchFilterOpts{
+		ProcessDefinitionId: x.ProcessDefinitionId,
+		ProcessInstanceKey:  x.ProcessInstanceKey,
+		ErrorType:           x.ErrorType,
+		State:               x.State,
+		Since:               x.Since,
+	}
+}
+
+func fromDomainIncidentResolution(x d.IncidentResolution) IncidentResolution {
+	return IncidentResolution{
+		BatchOperationKey:   x.BatchOperationKey,
+		Incidents:           x.Incidents,
+		Truncated:           x.Truncated,
+		ProcessInstanceKeys: x.ProcessInstanceKeys,
+		Failures: toolx.MapSlice(x.Failures, func(f d.IncidentResolutionFailure) IncidentResolutionFailure {
+			return IncidentResolutionFailure{IncidentKey: f.IncidentKey, ProcessInstanceKey: f.ProcessInstanceKey, Error: f.Error}
+		}),
+		Pending: x.Pending,
+	}
+}
@@ -0,0 +1,47 @@
+package incident
+
+import "time"
+
+type Incident struct {
+	Key                  string `json:"key,omitempty"`
+	ProcessInstanceKey   string `json:"processInstanceKey,omitempty"`
+	ProcessDefinitionKey string `json:"processDefinitionKey,omitempty"`
+	ProcessDefinitionId  string `json:"processDefinitionId,omitempty"`
+	ErrorType            string `json:"errorType,omitempty"`
+	ErrorMessage         string `json:"errorMessage,omitempty"`
+	ElementId            string `json:"elementId,omitempty"`
+	ElementInstanceKey   string `json:"elementInstanceKey,omitempty"`
+	JobKey               string `json:"jobKey,omitempty"`
+	State                string `json:"state,omitempty"`
+	CreationTime         string `json:"creationTime,omitempty"`
+	TenantId             string `json:"tenantId,omitempty"`
+}
+
+type IncidentSearchFilterOpts struct {
+	ProcessDefinitionId string    `json:"processDefinitionId,omitempty"`
+	ProcessInstanceKey  string    `json:"processInstanceKey,omitempty"`
+	ErrorType           string    `json:"errorType,omitempty"`
+	State               string    `json:"state,omitempty"`
+	Since               time.Time `json:"since,omitzero"` // only incidents created at or after
+}
+
+// IncidentResolution summarizes the resolution of all incidents matching a filter.
+type IncidentResolution struct {
+	BatchOperationKey   string                      `json:"batchOperationKey,omitempty"` // set when resolved by a server-side batch operation
+	Incidents           int                         `json:"incidents,omitempty"`
+	Truncated           bool                        `json:"truncated,omitempty"` // more incidents matched than the size limit, only the first ones were resolved
+	ProcessInstanceKeys []string                    `json:"processInstanceKeys,omitempty"`
+	Failures            []IncidentResolutionFailure `json:"failures,omitempty"`
+	Pending             []string                    `json:"pending,omitempty"` // process instances still reporting an incident after waiting
+}
+
+type IncidentResolutionFailure struct {
+	IncidentKey        string `json:"incidentKey,omitempty"`
+	ProcessInstanceKey string `json:"processInstanceKey,omitempty"`
+	Error              string `json:"error,omitempty"`
+}
+
+type Incidents struct {
+	Total int32      `json:"total,omitempty"`
+	Items []Incident `json:"items,omitempty"`
+}
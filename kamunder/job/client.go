@@ -0,0 +1,78 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/grafvonb/kamunder/internal/services/common"
+	jsvc "github.com/grafvonb/kamunder/internal/services/job"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type API interface {
+	SearchJobs(ctx context.Context, filter JobSearchFilterOpts, size int32, opts ...options.FacadeOption) (Jobs, error)
+	UpdateJob(ctx context.Context, key string, u JobUpdate, opts ...options.FacadeOption) error
+	UpdateJobs(ctx context.Context, keys []string, u JobUpdate, opts ...options.FacadeOption) ([]JobActionResult, error)
+	CompleteJob(ctx context.Context, key string, vars map[string]any, opts ...options.FacadeOption) error
+	FailJob(ctx context.Context, key string, f JobFailure, opts ...options.FacadeOption) error
+	ThrowJobError(ctx context.Context, key string, e JobError, opts ...options.FacadeOption) error
+	ActivateJobs(ctx context.Context, a JobActivation, opts ...options.FacadeOption) ([]ActivatedJob, error)
+}
+
+type client struct{ api jsvc.API }
+
+func New(api jsvc.API) API { return &client{api: api} }
+
+func (c *client) SearchJobs(ctx context.Context, filter JobSearchFilterOpts, size int32, opts ...options.FacadeOption) (Jobs, error) {
+	js, err := c.api.SearchJobs(ctx, toDomainJobFilter(filter), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return Jobs{}, ferrors.FromDomain(err)
+	}
+	return fromDomainJobs(js), nil
+}
+
+func (c *client) UpdateJob(ctx context.Context, key string, u JobUpdate, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.UpdateJob(ctx, key, toDomainJobUpdate(u), options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+// UpdateJobs applies the same update to all given jobs in parallel; the returned error joins all per-job failures.
+func (c *client) UpdateJobs(ctx context.Context, keys []string, u JobUpdate, opts ...options.FacadeOption) ([]JobActionResult, error) {
+	callOpts := options.MapFacadeOptionsToCallOptions(opts)
+	results := common.RunBulk(ctx, keys, 0, func(ctx context.Context, key string) error {
+		return c.api.UpdateJob(ctx, key, toDomainJobUpdate(u), callOpts...)
+	})
+	out := make([]JobActionResult, 0, len(results))
+	var errs []error
+	for _, r := range results {
+		res := JobActionResult{Key: r.Item, Ok: r.Err == nil}
+		if r.Err != nil {
+			res.Error = r.Err.Error()
+			errs = append(errs, fmt.Errorf("job %s: %w", r.Item, r.Err))
+		}
+		out = append(out, res)
+	}
+	return out, ferrors.FromDomain(errors.Join(errs...))
+}
+
+func (c *client) CompleteJob(ctx context.Context, key string, vars map[string]any, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.CompleteJob(ctx, key, vars, options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+func (c *client) FailJob(ctx context.Context, key string, f JobFailure, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.FailJob(ctx, key, toDomainJobFailure(f), options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+func (c *client) ThrowJobError(ctx context.Context, key string, e JobError, opts ...options.FacadeOption) error {
+	return ferrors.FromDomain(c.api.ThrowJobError(ctx, key, toDomainJobError(e), options.MapFacadeOptionsToCallOptions(opts)...))
+}
+
+func (c *client) ActivateJobs(ctx context.Context, a JobActivation, opts ...options.FacadeOption) ([]ActivatedJob, error) {
+	js, err := c.api.ActivateJobs(ctx, toDomainJobActivation(a), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return nil, ferrors.FromDomain(err)
+	}
+	return toolx.MapSlice(js, fromDomainActivatedJob), nil
+}
@@ -0,0 +1,104 @@
+package job
+
+import (
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func fromDomainJob(x d.Job) Job {
+	return Job{
+		Key:                      x.Key,
+		Type:                     x.Type,
+		Kind:                     x.Kind,
+		State:                    x.State,
+		Worker:                   x.Worker,
+		Retries:                  x.Retries,
+		HasFailedWithRetriesLeft: x.HasFailedWithRetriesLeft,
+		ErrorCode:                x.ErrorCode,
+		ErrorMessage:             x.ErrorMessage,
+		Deadline:                 x.Deadline,
+		EndTime:                  x.EndTime,
+		ElementId:                x.ElementId,
+		ElementInstanceKey:       x.ElementInstanceKey,
+		ProcessDefinitionId:      x.ProcessDefinitionId,
+		ProcessDefinitionKey:     x.ProcessDefinitionKey,
+		ProcessInstanceKey:       x.ProcessInstanceKey,
+		TenantId:                 x.TenantId,
+		CustomHeaders:            x.CustomHeaders,
+	}
+}
+
+func fromDomainJobs(xs []d.Job) Jobs {
+	items := toolx.MapSlice(xs, fromDomainJob)
+	return Jobs{
+		Total: int32(len(items)),
+		Items: items,
+	}
+}
+
+func toDomainJobFilter(x JobSearchFilterOpts) d.JobSearchFilterOpts {
+	return d.JobSearchFilterOpts{
+		Key:                 x.Key,
+		Type:                x.Type,
+		State:               x.State,
+		Worker:              x.Worker,
+		ElementId:           x.ElementId,
+		ProcessDefinitionId: x.ProcessDefinitionId,
+		ProcessInstanceKey:  x.ProcessInstanceKey,
+	}
+}
+
+func toDomainJobUpdate(x JobUpdate) d.JobUpdate {
+	return d.JobUpdate{
+		Retries: x.Retries,
+		Timeout: x.Timeout,
+	}
+}
+
+func toDomainJobFailure(x JobFailure) d.JobFailure {
+	return d.JobFailure{
+		ErrorMessage: x.ErrorMessage,
+		Retries:      x.Retries,
+		RetryBackOff: x.RetryBackOff,
+		Variables:    x.Variables,
+	}
+}
+
+func toDomainJobError(x JobError) d.JobError {
+	return d.JobError{
+		ErrorCode:    x.ErrorCode,
+		ErrorMessage: x.ErrorMessage,
+		Variables:    x.Variables,
+	}
+}
+
+func toDomainJobActivation(x JobActivation) d.JobActivation {
+	return d.JobActivation{
+		Type:              x.Type,
+		Worker:            x.Worker,
+		Timeout:           x.Timeout,
+		MaxJobsToActivate: x.MaxJobsToActivate,
+		RequestTimeout:    x.RequestTimeout,
+		FetchVariables:    x.FetchVariables,
+		TenantIds:         x.TenantIds,
+	}
+}
+
+func fromDomainActivatedJob(x d.ActivatedJob) ActivatedJob {
+	return ActivatedJob{
+		Key:                      x.Key,
+		Type:                     x.Type,
+		Worker:                   x.Worker,
+		Retries:                  x.Retries,
+		Deadline:                 x.Deadline,
+		ElementId:                x.ElementId,
+		ElementInstanceKey:       x.ElementInstanceKey,
+		ProcessDefinitionId:      x.ProcessDefinitionId,
+		ProcessDefinitionKey:     x.ProcessDefinitionKey,
+		ProcessDefinitionVersion: x.ProcessDefinitionVersion,
+		ProcessInstanceKey:       x.ProcessInstanceKey,
+		TenantId:                 x.TenantId,
+		CustomHeaders:            x.CustomHeaders,
+		Variables:                x.Variables,
+	}
+}
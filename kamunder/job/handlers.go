@@ -0,0 +1,105 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"os/exec"
+	"strings"
+	"text/template"
+)
+
+// StaticVariables completes every job with the same variables.
+func StaticVariables(vars map[string]any) JobHandler {
+	return func(context.Context, ActivatedJob) (map[string]any, error) {
+		return maps.Clone(vars), nil
+	}
+}
+
+// ReadVariablesFile reads a JSON object from path, e.g. the variables for StaticVariables.
+func ReadVariablesFile(path string) (map[string]any, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading variables file: %w", err)
+	}
+	var vars map[string]any
+	if err = json.Unmarshal(b, &vars); err != nil {
+		return nil, fmt.Errorf("variables file %s is not a JSON object: %w", path, err)
+	}
+	return vars, nil
+}
+
+// FailWith fails every job with the given error message.
+func FailWith(message string) JobHandler {
+	return func(context.Context, ActivatedJob) (map[string]any, error) {
+		return nil, errors.New(message)
+	}
+}
+
+// TemplateVariables renders the template with the activated job as data and decodes the output as JSON object.
+// The template can use the "json" function to embed values, e.g. {"orderId": {{ json .Variables.orderId }}}.
+func TemplateVariables(text string) (JobHandler, error) {
+	tmpl, err := template.New("variables").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parsing variables template: %w", err)
+	}
+	return func(_ context.Context, j ActivatedJob) (map[string]any, error) {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, j); err != nil {
+			return nil, fmt.Errorf("rendering variables template: %w", err)
+		}
+		return decodeVariables(buf.Bytes(), "variables template")
+	}, nil
+}
+
+// CommandVariables runs the shell command for every job; the job is passed as JSON on stdin and
+// as KAMUNDER_JOB_* environment variables. A JSON object on stdout becomes the job's variables,
+// a non-zero exit code fails the job.
+func CommandVariables(command string) JobHandler {
+	return func(ctx context.Context, j ActivatedJob) (map[string]any, error) {
+		in, err := json.Marshal(j)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling job for command: %w", err)
+		}
+		cmd := exec.CommandContext(ctx, "sh", "-c", command)
+		cmd.Stdin = bytes.NewReader(in)
+		cmd.Env = append(os.Environ(),
+			"KAMUNDER_JOB_KEY="+j.Key,
+			"KAMUNDER_JOB_TYPE="+j.Type,
+			"KAMUNDER_JOB_RETRIES="+fmt.Sprint(j.Retries),
+			"KAMUNDER_JOB_PROCESS_INSTANCE_KEY="+j.ProcessInstanceKey,
+			"KAMUNDER_JOB_ELEMENT_ID="+j.ElementId,
+		)
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err = cmd.Run(); err != nil {
+			msg := strings.TrimSpace(stderr.String())
+			if msg == "" {
+				msg = err.Error()
+			}
+			return nil, fmt.Errorf("command failed: %s", msg)
+		}
+		return decodeVariables(stdout.Bytes(), "command output")
+	}
+}
+
+func decodeVariables(b []byte, source string) (map[string]any, error) {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil, nil
+	}
+	var vars map[string]any
+	if err := json.Unmarshal(b, &vars); err != nil {
+		return nil, fmt.Errorf("%s is not a JSON object: %w", source, err)
+	}
+	return vars, nil
+}
@@ -0,0 +1,78 @@
+package job_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafvonb/kamunder/kamunder/job"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticVariables_ReturnsCopy(t *testing.T) {
+	vars := map[string]any{"a": 1}
+	h := job.StaticVariables(vars)
+	got, err := h(context.Background(), job.ActivatedJob{})
+	require.NoError(t, err)
+	got["a"] = 2
+	again, err := h(context.Background(), job.ActivatedJob{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"a": 1}, again)
+}
+
+func TestTemplateVariables(t *testing.T) {
+	h, err := job.TemplateVariables(`{"orderId": {{ json .Variables.orderId }}, "from": "{{ .Key }}"}`)
+	require.NoError(t, err)
+	got, err := h(context.Background(), job.ActivatedJob{Key: "7", Variables: map[string]any{"orderId": "o-1"}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"orderId": "o-1", "from": "7"}, got)
+
+	_, err = job.TemplateVariables(`{{ .Key `)
+	require.ErrorContains(t, err, "parsing variables template")
+
+	h, err = job.TemplateVariables(`not json {{ .Key }}`)
+	require.NoError(t, err)
+	_, err = h(context.Background(), job.ActivatedJob{Key: "7"})
+	require.ErrorContains(t, err, "variables template is not a JSON object")
+}
+
+func TestCommandVariables(t *testing.T) {
+	h := job.CommandVariables(`printf '{"key": "%s", "type": "%s"}' "$KAMUNDER_JOB_KEY" "$(cat | sed 's/.*"type":"\([^"]*\)".*/\1/')"`)
+	got, err := h(context.Background(), job.ActivatedJob{Key: "7", Type: "payment"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"key": "7", "type": "payment"}, got)
+
+	got, err = job.CommandVariables("true")(context.Background(), job.ActivatedJob{})
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	_, err = job.CommandVariables("echo downstream unavailable >&2; exit 3")(context.Background(), job.ActivatedJob{})
+	require.EqualError(t, err, "command failed: downstream unavailable")
+
+	_, err = job.CommandVariables("echo nope")(context.Background(), job.ActivatedJob{})
+	require.ErrorContains(t, err, "command output is not a JSON object")
+}
+
+func TestFailWith(t *testing.T) {
+	_, err := job.FailWith("boom")(context.Background(), job.ActivatedJob{})
+	require.EqualError(t, err, "boom")
+}
+
+func TestReadVariablesFile(t *testing.T) {
+	dir := t.TempDir()
+	p := filepath.Join(dir, "vars.json")
+	require.NoError(t, os.WriteFile(p, []byte(`{"paid": true, "amount": 12.5}`), 0o600))
+	vars, err := job.ReadVariablesFile(p)
+	require.NoError(t, err)
+	got, err := job.StaticVariables(vars)(context.Background(), job.ActivatedJob{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"paid": true, "amount": 12.5}, got)
+
+	_, err = job.ReadVariablesFile(filepath.Join(dir, "missing.json"))
+	require.ErrorContains(t, err, "reading variables file")
+
+	require.NoError(t, os.WriteFile(p, []byte(`[1, 2]`), 0o600))
+	_, err = job.ReadVariablesFile(p)
+	require.ErrorContains(t, err, "is not a JSON object")
+}
@@ -0,0 +1,90 @@
+package job
+
+type Job struct {
+	Key                      string            `json:"key,omitempty"`
+	Type                     string            `json:"type,omitempty"`
+	Kind                     string            `json:"kind,omitempty"`
+	State                    string            `json:"state,omitempty"`
+	Worker                   string            `json:"worker,omitempty"`
+	Retries                  int32             `json:"retries,omitempty"`
+	HasFailedWithRetriesLeft bool              `json:"hasFailedWithRetriesLeft,omitempty"`
+	ErrorCode                string            `json:"errorCode,omitempty"`
+	ErrorMessage             string            `json:"errorMessage,omitempty"`
+	Deadline                 string            `json:"deadline,omitempty"`
+	EndTime                  string            `json:"endTime,omitempty"`
+	ElementId                string            `json:"elementId,omitempty"`
+	ElementInstanceKey       string            `json:"elementInstanceKey,omitempty"`
+	ProcessDefinitionId      string            `json:"processDefinitionId,omitempty"`
+	ProcessDefinitionKey     string            `json:"processDefinitionKey,omitempty"`
+	ProcessInstanceKey       string            `json:"processInstanceKey,omitempty"`
+	TenantId                 string            `json:"tenantId,omitempty"`
+	CustomHeaders            map[string]string `json:"customHeaders,omitempty"`
+}
+
+type JobSearchFilterOpts struct {
+	Key                 string `json:"key,omitempty"`
+	Type                string `json:"type,omitempty"`
+	State               string `json:"state,omitempty"`
+	Worker              string `json:"worker,omitempty"`
+	ElementId           string `json:"elementId,omitempty"`
+	ProcessDefinitionId string `json:"processDefinitionId,omitempty"`
+	ProcessInstanceKey  string `json:"processInstanceKey,omitempty"`
+}
+
+// JobUpdate holds the job attributes to change; zero values are left unchanged.
+type JobUpdate struct {
+	Retries int32 `json:"retries,omitempty"`
+	Timeout int64 `json:"timeout,omitempty"` // in milliseconds, starting from now
+}
+
+type JobFailure struct {
+	ErrorMessage string         `json:"errorMessage,omitempty"`
+	Retries      int32          `json:"retries,omitempty"`
+	RetryBackOff int64          `json:"retryBackOff,omitempty"` // in milliseconds
+	Variables    map[string]any `json:"variables,omitempty"`
+}
+
+type JobError struct {
+	ErrorCode    string         `json:"errorCode,omitempty"`
+	ErrorMessage string         `json:"errorMessage,omitempty"`
+	Variables    map[string]any `json:"variables,omitempty"`
+}
+
+type JobActivation struct {
+	Type              string   `json:"type,omitempty"`
+	Worker            string   `json:"worker,omitempty"`
+	Timeout           int64    `json:"timeout,omitempty"` // in milliseconds
+	MaxJobsToActivate int32    `json:"maxJobsToActivate,omitempty"`
+	RequestTimeout    int64    `json:"requestTimeout,omitempty"` // in milliseconds, long polling
+	FetchVariables    []string `json:"fetchVariables,omitempty"`
+	TenantIds         []string `json:"tenantIds,omitempty"`
+}
+
+type ActivatedJob struct {
+	Key                      string         `json:"key,omitempty"`
+	Type                     string         `json:"type,omitempty"`
+	Worker                   string         `json:"worker,omitempty"`
+	Retries                  int32          `json:"retries,omitempty"`
+	Deadline                 int64          `json:"deadline,omitempty"`
+	ElementId                string         `json:"elementId,omitempty"`
+	ElementInstanceKey       string         `json:"elementInstanceKey,omitempty"`
+	ProcessDefinitionId      string         `json:"processDefinitionId,omitempty"`
+	ProcessDefinitionKey     string         `json:"processDefinitionKey,omitempty"`
+	ProcessDefinitionVersion int32          `json:"processDefinitionVersion,omitempty"`
+	ProcessInstanceKey       string         `json:"processInstanceKey,omitempty"`
+	TenantId                 string         `json:"tenantId,omitempty"`
+	CustomHeaders            map[string]any `json:"customHeaders,omitempty"`
+	Variables                map[string]any `json:"variables,omitempty"`
+}
+
+type Jobs struct {
+	Total int32 `json:"total,omitempty"`
+	Items []Job `json:"items,omitempty"`
+}
+
+// JobActionResult is the outcome of an action applied to one job of a bulk request.
+type JobActionResult struct {
+	Key   string `json:"key"`
+	Ok    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,158 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+)
+
+const (
+	DefaultWorkerName         = "kamunder-worker"
+	DefaultWorkerTimeout      = 30 * time.Second
+	DefaultWorkerMaxJobs      = 10
+	DefaultWorkerPollInterval = time.Second
+)
+
+// JobHandler processes an activated job and returns the variables the job is completed with.
+type JobHandler func(ctx context.Context, j ActivatedJob) (map[string]any, error)
+
+// JobWorkerResult is the outcome of a single job handled by a JobWorker.
+type JobWorkerResult struct {
+	Key                string `json:"key"`
+	Type               string `json:"type"`
+	ProcessInstanceKey string `json:"processInstanceKey,omitempty"`
+	Completed          bool   `json:"completed"`
+	Error              string `json:"error,omitempty"`
+}
+
+// JobWorker activates jobs of the given types and completes them with the handler's variables.
+// A handler error fails the job; FailRetries sets the retries left, a negative value decrements the job's retries.
+type JobWorker struct {
+	API          API
+	Handler      JobHandler
+	Types        []string
+	Name         string
+	Timeout      time.Duration
+	MaxJobs      int32
+	PollInterval time.Duration
+	FailRetries  int32
+	RetryBackOff time.Duration
+	Limit        int // stop after this many handled jobs, 0 runs until the context is done
+	OnResult     func(JobWorkerResult)
+	Log          *slog.Logger
+}
+
+func NewJobWorker(api API, handler JobHandler, types ...string) *JobWorker {
+	return &JobWorker{
+		API:          api,
+		Handler:      handler,
+		Types:        types,
+		Name:         DefaultWorkerName,
+		Timeout:      DefaultWorkerTimeout,
+		MaxJobs:      DefaultWorkerMaxJobs,
+		PollInterval: DefaultWorkerPollInterval,
+		FailRetries:  -1,
+		Log:          slog.Default(),
+	}
+}
+
+// Run polls for jobs until the context is done or Limit jobs have been handled.
+// Context cancellation ends the worker without error, failed activations are logged and polled again
+// unless the request itself is invalid or unsupported.
+func (w *JobWorker) Run(ctx context.Context, opts ...options.FacadeOption) error {
+	if w.API == nil || w.Handler == nil {
+		return errors.New("job worker requires an API and a handler")
+	}
+	if len(w.Types) == 0 {
+		return errors.New("job worker requires at least one job type")
+	}
+	handled := 0
+	for {
+		for _, t := range w.Types {
+			maxJobs := w.MaxJobs
+			if w.Limit > 0 && int32(w.Limit-handled) < maxJobs {
+				maxJobs = int32(w.Limit - handled)
+			}
+			jobs, err := w.API.ActivateJobs(ctx, JobActivation{
+				Type:              t,
+				Worker:            w.Name,
+				Timeout:           w.Timeout.Milliseconds(),
+				MaxJobsToActivate: maxJobs,
+				RequestTimeout:    -1,
+			}, opts...)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				if errors.Is(err, ferrors.ErrBadRequest) || errors.Is(err, ferrors.ErrUnsupported) {
+					return fmt.Errorf("activating jobs of type %q: %w", t, err)
+				}
+				// e.g. the cluster is unavailable for longer than the request retries, try again on the next poll
+				w.warn(fmt.Sprintf("activating jobs of type %q failed, polling again: %v", t, err))
+				continue
+			}
+			for _, j := range jobs {
+				w.report(w.handle(ctx, j, opts...))
+				handled++
+				if w.Limit > 0 && handled >= w.Limit {
+					return nil
+				}
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.PollInterval):
+		}
+	}
+}
+
+func (w *JobWorker) handle(ctx context.Context, j ActivatedJob, opts ...options.FacadeOption) JobWorkerResult {
+	res := JobWorkerResult{Key: j.Key, Type: j.Type, ProcessInstanceKey: j.ProcessInstanceKey}
+	vars, herr := w.Handler(ctx, j)
+	if herr == nil {
+		if err := w.API.CompleteJob(ctx, j.Key, vars, opts...); err != nil {
+			res.Error = fmt.Sprintf("completing job: %v", err)
+			return res
+		}
+		res.Completed = true
+		return res
+	}
+	retries := w.FailRetries
+	if retries < 0 {
+		retries = max(j.Retries-1, 0)
+	}
+	res.Error = herr.Error()
+	if err := w.API.FailJob(ctx, j.Key, JobFailure{
+		ErrorMessage: herr.Error(),
+		Retries:      retries,
+		RetryBackOff: w.RetryBackOff.Milliseconds(),
+	}, opts...); err != nil {
+		res.Error = fmt.Sprintf("%s; failing job: %v", res.Error, err)
+	}
+	return res
+}
+
+func (w *JobWorker) warn(msg string) {
+	if w.Log != nil {
+		w.Log.Warn(msg)
+	}
+}
+
+func (w *JobWorker) report(r JobWorkerResult) {
+	if w.Log != nil {
+		if r.Completed {
+			w.Log.Debug(fmt.Sprintf("job %s of type %q completed", r.Key, r.Type))
+		} else {
+			w.Log.Debug(fmt.Sprintf("job %s of type %q failed: %s", r.Key, r.Type, r.Error))
+		}
+	}
+	if w.OnResult != nil {
+		w.OnResult(r)
+	}
+}
@@ -0,0 +1,149 @@
+package job_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/job"
+	"github.com/grafvonb/kamunder/kamunder/options"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeJobs activates the queued jobs, or returns the queued errors first, and records completions and failures.
+type fakeJobs struct {
+	job.API
+	mu          sync.Mutex
+	queue       []job.ActivatedJob
+	activateErr []error
+	activations []job.JobActivation
+	completed   map[string]map[string]any
+	failed      map[string]job.JobFailure
+}
+
+func (f *fakeJobs) ActivateJobs(_ context.Context, a job.JobActivation, _ ...options.FacadeOption) ([]job.ActivatedJob, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.activations = append(f.activations, a)
+	if len(f.activateErr) > 0 {
+		err := f.activateErr[0]
+		f.activateErr = f.activateErr[1:]
+		return nil, err
+	}
+	n := min(int(a.MaxJobsToActivate), len(f.queue))
+	jobs := f.queue[:n]
+	f.queue = f.queue[n:]
+	return jobs, nil
+}
+
+func (f *fakeJobs) CompleteJob(_ context.Context, key string, vars map[string]any, _ ...options.FacadeOption) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.completed == nil {
+		f.completed = map[string]map[string]any{}
+	}
+	f.completed[key] = vars
+	return nil
+}
+
+func (f *fakeJobs) FailJob(_ context.Context, key string, fl job.JobFailure, _ ...options.FacadeOption) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failed == nil {
+		f.failed = map[string]job.JobFailure{}
+	}
+	f.failed[key] = fl
+	return nil
+}
+
+func newWorker(api job.API, h job.JobHandler) *job.JobWorker {
+	w := job.NewJobWorker(api, h, "payment")
+	w.PollInterval = time.Millisecond
+	w.Log = nil
+	return w
+}
+
+func jobs(keys ...string) []job.ActivatedJob {
+	out := make([]job.ActivatedJob, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, job.ActivatedJob{Key: k, Type: "payment", Retries: 3})
+	}
+	return out
+}
+
+func TestJobWorker_Limit(t *testing.T) {
+	api := &fakeJobs{queue: jobs("1", "2", "3", "4", "5")}
+	w := newWorker(api, job.StaticVariables(map[string]any{"paid": true}))
+	w.MaxJobs = 2
+	w.Limit = 3
+	var results []job.JobWorkerResult
+	w.OnResult = func(r job.JobWorkerResult) { results = append(results, r) }
+
+	require.NoError(t, w.Run(context.Background()))
+	require.Len(t, results, 3)
+	require.Len(t, api.completed, 3)
+	require.Equal(t, map[string]any{"paid": true}, api.completed["3"])
+	require.Equal(t, []int32{2, 1}, []int32{api.activations[0].MaxJobsToActivate, api.activations[1].MaxJobsToActivate})
+	require.Len(t, api.queue, 2, "no more jobs than the limit are activated")
+}
+
+func TestJobWorker_FailRetries(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		failRetries int32
+		jobRetries  int32
+		want        int32
+	}{
+		{"decrement", -1, 3, 2},
+		{"decrement stops at zero", -1, 0, 0},
+		{"fixed", 0, 3, 0},
+		{"fixed above current", 5, 1, 5},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			api := &fakeJobs{queue: []job.ActivatedJob{{Key: "1", Type: "payment", Retries: tc.jobRetries}}}
+			w := newWorker(api, job.FailWith("card declined"))
+			w.FailRetries = tc.failRetries
+			w.RetryBackOff = 2 * time.Second
+			w.Limit = 1
+			var res job.JobWorkerResult
+			w.OnResult = func(r job.JobWorkerResult) { res = r }
+
+			require.NoError(t, w.Run(context.Background()))
+			require.False(t, res.Completed)
+			require.Equal(t, "card declined", res.Error)
+			require.Equal(t, job.JobFailure{ErrorMessage: "card declined", Retries: tc.want, RetryBackOff: 2000}, api.failed["1"])
+		})
+	}
+}
+
+func TestJobWorker_KeepsPollingAfterActivationError(t *testing.T) {
+	api := &fakeJobs{queue: jobs("1"), activateErr: []error{ferrors.ErrUnavailable, ferrors.ErrTimeout}}
+	w := newWorker(api, job.StaticVariables(nil))
+	w.Limit = 1
+
+	require.NoError(t, w.Run(context.Background()))
+	require.Contains(t, api.completed, "1")
+	require.Len(t, api.activations, 3)
+}
+
+func TestJobWorker_StopsOnInvalidActivation(t *testing.T) {
+	api := &fakeJobs{activateErr: []error{ferrors.ErrUnsupported}}
+	w := newWorker(api, job.StaticVariables(nil))
+
+	require.ErrorIs(t, w.Run(context.Background()), ferrors.ErrUnsupported)
+}
+
+func TestJobWorker_ContextCancelEndsWithoutError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	w := newWorker(&fakeJobs{}, job.StaticVariables(nil))
+
+	require.NoError(t, w.Run(ctx))
+}
+
+func TestJobWorker_RequiresHandlerAndType(t *testing.T) {
+	require.Error(t, job.NewJobWorker(&fakeJobs{}, nil, "payment").Run(context.Background()))
+	require.Error(t, job.NewJobWorker(&fakeJobs{}, job.StaticVariables(nil)).Run(context.Background()))
+}
@@ -0,0 +1,52 @@
+package message
+
+import (
+	"context"
+
+	msvc "github.com/grafvonb/kamunder/internal/services/message"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+)
+
+type API interface {
+	PublishMessage(ctx context.Context, m MessagePublication, opts ...options.FacadeOption) (MessagePublicationResult, error)
+	CorrelateMessage(ctx context.Context, m MessageCorrelation, opts ...options.FacadeOption) (MessageCorrelationResult, error)
+	SearchMessageSubscriptions(ctx context.Context, filter MessageSubscriptionSearchFilterOpts, size int32, opts ...options.FacadeOption) (MessageSubscriptions, error)
+	SearchCorrelatedMessageSubscriptions(ctx context.Context, filter MessageSubscriptionSearchFilterOpts, size int32, opts ...options.FacadeOption) (CorrelatedMessageSubscriptions, error)
+}
+
+type client struct{ api msvc.API }
+
+func New(api msvc.API) API { return &client{api: api} }
+
+func (c *client) PublishMessage(ctx context.Context, m MessagePublication, opts ...options.FacadeOption) (MessagePublicationResult, error) {
+	r, err := c.api.PublishMessage(ctx, toDomainMessagePublication(m), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return MessagePublicationResult{}, ferrors.FromDomain(err)
+	}
+	return fromDomainMessagePublicationResult(r), nil
+}
+
+func (c *client) CorrelateMessage(ctx context.Context, m MessageCorrelation, opts ...options.FacadeOption) (MessageCorrelationResult, error) {
+	r, err := c.api.CorrelateMessage(ctx, toDomainMessageCorrelation(m), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return MessageCorrelationResult{}, ferrors.FromDomain(err)
+	}
+	return fromDomainMessageCorrelationResult(r), nil
+}
+
+func (c *client) SearchMessageSubscriptions(ctx context.Context, filter MessageSubscriptionSearchFilterOpts, size int32, opts ...options.FacadeOption) (MessageSubscriptions, error) {
+	ms, err := c.api.SearchMessageSubscriptions(ctx, toDomainMessageSubscriptionFilter(filter), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return MessageSubscriptions{}, ferrors.FromDomain(err)
+	}
+	return fromDomainMessageSubscriptions(ms), nil
+}
+
+func (c *client) SearchCorrelatedMessageSubscriptions(ctx context.Context, filter MessageSubscriptionSearchFilterOpts, size int32, opts ...options.FacadeOption) (CorrelatedMessageSubscriptions, error) {
+	ms, err := c.api.SearchCorrelatedMessageSubscriptions(ctx, toDomainMessageSubscriptionFilter(filter), size, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return CorrelatedMessageSubscriptions{}, ferrors.FromDomain(err)
+	}
+	return fromDomainCorrelatedMessageSubscriptions(ms), nil
+}
@@ -0,0 +1,98 @@
+package message
+
+import (
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func toDomainMessagePublication(x MessagePublication) d.MessagePublication {
+	return d.MessagePublication{
+		Name:           x.Name,
+		CorrelationKey: x.CorrelationKey,
+		MessageId:      x.MessageId,
+		TimeToLive:     x.TimeToLive,
+		TenantId:       x.TenantId,
+		Variables:      x.Variables,
+	}
+}
+
+func fromDomainMessagePublicationResult(x d.MessagePublicationResult) MessagePublicationResult {
+	return MessagePublicationResult{
+		MessageKey: x.MessageKey,
+		TenantId:   x.TenantId,
+	}
+}
+
+func toDomainMessageCorrelation(x MessageCorrelation) d.MessageCorrelation {
+	return d.MessageCorrelation{
+		Name:           x.Name,
+		CorrelationKey: x.CorrelationKey,
+		TenantId:       x.TenantId,
+		Variables:      x.Variables,
+	}
+}
+
+func fromDomainMessageCorrelationResult(x d.MessageCorrelationResult) MessageCorrelationResult {
+	return MessageCorrelationResult{
+		MessageKey:         x.MessageKey,
+		ProcessInstanceKey: x.ProcessInstanceKey,
+		TenantId:           x.TenantId,
+	}
+}
+
+func fromDomainMessageSubscription(x d.MessageSubscription) MessageSubscription {
+	return MessageSubscription{
+		Key:                  x.Key,
+		MessageName:          x.MessageName,
+		CorrelationKey:       x.CorrelationKey,
+		ElementId:            x.ElementId,
+		ElementInstanceKey:   x.ElementInstanceKey,
+		ProcessDefinitionId:  x.ProcessDefinitionId,
+		ProcessDefinitionKey: x.ProcessDefinitionKey,
+		ProcessInstanceKey:   x.ProcessInstanceKey,
+		State:                x.State,
+		LastUpdatedDate:      x.LastUpdatedDate,
+		TenantId:             x.TenantId,
+	}
+}
+
+func fromDomainMessageSubscriptions(xs []d.MessageSubscription) MessageSubscriptions {
+	items := toolx.MapSlice(xs, fromDomainMessageSubscription)
+	return MessageSubscriptions{
+		Total: int32(len(items)),
+		Items: items,
+	}
+}
+
+func fromDomainCorrelatedMessageSubscription(x d.CorrelatedMessageSubscription) CorrelatedMessageSubscription {
+	return CorrelatedMessageSubscription{
+		SubscriptionKey:      x.SubscriptionKey,
+		MessageKey:           x.MessageKey,
+		MessageName:          x.MessageName,
+		CorrelationKey:       x.CorrelationKey,
+		CorrelationTime:      x.CorrelationTime,
+		ElementId:            x.ElementId,
+		ElementInstanceKey:   x.ElementInstanceKey,
+		ProcessDefinitionId:  x.ProcessDefinitionId,
+		ProcessDefinitionKey: x.ProcessDefinitionKey,
+		ProcessInstanceKey:   x.ProcessInstanceKey,
+		TenantId:             x.TenantId,
+	}
+}
+
+func fromDomainCorrelatedMessageSubscriptions(xs []d.CorrelatedMessageSubscription) CorrelatedMessageSubscriptions {
+	items := toolx.MapSlice(xs, fromDomainCorrelatedMessageSubscription)
+	return CorrelatedMessageSubscriptions{
+		Total: int32(len(items)),
+		Items: items,
+	}
+}
+
+func toDomainMessageSubscriptionFilter(x MessageSubscriptionSearchFilterOpts) d.MessageSubscriptionSearchFilterOpts {
+	return d.MessageSubscriptionSearchFilterOpts{
+		MessageName:        x.MessageName,
+		CorrelationKey:     x.CorrelationKey,
+		ProcessInstanceKey: x.ProcessInstanceKey,
+		State:              x.State,
+	}
+}
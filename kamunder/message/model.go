@@ -0,0 +1,73 @@
+package message
+
+type MessagePublication struct {
+	Name           string         `json:"name,omitempty"`
+	CorrelationKey string         `json:"correlationKey,omitempty"`
+	MessageId      string         `json:"messageId,omitempty"`
+	TimeToLive     int64          `json:"timeToLive,omitempty"` // in milliseconds
+	TenantId       string         `json:"tenantId,omitempty"`
+	Variables      map[string]any `json:"variables,omitempty"`
+}
+
+type MessagePublicationResult struct {
+	MessageKey string `json:"messageKey,omitempty"`
+	TenantId   string `json:"tenantId,omitempty"`
+}
+
+type MessageCorrelation struct {
+	Name           string         `json:"name,omitempty"`
+	CorrelationKey string         `json:"correlationKey,omitempty"`
+	TenantId       string         `json:"tenantId,omitempty"`
+	Variables      map[string]any `json:"variables,omitempty"`
+}
+
+type MessageCorrelationResult struct {
+	MessageKey         string `json:"messageKey,omitempty"`
+	ProcessInstanceKey string `json:"processInstanceKey,omitempty"`
+	TenantId           string `json:"tenantId,omitempty"`
+}
+
+type MessageSubscription struct {
+	Key                  string `json:"key,omitempty"`
+	MessageName          string `json:"messageName,omitempty"`
+	CorrelationKey       string `json:"correlationKey,omitempty"`
+	ElementId            string `json:"elementId,omitempty"`
+	ElementInstanceKey   string `json:"elementInstanceKey,omitempty"`
+	ProcessDefinitionId  string `json:"processDefinitionId,omitempty"`
+	ProcessDefinitionKey string `json:"processDefinitionKey,omitempty"`
+	ProcessInstanceKey   string `json:"processInstanceKey,omitempty"`
+	State                string `json:"state,omitempty"`
+	LastUpdatedDate      string `json:"lastUpdatedDate,omitempty"`
+	TenantId             string `json:"tenantId,omitempty"`
+}
+
+type CorrelatedMessageSubscription struct {
+	SubscriptionKey      string `json:"subscriptionKey,omitempty"`
+	MessageKey           string `json:"messageKey,omitempty"`
+	MessageName          string `json:"messageName,omitempty"`
+	CorrelationKey       string `json:"correlationKey,omitempty"`
+	CorrelationTime      string `json:"correlationTime,omitempty"`
+	ElementId            string `json:"elementId,omitempty"`
+	ElementInstanceKey   string `json:"elementInstanceKey,omitempty"`
+	ProcessDefinitionId  string `json:"processDefinitionId,omitempty"`
+	ProcessDefinitionKey string `json:"processDefinitionKey,omitempty"`
+	ProcessInstanceKey   string `json:"processInstanceKey,omitempty"`
+	TenantId             string `json:"tenantId,omitempty"`
+}
+
+type MessageSubscriptionSearchFilterOpts struct {
+	MessageName        string `json:"messageName,omitempty"`
+	CorrelationKey     string `json:"correlationKey,omitempty"`
+	ProcessInstanceKey string `json:"processInstanceKey,omitempty"`
+	State              string `json:"state,omitempty"`
+}
+
+type MessageSubscriptions struct {
+	Total int32                 `json:"total,omitempty"`
+	Items []MessageSubscription `json:"items,omitempty"`
+}
+
+type CorrelatedMessageSubscriptions struct {
+	Total int32                           `json:"total,omitempty"`
+	Items []CorrelatedMessageSubscription `json:"items,omitempty"`
+}
@@ -5,6 +5,7 @@ import "github.com/grafvonb/kamunder/internal/services"
 func WithNoStateCheck() FacadeOption { return func(c *FacadeCfg) { c.NoStateCheck = true } }
 func WithCancel() FacadeOption       { return func(c *FacadeCfg) { c.Cancel = true } }
 func WithWait() FacadeOption         { return func(c *FacadeCfg) { c.Wait = true } }
+func WithVerify() FacadeOption       { return func(c *FacadeCfg) { c.Verify = true } }
 
 type FacadeOption func(*FacadeCfg)
 
@@ -12,6 +13,7 @@ type FacadeCfg struct {
 	NoStateCheck bool
 	Cancel       bool
 	Wait         bool
+	Verify       bool
 }
 
 func ApplyFacadeOptions(opts []FacadeOption) *FacadeCfg {
@@ -34,5 +36,8 @@ func MapFacadeOptionsToCallOptions(opts []FacadeOption) []services.CallOption {
 	if c.Wait {
 		out = append(out, services.WithWait())
 	}
+	if c.Verify {
+		out = append(out, services.WithVerify())
+	}
 	return out
 }
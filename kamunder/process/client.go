@@ -16,6 +16,9 @@ type API interface {
 	SearchProcessDefinitions(ctx context.Context, filter ProcessDefinitionSearchFilterOpts, size int32, opts ...options.FacadeOption) (ProcessDefinitions, error)
 	GetProcessInstanceByKey(ctx context.Context, key string, opts ...options.FacadeOption) (ProcessInstance, error)
 	SearchForProcessInstances(ctx context.Context, filter ProcessInstanceSearchFilterOpts, size int32, opts ...options.FacadeOption) (ProcessInstances, error)
+	// CountProcessInstances returns the total number of matching process instances, which searches limit to a page.
+	CountProcessInstances(ctx context.Context, filter ProcessInstanceSearchFilterOpts, opts ...options.FacadeOption) (int64, error)
+	CreateProcessInstance(ctx context.Context, data ProcessInstanceData, opts ...options.FacadeOption) (ProcessInstance, error)
 	CancelProcessInstance(ctx context.Context, key string, opts ...options.FacadeOption) (CancelResponse, error)
 	GetDirectChildrenOfProcessInstance(ctx context.Context, key string, opts ...options.FacadeOption) (ProcessInstances, error)
 	FilterProcessInstanceWithOrphanParent(ctx context.Context, items []ProcessInstance, opts ...options.FacadeOption) ([]ProcessInstance, error)
@@ -68,6 +71,19 @@ func (c *client) SearchForProcessInstances(ctx context.Context, filter ProcessIn
 	return fromDomainProcessInstances(pis), nil
 }
 
+func (c *client) CountProcessInstances(ctx context.Context, filter ProcessInstanceSearchFilterOpts, opts ...options.FacadeOption) (int64, error) {
+	n, err := c.piApi.CountProcessInstances(ctx, toDomainProcessInstanceFilter(filter), options.MapFacadeOptionsToCallOptions(opts)...)
+	return n, ferrors.FromDomain(err)
+}
+
+func (c *client) CreateProcessInstance(ctx context.Context, data ProcessInstanceData, opts ...options.FacadeOption) (ProcessInstance, error) {
+	pi, err := c.piApi.CreateProcessInstance(ctx, toDomainProcessInstanceCreation(data), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return ProcessInstance{}, ferrors.FromDomain(err)
+	}
+	return fromDomainProcessInstance(pi), nil
+}
+
 func (c *client) CancelProcessInstance(ctx context.Context, key string, opts ...options.FacadeOption) (CancelResponse, error) {
 	resp, err := c.piApi.CancelProcessInstance(ctx, key, options.MapFacadeOptionsToCallOptions(opts)...)
 	if err != nil {
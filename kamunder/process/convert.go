@@ -41,6 +41,16 @@ func fromDomainProcessInstance(x d.ProcessInstance) ProcessInstance {
 	}
 }
 
+func toDomainProcessInstanceCreation(x ProcessInstanceData) d.ProcessInstanceCreation {
+	return d.ProcessInstanceCreation{
+		BpmnProcessId:        x.BpmnProcessId,
+		ProcessDefinitionKey: x.ProcessDefinitionKey,
+		ProcessVersion:       x.ProcessVersion,
+		TenantId:             x.TenantId,
+		Variables:            x.Variables,
+	}
+}
+
 func fromDomainProcessInstances(xs []d.ProcessInstance) ProcessInstances {
 	items := toolx.MapSlice(xs, fromDomainProcessInstance)
 	return ProcessInstances{
@@ -46,6 +46,17 @@ type ProcessInstances struct {
 	Items []ProcessInstance `json:"items,omitempty"`
 }
 
+// ProcessInstanceData starts an instance of the definition given by ProcessDefinitionKey
+// or by BpmnProcessId and ProcessVersion, the latest version if ProcessVersion is 0.
+// Variables may hold document references returned by the document API.
+type ProcessInstanceData struct {
+	BpmnProcessId        string         `json:"bpmnProcessId,omitempty"`
+	ProcessDefinitionKey string         `json:"processDefinitionKey,omitempty"`
+	ProcessVersion       int32          `json:"processVersion,omitempty"`
+	TenantId             string         `json:"tenantId,omitempty"`
+	Variables            map[string]any `json:"variables,omitempty"`
+}
+
 type ProcessInstanceSearchFilterOpts struct {
 	Key               string
 	BpmnProcessId     string
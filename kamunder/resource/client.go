@@ -10,6 +10,8 @@ import (
 
 type API interface {
 	DeployProcessDefinition(ctx context.Context, tenantId string, units []DeploymentUnitData, opts ...options.FacadeOption) (ProcessDefinitionDeployment, error)
+	GetResource(ctx context.Context, key string, opts ...options.FacadeOption) (Resource, error)
+	GetResourceContent(ctx context.Context, key string, opts ...options.FacadeOption) ([]byte, error)
 }
 
 type client struct{ api rsvc.API }
@@ -23,3 +25,19 @@ func (c *client) DeployProcessDefinition(ctx context.Context, tenantId string, u
 	}
 	return fromProcessDefinitionDeployment(pdd), nil
 }
+
+func (c *client) GetResource(ctx context.Context, key string, opts ...options.FacadeOption) (Resource, error) {
+	r, err := c.api.GetResource(ctx, key, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return Resource{}, ferrors.FromDomain(err)
+	}
+	return fromDomainResource(r), nil
+}
+
+func (c *client) GetResourceContent(ctx context.Context, key string, opts ...options.FacadeOption) ([]byte, error) {
+	b, err := c.api.GetResourceContent(ctx, key, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return nil, ferrors.FromDomain(err)
+	}
+	return b, nil
+}
@@ -26,3 +26,14 @@ func toDeploymentUnitDatas(units []DeploymentUnitData) []d.DeploymentUnitData {
 	}
 	return result
 }
+
+func fromDomainResource(r d.Resource) Resource {
+	return Resource{
+		Id:         r.Id,
+		Key:        r.Key,
+		Name:       r.Name,
+		TenantId:   r.TenantId,
+		Version:    r.Version,
+		VersionTag: r.VersionTag,
+	}
+}
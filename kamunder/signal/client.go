@@ -0,0 +1,37 @@
+package signal
+
+import (
+	"context"
+
+	ssvc "github.com/grafvonb/kamunder/internal/services/signal"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type API interface {
+	BroadcastSignal(ctx context.Context, sig SignalBroadcast, opts ...options.FacadeOption) (SignalBroadcastResult, error)
+	SearchSignalCatchInstances(ctx context.Context, signalName string, opts ...options.FacadeOption) ([]SignalCatchInstance, error)
+}
+
+type client struct{ api ssvc.API }
+
+func New(api ssvc.API) API { return &client{api: api} }
+
+// BroadcastSignal broadcasts the signal; with options.WithVerify() it also waits until the instances that were waiting for it progressed.
+// On a failed verification the partial result (including the still waiting instances) is returned together with the error.
+func (c *client) BroadcastSignal(ctx context.Context, sig SignalBroadcast, opts ...options.FacadeOption) (SignalBroadcastResult, error) {
+	r, err := c.api.BroadcastSignal(ctx, toDomainSignalBroadcast(sig), options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return fromDomainSignalBroadcastResult(r), ferrors.FromDomain(err)
+	}
+	return fromDomainSignalBroadcastResult(r), nil
+}
+
+func (c *client) SearchSignalCatchInstances(ctx context.Context, signalName string, opts ...options.FacadeOption) ([]SignalCatchInstance, error) {
+	items, err := c.api.SearchSignalCatchInstances(ctx, signalName, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return nil, ferrors.FromDomain(err)
+	}
+	return toolx.MapSlice(items, fromDomainSignalCatchInstance), nil
+}
@@ -0,0 +1,35 @@
+package signal
+
+import (
+	d "github.com/grafvonb/kamunder/internal/domain"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+func toDomainSignalBroadcast(x SignalBroadcast) d.SignalBroadcast {
+	return d.SignalBroadcast{
+		Name:      x.Name,
+		TenantId:  x.TenantId,
+		Variables: x.Variables,
+	}
+}
+
+func fromDomainSignalBroadcastResult(x d.SignalBroadcastResult) SignalBroadcastResult {
+	return SignalBroadcastResult{
+		SignalKey:         x.SignalKey,
+		TenantId:          x.TenantId,
+		Verified:          x.Verified,
+		CatchingInstances: toolx.MapSlice(x.CatchingInstances, fromDomainSignalCatchInstance),
+	}
+}
+
+func fromDomainSignalCatchInstance(x d.SignalCatchInstance) SignalCatchInstance {
+	return SignalCatchInstance{
+		Key:                  x.Key,
+		ElementId:            x.ElementId,
+		ProcessInstanceKey:   x.ProcessInstanceKey,
+		ProcessDefinitionKey: x.ProcessDefinitionKey,
+		StateBefore:          x.StateBefore,
+		StateAfter:           x.StateAfter,
+		TenantId:             x.TenantId,
+	}
+}
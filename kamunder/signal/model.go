@@ -0,0 +1,25 @@
+package signal
+
+type SignalBroadcast struct {
+	Name      string         `json:"name,omitempty"`
+	TenantId  string         `json:"tenantId,omitempty"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type SignalBroadcastResult struct {
+	SignalKey         string                `json:"signalKey,omitempty"`
+	TenantId          string                `json:"tenantId,omitempty"`
+	Verified          bool                  `json:"verified,omitempty"`
+	CatchingInstances []SignalCatchInstance `json:"catchingInstances,omitempty"`
+}
+
+// SignalCatchInstance is an element instance waiting for a signal, captured before the broadcast.
+type SignalCatchInstance struct {
+	Key                  string `json:"key,omitempty"`
+	ElementId            string `json:"elementId,omitempty"`
+	ProcessInstanceKey   string `json:"processInstanceKey,omitempty"`
+	ProcessDefinitionKey string `json:"processDefinitionKey,omitempty"`
+	StateBefore          string `json:"stateBefore,omitempty"`
+	StateAfter           string `json:"stateAfter,omitempty"`
+	TenantId             string `json:"tenantId,omitempty"`
+}
@@ -0,0 +1,119 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	usvc "github.com/grafvonb/kamunder/internal/services/usage"
+	"github.com/grafvonb/kamunder/kamunder/ferrors"
+	"github.com/grafvonb/kamunder/kamunder/options"
+	"github.com/grafvonb/kamunder/toolx"
+)
+
+type API interface {
+	GetUsageReport(ctx context.Context, o UsageReportOpts, opts ...options.FacadeOption) (UsageReport, error)
+	ListAdminClusters(ctx context.Context, opts ...options.FacadeOption) (AdminClusters, error)
+}
+
+type client struct{ api usvc.API }
+
+func New(api usvc.API) API { return &client{api: api} }
+
+// GetUsageReport queries the usage metrics of every calendar month (UTC) overlapping [o.From, o.To),
+// clipped to the range, plus a total per cluster.
+func (c *client) GetUsageReport(ctx context.Context, o UsageReportOpts, opts ...options.FacadeOption) (UsageReport, error) {
+	if !o.From.Before(o.To) {
+		return UsageReport{}, fmt.Errorf("%w: from (%s) must be before to (%s)", ferrors.ErrBadRequest,
+			o.From.Format(time.RFC3339), o.To.Format(time.RFC3339))
+	}
+	if o.Source == "" {
+		o.Source = SourceOrchestration
+	}
+	callOpts := options.MapFacadeOptionsToCallOptions(opts)
+	rep := UsageReport{From: o.From, To: o.To, Source: o.Source}
+	windows := monthWindows(o.From, o.To)
+
+	switch o.Source {
+	case SourceOrchestration:
+		fetch := func(w window) (UsageMetrics, error) {
+			m, err := c.api.GetUsageMetrics(ctx, w.from, w.to, callOpts...)
+			return fromDomainUsageMetrics(m), err
+		}
+		rows, err := collectRows(windows, o.From, o.To, fetch)
+		if err != nil {
+			return UsageReport{}, ferrors.FromDomain(err)
+		}
+		rep.Rows = rows
+	case SourceAdministration:
+		clusters, err := c.api.GetAdminClusters(ctx, callOpts...)
+		if err != nil {
+			return UsageReport{}, ferrors.FromDomain(err)
+		}
+		for _, cl := range clusters {
+			if len(o.ClusterIds) > 0 && !slices.Contains(o.ClusterIds, cl.Uuid) && !slices.Contains(o.ClusterIds, cl.Name) {
+				continue
+			}
+			fetch := func(w window) (UsageMetrics, error) {
+				m, err := c.api.GetAdminUsageMetrics(ctx, cl.Uuid, w.from, w.to, callOpts...)
+				um := fromDomainUsageMetrics(m)
+				um.ClusterId, um.ClusterName = cl.Uuid, cl.Name
+				return um, err
+			}
+			rows, err := collectRows(windows, o.From, o.To, fetch)
+			if err != nil {
+				return UsageReport{}, ferrors.FromDomain(fmt.Errorf("cluster %s: %w", cl.Name, err))
+			}
+			rep.Rows = append(rep.Rows, rows...)
+		}
+	default:
+		return UsageReport{}, fmt.Errorf("%w: unknown usage metrics source %q (supported: %s, %s)",
+			ferrors.ErrBadRequest, o.Source, SourceOrchestration, SourceAdministration)
+	}
+	return rep, nil
+}
+
+func (c *client) ListAdminClusters(ctx context.Context, opts ...options.FacadeOption) (AdminClusters, error) {
+	cs, err := c.api.GetAdminClusters(ctx, options.MapFacadeOptionsToCallOptions(opts)...)
+	if err != nil {
+		return AdminClusters{}, ferrors.FromDomain(err)
+	}
+	items := toolx.MapSlice(cs, fromDomainAdminCluster)
+	return AdminClusters{Total: int32(len(items)), Items: items}, nil
+}
+
+type window struct{ from, to time.Time }
+
+// monthWindows splits [from, to) at UTC month boundaries.
+func monthWindows(from, to time.Time) []window {
+	var out []window
+	for start := from; start.Before(to); {
+		u := start.UTC()
+		end := time.Date(u.Year(), u.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		if end.After(to) {
+			end = to
+		}
+		out = append(out, window{from: start, to: end})
+		start = end
+	}
+	return out
+}
+
+func collectRows(windows []window, from, to time.Time, fetch func(window) (UsageMetrics, error)) ([]UsageMetrics, error) {
+	rows := make([]UsageMetrics, 0, len(windows)+1)
+	for _, w := range windows {
+		m, err := fetch(w)
+		if err != nil {
+			return nil, err
+		}
+		m.Period = w.from.UTC().Format("2006-01")
+		rows = append(rows, m)
+	}
+	total, err := fetch(window{from: from, to: to})
+	if err != nil {
+		return nil, err
+	}
+	total.Period = TotalPeriod
+	return append(rows, total), nil
+}
@@ -0,0 +1,27 @@
+package usage
+
+import (
+	"github.com/grafvonb/kamunder/internal/domain"
+)
+
+func fromDomainUsageMetrics(m domain.UsageMetrics) UsageMetrics {
+	return UsageMetrics{
+		ClusterId:         m.ClusterId,
+		From:              m.From,
+		To:                m.To,
+		ProcessInstances:  m.ProcessInstances,
+		DecisionInstances: m.DecisionInstances,
+		Assignees:         m.Assignees,
+	}
+}
+
+func fromDomainAdminCluster(c domain.AdminCluster) AdminCluster {
+	return AdminCluster{
+		Uuid:       c.Uuid,
+		Name:       c.Name,
+		Namespace:  c.Namespace,
+		Generation: c.Generation,
+		Status:     c.Status,
+		Type:       c.Type,
+	}
+}
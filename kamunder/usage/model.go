@@ -0,0 +1,60 @@
+package usage
+
+import "time"
+
+// Source selects the API usage metrics are read from.
+type Source string
+
+const (
+	// SourceOrchestration reads the metrics of the connected orchestration cluster (Camunda 8.8+).
+	SourceOrchestration Source = "orchestration"
+	// SourceAdministration reads the metrics of all clusters known to the Self-Managed Console.
+	SourceAdministration Source = "administration"
+)
+
+// TotalPeriod is the Period of the rows summing up the whole reporting range.
+const TotalPeriod = "total"
+
+// UsageMetrics are the license relevant counters of one cluster for the time range [From, To).
+// Period is the month formatted as YYYY-MM, or TotalPeriod.
+type UsageMetrics struct {
+	ClusterId         string    `json:"clusterId,omitempty"`
+	ClusterName       string    `json:"clusterName,omitempty"`
+	Period            string    `json:"period,omitempty"`
+	From              time.Time `json:"from"`
+	To                time.Time `json:"to"`
+	ProcessInstances  int64     `json:"processInstances"`
+	DecisionInstances int64     `json:"decisionInstances"`
+	Assignees         int64     `json:"assignees"`
+}
+
+type UsageReportOpts struct {
+	From   time.Time
+	To     time.Time
+	Source Source
+	// ClusterIds restricts SourceAdministration to the given cluster ids, all clusters if empty.
+	ClusterIds []string
+}
+
+// UsageReport holds one row per cluster and month followed by one TotalPeriod row per cluster.
+// Totals are queried over the whole range, as unique assignees cannot be summed up month by month.
+type UsageReport struct {
+	From   time.Time      `json:"from"`
+	To     time.Time      `json:"to"`
+	Source Source         `json:"source"`
+	Rows   []UsageMetrics `json:"rows,omitempty"`
+}
+
+type AdminCluster struct {
+	Uuid       string `json:"uuid,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Generation string `json:"generation,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Type       string `json:"type,omitempty"`
+}
+
+type AdminClusters struct {
+	Total int32          `json:"total,omitempty"`
+	Items []AdminCluster `json:"items,omitempty"`
+}